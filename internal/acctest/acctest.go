@@ -0,0 +1,51 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+package acctest
+
+import (
+	"fmt"
+	"terraform-provider-kakaocloud/internal/acctest/mockserver"
+	"terraform-provider-kakaocloud/internal/provider"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+)
+
+var ProtoV6ProviderFactories = map[string]func() (tfprotov6.ProviderServer, error){
+	"kakaocloud": providerserver.NewProtocol6WithError(provider.New("test")()),
+}
+
+// NewMockServer starts a mock KakaoCloud API server that is closed when the test finishes.
+func NewMockServer(t *testing.T) *mockserver.Server {
+	t.Helper()
+
+	server := mockserver.New()
+	t.Cleanup(server.Close)
+	return server
+}
+
+// ProviderConfig returns a provider block pointing the iam and config endpoints at the mock
+// server. The remaining service endpoints are resolved through the mock config API.
+func ProviderConfig(server *mockserver.Server, extra string) string {
+	return fmt.Sprintf(`
+provider "kakaocloud" {
+  application_credential_id     = %q
+  application_credential_secret = %q
+  region                        = %q
+
+  endpoint_overrides = {
+    iam    = %q
+    config = %q
+  }
+%s
+}
+`,
+		mockserver.CredentialId,
+		mockserver.CredentialSecret,
+		mockserver.Region,
+		server.Endpoint("iam"),
+		server.Endpoint("config"),
+		extra,
+	)
+}
@@ -0,0 +1,335 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+package mockserver
+
+import (
+	"crypto/rand"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sort"
+	"strconv"
+	"sync"
+	"time"
+)
+
+// Collection is an in-memory REST collection such as /vpc/.../vpcs.
+// Objects are wrapped in Key for single-object bodies and in ListKey for list bodies.
+// A created object reports PendingStatus in StatusField for PendingReads GET requests and
+// ReadyStatus afterwards, which exercises the provider polling helpers.
+// Actions are keyed by the path segment after the object id, and OnAction handles any action
+// missing from Actions. Children serve requests for items nested under an object, such as
+// /instances/{id}/volumes/{volume_id}, keyed by the segment after the object id.
+// MaxLimit caps the items of a list page below the requested limit, like APIs that serve fewer
+// items than asked for. Transitions maps a transitional status set by an action to the status
+// it settles to after PendingReads GET requests.
+type Collection struct {
+	Service  string
+	Name     string
+	Key      string
+	ListKey  string
+	MaxLimit int
+
+	StatusField   string
+	PendingStatus string
+	ReadyStatus   string
+	PendingReads  int
+	Transitions   map[string]string
+
+	OnCreate func(obj map[string]any)
+	Actions  map[string]func(obj map[string]any, body map[string]any) int
+	OnAction func(obj map[string]any, name string, body map[string]any) int
+	Children map[string]func(obj map[string]any, method string, childId string, body map[string]any) int
+
+	mu      *sync.Mutex
+	objects map[string]*object
+	order   []string
+}
+
+type object struct {
+	fields map[string]any
+	reads  int
+}
+
+func (c *Collection) match(segments []string) int {
+	for i, seg := range segments {
+		if seg == c.Name {
+			return i
+		}
+	}
+	return -1
+}
+
+func (c *Collection) serve(w http.ResponseWriter, r *http.Request, rest []string) {
+	switch {
+	case len(rest) == 0 && r.Method == http.MethodGet:
+		c.list(w, r)
+	case len(rest) == 0 && r.Method == http.MethodPost:
+		c.create(w, r)
+	case len(rest) == 1 && r.Method == http.MethodGet:
+		c.get(w, rest[0])
+	case len(rest) == 1 && (r.Method == http.MethodPut || r.Method == http.MethodPatch):
+		c.update(w, r, rest[0])
+	case len(rest) == 1 && r.Method == http.MethodDelete:
+		c.delete(w, rest[0])
+	case len(rest) == 2:
+		c.action(w, r, rest[0], rest[1])
+	case len(rest) == 3:
+		c.child(w, r, rest[0], rest[1], rest[2])
+	default:
+		writeError(w, http.StatusNotFound, "unknown path")
+	}
+}
+
+func (c *Collection) Put(id string, fields map[string]any) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if _, ok := c.objects[id]; !ok {
+		c.order = append(c.order, id)
+	}
+	c.objects[id] = &object{fields: fields, reads: c.PendingReads}
+}
+
+func (c *Collection) Get(id string) (map[string]any, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	obj, ok := c.objects[id]
+	if !ok {
+		return nil, false
+	}
+	return obj.fields, true
+}
+
+func (c *Collection) Len() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return len(c.objects)
+}
+
+func (c *Collection) list(w http.ResponseWriter, r *http.Request) {
+	limit := len(c.order)
+	offset := 0
+	if v, err := strconv.Atoi(r.URL.Query().Get("limit")); err == nil && v >= 0 {
+		limit = v
+	}
+	if v, err := strconv.Atoi(r.URL.Query().Get("offset")); err == nil && v >= 0 {
+		offset = v
+	}
+	if c.MaxLimit > 0 && limit > c.MaxLimit {
+		limit = c.MaxLimit
+	}
+
+	items := make([]map[string]any, 0)
+	for i, id := range c.order {
+		if i < offset {
+			continue
+		}
+		if len(items) >= limit {
+			break
+		}
+		obj := c.objects[id]
+		c.advance(obj)
+		items = append(items, obj.fields)
+	}
+
+	writeJSON(w, http.StatusOK, map[string]any{
+		c.ListKey: items,
+		"pagination": map[string]any{
+			"total": len(c.order),
+		},
+	})
+}
+
+func (c *Collection) create(w http.ResponseWriter, r *http.Request) {
+	body, ok := decodeBody(w, r)
+	if !ok {
+		return
+	}
+
+	fields, _ := body[c.Key].(map[string]any)
+	if fields == nil {
+		fields = body
+	}
+
+	id := newId()
+	now := time.Now().UTC().Format(time.RFC3339)
+	projectId, _ := projectFromToken(r.Header.Get("X-Auth-Token"))
+	obj := map[string]any{
+		"id":         id,
+		"project_id": projectId,
+		"created_at": now,
+		"updated_at": now,
+	}
+	for k, v := range fields {
+		obj[k] = v
+	}
+	if c.OnCreate != nil {
+		c.OnCreate(obj)
+	}
+	if c.StatusField != "" {
+		obj[c.StatusField] = c.PendingStatus
+	}
+
+	c.order = append(c.order, id)
+	c.objects[id] = &object{fields: obj}
+
+	writeJSON(w, http.StatusCreated, map[string]any{c.Key: obj})
+}
+
+func (c *Collection) get(w http.ResponseWriter, id string) {
+	obj, ok := c.objects[id]
+	if !ok {
+		writeError(w, http.StatusNotFound, fmt.Sprintf("%s %s not found", c.Key, id))
+		return
+	}
+	c.advance(obj)
+	writeJSON(w, http.StatusOK, map[string]any{c.Key: obj.fields})
+}
+
+func (c *Collection) update(w http.ResponseWriter, r *http.Request, id string) {
+	obj, ok := c.objects[id]
+	if !ok {
+		writeError(w, http.StatusNotFound, fmt.Sprintf("%s %s not found", c.Key, id))
+		return
+	}
+
+	body, ok := decodeBody(w, r)
+	if !ok {
+		return
+	}
+	fields, _ := body[c.Key].(map[string]any)
+	if fields == nil {
+		fields = body
+	}
+	for k, v := range fields {
+		obj.fields[k] = v
+	}
+	obj.fields["updated_at"] = time.Now().UTC().Format(time.RFC3339)
+
+	writeJSON(w, http.StatusOK, map[string]any{c.Key: obj.fields})
+}
+
+func (c *Collection) delete(w http.ResponseWriter, id string) {
+	if _, ok := c.objects[id]; !ok {
+		writeError(w, http.StatusNotFound, fmt.Sprintf("%s %s not found", c.Key, id))
+		return
+	}
+	delete(c.objects, id)
+	for i, v := range c.order {
+		if v == id {
+			c.order = append(c.order[:i], c.order[i+1:]...)
+			break
+		}
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (c *Collection) action(w http.ResponseWriter, r *http.Request, id string, name string) {
+	obj, ok := c.objects[id]
+	if !ok {
+		writeError(w, http.StatusNotFound, fmt.Sprintf("%s %s not found", c.Key, id))
+		return
+	}
+	handler, ok := c.Actions[name]
+	if !ok && c.OnAction != nil {
+		handler, ok = func(obj map[string]any, body map[string]any) int {
+			return c.OnAction(obj, name, body)
+		}, true
+	}
+	if !ok {
+		writeError(w, http.StatusNotFound, fmt.Sprintf("unknown action %s", name))
+		return
+	}
+	body, ok := decodeBody(w, r)
+	if !ok {
+		return
+	}
+	status := handler(obj.fields, body)
+	obj.reads = 0
+	if status == http.StatusNoContent {
+		w.WriteHeader(status)
+		return
+	}
+	writeJSON(w, status, map[string]any{c.Key: obj.fields})
+}
+
+func (c *Collection) child(w http.ResponseWriter, r *http.Request, id string, name string, childId string) {
+	obj, ok := c.objects[id]
+	if !ok {
+		writeError(w, http.StatusNotFound, fmt.Sprintf("%s %s not found", c.Key, id))
+		return
+	}
+	handler, ok := c.Children[name]
+	if !ok {
+		writeError(w, http.StatusNotFound, fmt.Sprintf("unknown child collection %s", name))
+		return
+	}
+	body, ok := decodeBody(w, r)
+	if !ok {
+		return
+	}
+	switch status := handler(obj.fields, r.Method, childId, body); {
+	case status >= http.StatusBadRequest:
+		writeError(w, status, fmt.Sprintf("%s %s of %s %s: %s", name, childId, c.Key, id, http.StatusText(status)))
+	case status == http.StatusNoContent:
+		w.WriteHeader(status)
+	default:
+		writeJSON(w, status, map[string]any{c.Key: obj.fields})
+	}
+}
+
+func (c *Collection) advance(obj *object) {
+	if c.StatusField == "" {
+		return
+	}
+	obj.reads++
+	status, ok := obj.fields[c.StatusField]
+	if next, transitional := c.Transitions[fmt.Sprint(status)]; ok && transitional {
+		if obj.reads > c.PendingReads {
+			obj.fields[c.StatusField] = next
+		}
+		return
+	}
+	if ok && status != c.PendingStatus {
+		return
+	}
+	if obj.reads > c.PendingReads {
+		obj.fields[c.StatusField] = c.ReadyStatus
+	}
+}
+
+// SortedIds returns the ids of the stored objects, mainly for assertions in tests.
+func (c *Collection) SortedIds() []string {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	ids := make([]string, 0, len(c.objects))
+	for id := range c.objects {
+		ids = append(ids, id)
+	}
+	sort.Strings(ids)
+	return ids
+}
+
+func decodeBody(w http.ResponseWriter, r *http.Request) (map[string]any, bool) {
+	body := make(map[string]any)
+	if r.Body == nil || r.ContentLength == 0 {
+		return body, true
+	}
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		writeError(w, http.StatusBadRequest, err.Error())
+		return nil, false
+	}
+	return body, true
+}
+
+func newId() string {
+	b := make([]byte, 16)
+	_, _ = rand.Read(b)
+	b[6] = (b[6] & 0x0f) | 0x40
+	b[8] = (b[8] & 0x3f) | 0x80
+	return fmt.Sprintf("%x-%x-%x-%x-%x", b[0:4], b[4:6], b[6:8], b[8:10], b[10:])
+}
@@ -0,0 +1,216 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+package mockserver
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+)
+
+func (s *Server) registerDefaultCollections() {
+	s.Register(&Collection{
+		Service:       "vpc",
+		Name:          "vpcs",
+		Key:           "vpc",
+		ListKey:       "vpcs",
+		StatusField:   "provisioning_status",
+		PendingStatus: "PENDING_CREATE",
+		ReadyStatus:   "ACTIVE",
+		PendingReads:  1,
+		OnCreate: func(obj map[string]any) {
+			delete(obj, "subnet")
+			obj["region"] = Region
+			obj["project_name"] = "mock-project"
+			obj["is_default"] = false
+			obj["is_enable_dns_support"] = true
+			obj["igw"] = map[string]any{
+				"id":                  newId(),
+				"name":                "igw",
+				"region":              Region,
+				"project_id":          ProjectId,
+				"operating_status":    "ACTIVE",
+				"provisioning_status": "ACTIVE",
+				"created_at":          obj["created_at"],
+				"updated_at":          obj["updated_at"],
+			}
+			obj["default_route_table"] = map[string]any{
+				"id":                  newId(),
+				"name":                "default",
+				"provisioning_status": "ACTIVE",
+				"created_at":          obj["created_at"],
+				"updated_at":          obj["updated_at"],
+			}
+		},
+	})
+
+	s.Register(&Collection{
+		Service:       "vpc",
+		Name:          "subnets",
+		Key:           "subnet",
+		ListKey:       "subnets",
+		StatusField:   "provisioning_status",
+		PendingStatus: "PENDING_CREATE",
+		ReadyStatus:   "ACTIVE",
+		PendingReads:  1,
+		OnCreate: func(obj map[string]any) {
+			obj["operating_status"] = "ACTIVE"
+			obj["is_shared"] = false
+		},
+	})
+
+	s.Register(&Collection{
+		Service: "network",
+		Name:    "security-groups",
+		Key:     "security_group",
+		ListKey: "security_groups",
+		OnCreate: func(obj map[string]any) {
+			if _, ok := obj["rules"]; !ok {
+				obj["rules"] = []any{}
+			}
+		},
+	})
+
+	s.Register(&Collection{
+		Service:       "volume",
+		Name:          "volumes",
+		Key:           "volume",
+		ListKey:       "volumes",
+		StatusField:   "status",
+		PendingStatus: "creating",
+		ReadyStatus:   "available",
+		PendingReads:  1,
+		OnCreate: func(obj map[string]any) {
+			obj["type"] = "ssd"
+			obj["volume_type"] = "SSD"
+			obj["is_root"] = false
+			obj["is_bootable"] = false
+			obj["is_encrypted"] = false
+			obj["attach_status"] = "detached"
+			if _, ok := obj["metadata"]; !ok {
+				obj["metadata"] = map[string]any{}
+			}
+		},
+		Actions: map[string]func(obj map[string]any, body map[string]any) int{
+			"extend": func(obj map[string]any, body map[string]any) int {
+				if v, ok := body["volume"].(map[string]any); ok {
+					obj["size"] = v["new_size"]
+				}
+				return http.StatusNoContent
+			},
+		},
+	})
+
+	s.Register(&Collection{
+		Service:       "bcs",
+		Name:          "instances",
+		Key:           "instance",
+		ListKey:       "instances",
+		StatusField:   "status",
+		PendingStatus: "building",
+		ReadyStatus:   "active",
+		PendingReads:  2,
+		Transitions: map[string]string{
+			"reboot":      "active",
+			"hard_reboot": "active",
+		},
+		OnCreate: func(obj map[string]any) {
+			obj["vm_state"] = "active"
+			obj["power_state"] = "running"
+			obj["attached_volumes"] = []any{}
+		},
+		OnAction: serveInstanceAction,
+		Children: map[string]func(obj map[string]any, method string, childId string, body map[string]any) int{
+			"volumes": serveInstanceVolume,
+		},
+	})
+
+	s.Register(&Collection{
+		Service:       "load-balancer",
+		Name:          "load-balancers",
+		Key:           "load_balancer",
+		ListKey:       "load_balancers",
+		StatusField:   "provisioning_status",
+		PendingStatus: "PENDING_CREATE",
+		ReadyStatus:   "ACTIVE",
+		PendingReads:  2,
+		OnCreate: func(obj map[string]any) {
+			obj["operating_status"] = "ONLINE"
+		},
+	})
+}
+
+// serveInstanceAction applies instance actions such as stop, start, reboot and resize, matched by
+// name so that /stop and /stop-instance are served the same way. Reboots pass through a reboot or
+// hard_reboot status first; other actions complete at once.
+func serveInstanceAction(obj map[string]any, name string, body map[string]any) int {
+	switch name = strings.ToLower(name); {
+	case strings.Contains(name, "hard") && strings.Contains(name, "reboot"):
+		obj["status"] = "hard_reboot"
+	case strings.Contains(name, "reboot"):
+		obj["status"] = "reboot"
+	case strings.Contains(name, "unshelve"), strings.Contains(name, "start"):
+		obj["status"] = "active"
+		obj["power_state"] = "running"
+	case strings.Contains(name, "shelve"):
+		obj["status"] = "shelved"
+		obj["power_state"] = "shutdown"
+	case strings.Contains(name, "stop"):
+		obj["status"] = "stopped"
+		obj["power_state"] = "shutdown"
+	case strings.Contains(name, "resize"):
+		if flavor, ok := body["flavor"].(map[string]any); ok {
+			obj["flavor"] = map[string]any{"id": flavor["id"]}
+		}
+		obj["status"] = "stopped"
+	default:
+		return http.StatusNotFound
+	}
+	return http.StatusNoContent
+}
+
+// serveInstanceVolume attaches, updates and detaches volumes in the instance attached_volumes list.
+// Attached volumes are in-use at once and get the next free mount point.
+func serveInstanceVolume(obj map[string]any, method string, volumeId string, body map[string]any) int {
+	attached, _ := obj["attached_volumes"].([]any)
+	index := -1
+	for i, v := range attached {
+		if v.(map[string]any)["id"] == volumeId {
+			index = i
+		}
+	}
+
+	settings, _ := body["volume"].(map[string]any)
+	switch method {
+	case http.MethodPost:
+		if index >= 0 {
+			return http.StatusConflict
+		}
+		volume := map[string]any{
+			"id":                       volumeId,
+			"status":                   "in-use",
+			"mount_point":              fmt.Sprintf("/dev/vd%c", 'b'+len(attached)),
+			"is_delete_on_termination": false,
+		}
+		if v, ok := settings["is_delete_on_termination"]; ok {
+			volume["is_delete_on_termination"] = v
+		}
+		obj["attached_volumes"] = append(attached, volume)
+		return http.StatusNoContent
+	case http.MethodPut, http.MethodPatch:
+		if index < 0 {
+			return http.StatusNotFound
+		}
+		if v, ok := settings["is_delete_on_termination"]; ok {
+			attached[index].(map[string]any)["is_delete_on_termination"] = v
+		}
+		return http.StatusNoContent
+	case http.MethodDelete:
+		if index < 0 {
+			return http.StatusNotFound
+		}
+		obj["attached_volumes"] = append(attached[:index:index], attached[index+1:]...)
+		return http.StatusNoContent
+	}
+	return http.StatusMethodNotAllowed
+}
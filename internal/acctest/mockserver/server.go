@@ -0,0 +1,333 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+package mockserver
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+const (
+	CredentialId     = "mock-credential-id"
+	CredentialSecret = "mock-credential-secret"
+	Token            = "mock-token"
+	ProjectId        = "mock-project-id"
+	Region           = "kr-central-2"
+)
+
+var AvailabilityZones = []string{"kr-central-2-a", "kr-central-2-b", "kr-central-2-c"}
+
+// Services lists the path prefixes served by the mock, keyed by the name used in the
+// config API service_endpoints response.
+var Services = []string{"iam", "vpc", "network", "bcs", "volume", "image", "load-balancer"}
+
+var requestSeq atomic.Int64
+
+type Server struct {
+	*httptest.Server
+
+	mu          sync.Mutex
+	collections []*Collection
+	requests    []string
+	failures    map[string][]int
+	tokenIssued int
+	scoped      map[string]int
+	credentials map[string]credential
+}
+
+type credential struct {
+	secret    string
+	projectId string
+}
+
+func New() *Server {
+	s := &Server{
+		failures: make(map[string][]int),
+		scoped:   make(map[string]int),
+		credentials: map[string]credential{
+			CredentialId: {secret: CredentialSecret, projectId: ProjectId},
+		},
+	}
+	s.Server = httptest.NewServer(http.HandlerFunc(s.serveHTTP))
+	s.registerDefaultCollections()
+	return s
+}
+
+func (s *Server) Endpoint(service string) string {
+	return s.URL + "/" + service
+}
+
+// Register adds a collection to the mock. Requests are routed to the first collection whose
+// service prefix and name appear in the request path.
+func (s *Server) Register(c *Collection) *Collection {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	c.mu = &s.mu
+	c.objects = make(map[string]*object)
+	s.collections = append(s.collections, c)
+	return c
+}
+
+func (s *Server) Collection(service string, name string) *Collection {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for _, c := range s.collections {
+		if c.Service == service && c.Name == name {
+			return c
+		}
+	}
+	return nil
+}
+
+// FailNext makes the next requests matching method and path suffix answer with the given status codes, in order.
+func (s *Server) FailNext(method string, pathSuffix string, statusCodes ...int) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	key := method + " " + pathSuffix
+	s.failures[key] = append(s.failures[key], statusCodes...)
+}
+
+func (s *Server) Requests() []string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return append([]string(nil), s.requests...)
+}
+
+func (s *Server) TokensIssued() int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.tokenIssued
+}
+
+// AddCredential registers an application credential of projectId. Like the real identity API, the
+// mock issues its tokens for that project only.
+func (s *Server) AddCredential(id string, secret string, projectId string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.credentials[id] = credential{secret: secret, projectId: projectId}
+}
+
+// ProjectTokensIssued reports how many tokens were issued for projectId with a credential added
+// by AddCredential.
+func (s *Server) ProjectTokensIssued(projectId string) int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.scoped[projectId]
+}
+
+// ProjectToken is the token the mock issues for a credential of projectId.
+func ProjectToken(projectId string) string {
+	if projectId == "" || projectId == ProjectId {
+		return Token
+	}
+	return Token + "." + projectId
+}
+
+func projectFromToken(token string) (string, bool) {
+	if token == Token {
+		return ProjectId, true
+	}
+	projectId, ok := strings.CutPrefix(token, Token+".")
+	return projectId, ok && projectId != ""
+}
+
+func (s *Server) serveHTTP(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	s.requests = append(s.requests, r.Method+" "+r.URL.Path)
+	for key, codes := range s.failures {
+		method, suffix, _ := strings.Cut(key, " ")
+		if method == r.Method && strings.HasSuffix(r.URL.Path, suffix) && len(codes) > 0 {
+			s.failures[key] = codes[1:]
+			s.mu.Unlock()
+			writeError(w, codes[0], "injected failure")
+			return
+		}
+	}
+	s.mu.Unlock()
+
+	segments := splitPath(r.URL.Path)
+	if len(segments) == 0 {
+		writeError(w, http.StatusNotFound, "unknown path")
+		return
+	}
+
+	switch segments[0] {
+	case "iam":
+		s.serveIdentity(w, r, segments[1:])
+		return
+	case "config":
+		s.serveConfig(w, r, segments[1:])
+		return
+	}
+
+	if _, ok := projectFromToken(r.Header.Get("X-Auth-Token")); !ok {
+		writeError(w, http.StatusUnauthorized, "invalid token")
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for _, c := range s.collections {
+		if c.Service != segments[0] {
+			continue
+		}
+		if idx := c.match(segments[1:]); idx >= 0 {
+			c.serve(w, r, segments[1+idx+1:])
+			return
+		}
+	}
+	writeError(w, http.StatusNotFound, fmt.Sprintf("no mock collection for %s", r.URL.Path))
+}
+
+func (s *Server) serveIdentity(w http.ResponseWriter, r *http.Request, segments []string) {
+	if len(segments) < 2 || segments[len(segments)-2] != "auth" || segments[len(segments)-1] != "tokens" {
+		writeError(w, http.StatusNotFound, "unknown identity path")
+		return
+	}
+
+	switch r.Method {
+	case http.MethodPost:
+		var body struct {
+			Auth struct {
+				Identity struct {
+					ApplicationCredential struct {
+						Id     string `json:"id"`
+						Secret string `json:"secret"`
+					} `json:"application_credential"`
+				} `json:"identity"`
+				Scope struct {
+					Project struct {
+						Id string `json:"id"`
+					} `json:"project"`
+				} `json:"scope"`
+			} `json:"auth"`
+		}
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			writeError(w, http.StatusBadRequest, err.Error())
+			return
+		}
+		if body.Auth.Scope.Project.Id != "" {
+			writeError(w, http.StatusUnauthorized, "application credentials cannot request a scope")
+			return
+		}
+
+		s.mu.Lock()
+		cred, ok := s.credentials[body.Auth.Identity.ApplicationCredential.Id]
+		if !ok || cred.secret != body.Auth.Identity.ApplicationCredential.Secret {
+			s.mu.Unlock()
+			writeError(w, http.StatusUnauthorized, "invalid application credential")
+			return
+		}
+		s.tokenIssued++
+		if cred.projectId != ProjectId {
+			s.scoped[cred.projectId]++
+		}
+		s.mu.Unlock()
+
+		projectId := cred.projectId
+		token := ProjectToken(projectId)
+		w.Header().Set("X-Subject-Token", token)
+		writeJSON(w, http.StatusCreated, map[string]any{
+			"token": map[string]any{
+				"expires_at": time.Now().Add(12 * time.Hour).UTC().Format(time.RFC3339),
+				"project": map[string]any{
+					"id": projectId,
+				},
+			},
+		})
+	case http.MethodGet, http.MethodHead:
+		projectId, ok := projectFromToken(r.Header.Get("X-Subject-Token"))
+		if !ok {
+			writeError(w, http.StatusNotFound, "token not found")
+			return
+		}
+		writeJSON(w, http.StatusOK, map[string]any{
+			"token": map[string]any{
+				"expires_at": time.Now().Add(12 * time.Hour).UTC().Format(time.RFC3339),
+				"project": map[string]any{
+					"id": projectId,
+				},
+			},
+		})
+	default:
+		writeError(w, http.StatusMethodNotAllowed, "method not allowed")
+	}
+}
+
+func (s *Server) serveConfig(w http.ResponseWriter, r *http.Request, segments []string) {
+	if r.Method != http.MethodGet {
+		writeError(w, http.StatusMethodNotAllowed, "method not allowed")
+		return
+	}
+	last := ""
+	if len(segments) > 0 {
+		last = strings.ToLower(segments[len(segments)-1])
+	}
+
+	switch {
+	case strings.Contains(last, "endpoint"):
+		serviceEndpoints := make(map[string]string, len(Services))
+		for _, svc := range Services {
+			serviceEndpoints[svc] = s.Endpoint(svc)
+		}
+		writeJSON(w, http.StatusOK, map[string]any{
+			"data": map[string]any{
+				"service_endpoints": serviceEndpoints,
+			},
+		})
+	case strings.Contains(last, "az"):
+		services := make(map[string][]string, len(Services))
+		for _, svc := range Services {
+			services[svc] = AvailabilityZones
+		}
+		writeJSON(w, http.StatusOK, map[string]any{
+			"data": map[string]any{
+				"services": services,
+			},
+		})
+	default:
+		writeError(w, http.StatusNotFound, "unknown config path")
+	}
+}
+
+func splitPath(p string) []string {
+	var segments []string
+	for _, seg := range strings.Split(p, "/") {
+		if seg != "" {
+			segments = append(segments, seg)
+		}
+	}
+	return segments
+}
+
+func writeJSON(w http.ResponseWriter, status int, body any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	if body != nil {
+		_ = json.NewEncoder(w).Encode(body)
+	}
+}
+
+func writeError(w http.ResponseWriter, status int, message string) {
+	w.Header().Set("X-Request-Id", fmt.Sprintf("req-%08d", requestSeq.Add(1)))
+	writeJSON(w, status, map[string]any{
+		"error": map[string]any{
+			"code":    http.StatusText(status),
+			"message": message,
+		},
+	})
+}
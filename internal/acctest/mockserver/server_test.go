@@ -0,0 +1,351 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+package mockserver
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+)
+
+func doRequest(t *testing.T, method string, url string, body any, headers map[string]string) (*http.Response, map[string]any) {
+	t.Helper()
+
+	var reader *bytes.Reader
+	if body != nil {
+		b, err := json.Marshal(body)
+		if err != nil {
+			t.Fatal(err)
+		}
+		reader = bytes.NewReader(b)
+	} else {
+		reader = bytes.NewReader(nil)
+	}
+
+	req, err := http.NewRequest(method, url, reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	for k, v := range headers {
+		req.Header.Set(k, v)
+	}
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer resp.Body.Close()
+
+	decoded := map[string]any{}
+	_ = json.NewDecoder(resp.Body).Decode(&decoded)
+	return resp, decoded
+}
+
+func TestIssueToken(t *testing.T) {
+	server := New()
+	defer server.Close()
+
+	body := map[string]any{
+		"auth": map[string]any{
+			"identity": map[string]any{
+				"methods": []string{"application_credential"},
+				"application_credential": map[string]any{
+					"id":     CredentialId,
+					"secret": CredentialSecret,
+				},
+			},
+		},
+	}
+	resp, decoded := doRequest(t, http.MethodPost, server.Endpoint("iam")+"/identity/v3/auth/tokens", body, nil)
+	if resp.StatusCode != http.StatusCreated {
+		t.Fatalf("StatusCode = %d, want %d", resp.StatusCode, http.StatusCreated)
+	}
+	if got := resp.Header.Get("X-Subject-Token"); got != Token {
+		t.Errorf("X-Subject-Token = %q, want %q", got, Token)
+	}
+	if _, ok := decoded["token"].(map[string]any)["expires_at"]; !ok {
+		t.Errorf("response has no expires_at: %v", decoded)
+	}
+
+	body["auth"].(map[string]any)["identity"].(map[string]any)["application_credential"].(map[string]any)["secret"] = "wrong"
+	resp, _ = doRequest(t, http.MethodPost, server.Endpoint("iam")+"/identity/v3/auth/tokens", body, nil)
+	if resp.StatusCode != http.StatusUnauthorized {
+		t.Errorf("StatusCode = %d, want %d for a wrong secret", resp.StatusCode, http.StatusUnauthorized)
+	}
+}
+
+func TestIssueToken_projectCredential(t *testing.T) {
+	server := New()
+	defer server.Close()
+
+	const otherProject = "0123456789abcdef0123456789abcdef"
+	server.AddCredential("other-credential-id", "other-credential-secret", otherProject)
+
+	credentialBody := func(id, secret string) map[string]any {
+		return map[string]any{
+			"auth": map[string]any{
+				"identity": map[string]any{
+					"methods": []string{"application_credential"},
+					"application_credential": map[string]any{
+						"id":     id,
+						"secret": secret,
+					},
+				},
+			},
+		}
+	}
+
+	// Application credentials are bound to their project and cannot request another scope.
+	scoped := credentialBody(CredentialId, CredentialSecret)
+	scoped["auth"].(map[string]any)["scope"] = map[string]any{
+		"project": map[string]any{"id": otherProject},
+	}
+	resp, _ := doRequest(t, http.MethodPost, server.Endpoint("iam")+"/identity/v3/auth/tokens", scoped, nil)
+	if resp.StatusCode != http.StatusUnauthorized {
+		t.Errorf("StatusCode = %d, want %d for an explicit scope", resp.StatusCode, http.StatusUnauthorized)
+	}
+
+	resp, decoded := doRequest(t, http.MethodPost, server.Endpoint("iam")+"/identity/v3/auth/tokens", credentialBody("other-credential-id", "other-credential-secret"), nil)
+	token := resp.Header.Get("X-Subject-Token")
+	if token != ProjectToken(otherProject) {
+		t.Fatalf("X-Subject-Token = %q, want %q", token, ProjectToken(otherProject))
+	}
+	if got := decoded["token"].(map[string]any)["project"].(map[string]any)["id"]; got != otherProject {
+		t.Errorf("token project = %v, want %s", got, otherProject)
+	}
+	if got := server.ProjectTokensIssued(otherProject); got != 1 {
+		t.Errorf("ProjectTokensIssued() = %d, want 1", got)
+	}
+
+	resp, decoded = doRequest(t, http.MethodPost, server.Endpoint("vpc")+"/api/v1/vpcs", map[string]any{
+		"vpc": map[string]any{"name": "scoped"},
+	}, map[string]string{"X-Auth-Token": token})
+	if resp.StatusCode != http.StatusCreated {
+		t.Fatalf("create StatusCode = %d", resp.StatusCode)
+	}
+	if got := decoded["vpc"].(map[string]any)["project_id"]; got != otherProject {
+		t.Errorf("project_id = %v, want %s", got, otherProject)
+	}
+}
+
+func TestConfigEndpoints(t *testing.T) {
+	server := New()
+	defer server.Close()
+
+	_, decoded := doRequest(t, http.MethodGet, server.Endpoint("config")+"/api/v1/client-endpoint", nil, nil)
+	endpoints := decoded["data"].(map[string]any)["service_endpoints"].(map[string]any)
+	if endpoints["vpc"] != server.Endpoint("vpc") {
+		t.Errorf("vpc endpoint = %v, want %s", endpoints["vpc"], server.Endpoint("vpc"))
+	}
+
+	_, decoded = doRequest(t, http.MethodGet, server.Endpoint("config")+"/api/v1/az-policy", nil, nil)
+	services := decoded["data"].(map[string]any)["services"].(map[string]any)
+	if zones := services["bcs"].([]any); len(zones) != len(AvailabilityZones) {
+		t.Errorf("bcs zones = %v", zones)
+	}
+}
+
+func TestCollectionLifecycle(t *testing.T) {
+	server := New()
+	defer server.Close()
+
+	auth := map[string]string{"X-Auth-Token": Token}
+	base := server.Endpoint("volume") + "/api/v1/volumes"
+
+	resp, _ := doRequest(t, http.MethodGet, base, nil, nil)
+	if resp.StatusCode != http.StatusUnauthorized {
+		t.Fatalf("StatusCode = %d, want %d without a token", resp.StatusCode, http.StatusUnauthorized)
+	}
+
+	resp, decoded := doRequest(t, http.MethodPost, base, map[string]any{
+		"volume": map[string]any{"name": "vol", "size": 10},
+	}, auth)
+	if resp.StatusCode != http.StatusCreated {
+		t.Fatalf("create StatusCode = %d", resp.StatusCode)
+	}
+	created := decoded["volume"].(map[string]any)
+	id := created["id"].(string)
+	if created["status"] != "creating" {
+		t.Errorf("status after create = %v, want creating", created["status"])
+	}
+
+	_, decoded = doRequest(t, http.MethodGet, base+"/"+id, nil, auth)
+	if got := decoded["volume"].(map[string]any)["status"]; got != "creating" {
+		t.Errorf("status on first read = %v, want creating", got)
+	}
+	_, decoded = doRequest(t, http.MethodGet, base+"/"+id, nil, auth)
+	if got := decoded["volume"].(map[string]any)["status"]; got != "available" {
+		t.Errorf("status on second read = %v, want available", got)
+	}
+
+	_, decoded = doRequest(t, http.MethodPut, base+"/"+id, map[string]any{
+		"volume": map[string]any{"name": "renamed"},
+	}, auth)
+	if got := decoded["volume"].(map[string]any)["name"]; got != "renamed" {
+		t.Errorf("name after update = %v", got)
+	}
+
+	resp, _ = doRequest(t, http.MethodPost, base+"/"+id+"/extend", map[string]any{
+		"volume": map[string]any{"new_size": 20},
+	}, auth)
+	if resp.StatusCode != http.StatusNoContent {
+		t.Errorf("extend StatusCode = %d", resp.StatusCode)
+	}
+	if obj, _ := server.Collection("volume", "volumes").Get(id); obj["size"] != float64(20) {
+		t.Errorf("size after extend = %v", obj["size"])
+	}
+
+	resp, _ = doRequest(t, http.MethodDelete, base+"/"+id, nil, auth)
+	if resp.StatusCode != http.StatusNoContent {
+		t.Errorf("delete StatusCode = %d", resp.StatusCode)
+	}
+	resp, _ = doRequest(t, http.MethodGet, base+"/"+id, nil, auth)
+	if resp.StatusCode != http.StatusNotFound {
+		t.Errorf("StatusCode after delete = %d, want %d", resp.StatusCode, http.StatusNotFound)
+	}
+}
+
+func TestCollectionChildren(t *testing.T) {
+	server := New()
+	defer server.Close()
+
+	instances := server.Collection("bcs", "instances")
+	instances.Put("instance-1", map[string]any{"id": "instance-1", "attached_volumes": []any{}})
+
+	auth := map[string]string{"X-Auth-Token": Token}
+	url := server.Endpoint("bcs") + "/api/v1/instances/instance-1/volumes/volume-1"
+
+	resp, _ := doRequest(t, http.MethodPost, url, map[string]any{
+		"volume": map[string]any{"is_delete_on_termination": true},
+	}, auth)
+	if resp.StatusCode != http.StatusNoContent {
+		t.Fatalf("attach StatusCode = %d", resp.StatusCode)
+	}
+	obj, _ := instances.Get("instance-1")
+	attached := obj["attached_volumes"].([]any)
+	if len(attached) != 1 {
+		t.Fatalf("got %d attached volumes, want 1", len(attached))
+	}
+	volume := attached[0].(map[string]any)
+	if volume["mount_point"] != "/dev/vdb" || volume["is_delete_on_termination"] != true {
+		t.Errorf("attached volume = %v", volume)
+	}
+
+	resp, _ = doRequest(t, http.MethodPost, url, nil, auth)
+	if resp.StatusCode != http.StatusConflict {
+		t.Errorf("second attach StatusCode = %d, want %d", resp.StatusCode, http.StatusConflict)
+	}
+
+	resp, _ = doRequest(t, http.MethodDelete, url, nil, auth)
+	if resp.StatusCode != http.StatusNoContent {
+		t.Fatalf("detach StatusCode = %d", resp.StatusCode)
+	}
+	if obj, _ := instances.Get("instance-1"); len(obj["attached_volumes"].([]any)) != 0 {
+		t.Errorf("attached volumes after detach = %v", obj["attached_volumes"])
+	}
+
+	resp, _ = doRequest(t, http.MethodDelete, url, nil, auth)
+	if resp.StatusCode != http.StatusNotFound {
+		t.Errorf("second detach StatusCode = %d, want %d", resp.StatusCode, http.StatusNotFound)
+	}
+}
+
+func TestInstanceActions(t *testing.T) {
+	server := New()
+	defer server.Close()
+
+	instances := server.Collection("bcs", "instances")
+	instances.Put("instance-1", map[string]any{"id": "instance-1", "status": "active"})
+
+	auth := map[string]string{"X-Auth-Token": Token}
+	base := server.Endpoint("bcs") + "/api/v1/instances/instance-1"
+	for _, step := range []struct {
+		action string
+		want   string
+	}{
+		{"stop", "stopped"},
+		{"resize", "stopped"},
+		{"start", "active"},
+		{"hard-reboot", "hard_reboot"},
+		{"reboot", "reboot"},
+		{"shelve", "shelved"},
+		{"unshelve", "active"},
+	} {
+		resp, _ := doRequest(t, http.MethodPost, base+"/"+step.action, map[string]any{
+			"flavor": map[string]any{"id": "flavor-2"},
+		}, auth)
+		if resp.StatusCode != http.StatusNoContent {
+			t.Fatalf("%s StatusCode = %d", step.action, resp.StatusCode)
+		}
+		_, decoded := doRequest(t, http.MethodGet, base, nil, auth)
+		if got := decoded["instance"].(map[string]any)["status"]; got != step.want {
+			t.Errorf("status after %s = %v, want %s", step.action, got, step.want)
+		}
+	}
+	if obj, _ := instances.Get("instance-1"); obj["flavor"].(map[string]any)["id"] != "flavor-2" {
+		t.Errorf("flavor after resize = %v", obj["flavor"])
+	}
+
+	doRequest(t, http.MethodPost, base+"/reboot", nil, auth)
+	var statuses []any
+	for i := 0; i < 3; i++ {
+		_, decoded := doRequest(t, http.MethodGet, base, nil, auth)
+		statuses = append(statuses, decoded["instance"].(map[string]any)["status"])
+	}
+	if statuses[0] != "reboot" || statuses[2] != "active" {
+		t.Errorf("statuses after reboot = %v, want reboot settling to active", statuses)
+	}
+
+	resp, _ := doRequest(t, http.MethodPost, base+"/rebuild", nil, auth)
+	if resp.StatusCode != http.StatusNotFound {
+		t.Errorf("unknown action StatusCode = %d, want %d", resp.StatusCode, http.StatusNotFound)
+	}
+}
+
+func TestCollectionPaging(t *testing.T) {
+	server := New()
+	defer server.Close()
+
+	vpcs := server.Collection("vpc", "vpcs")
+	for i := 0; i < 5; i++ {
+		id := fmt.Sprintf("vpc-%d", i)
+		vpcs.Put(id, map[string]any{"id": id})
+	}
+
+	auth := map[string]string{"X-Auth-Token": Token}
+	_, decoded := doRequest(t, http.MethodGet, server.Endpoint("vpc")+"/api/v1/vpcs?limit=2&offset=3", nil, auth)
+	items := decoded["vpcs"].([]any)
+	if len(items) != 2 {
+		t.Fatalf("got %d items, want 2", len(items))
+	}
+	if got := items[0].(map[string]any)["id"]; got != "vpc-3" {
+		t.Errorf("first item = %v, want vpc-3", got)
+	}
+
+	vpcs.MaxLimit = 3
+	_, decoded = doRequest(t, http.MethodGet, server.Endpoint("vpc")+"/api/v1/vpcs?limit=1000", nil, auth)
+	if items := decoded["vpcs"].([]any); len(items) != 3 {
+		t.Errorf("got %d items with MaxLimit, want 3", len(items))
+	}
+	if total := decoded["pagination"].(map[string]any)["total"]; total != float64(5) {
+		t.Errorf("pagination.total = %v, want 5", total)
+	}
+}
+
+func TestFailNext(t *testing.T) {
+	server := New()
+	defer server.Close()
+
+	auth := map[string]string{"X-Auth-Token": Token}
+	server.FailNext(http.MethodGet, "/vpcs", http.StatusTooManyRequests, http.StatusInternalServerError)
+
+	for _, want := range []int{http.StatusTooManyRequests, http.StatusInternalServerError, http.StatusOK} {
+		resp, _ := doRequest(t, http.MethodGet, server.Endpoint("vpc")+"/api/v1/vpcs", nil, auth)
+		if resp.StatusCode != want {
+			t.Errorf("StatusCode = %d, want %d", resp.StatusCode, want)
+		}
+	}
+}
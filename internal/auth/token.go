@@ -5,7 +5,6 @@ package auth
 import (
 	"context"
 	"fmt"
-	"strings"
 	"sync"
 	"time"
 
@@ -13,53 +12,94 @@ import (
 )
 
 type TokenManager struct {
-	mutex            sync.Mutex
-	credentialID     string
-	credentialSecret string
-	currentToken     string
-	expiresAt        time.Time
-	identityAPI      auth.IdentityAPI
+	mutex              sync.Mutex
+	credential         Credential
+	projectCredentials map[string]Credential
+	projectID          string
+	ownProjectID       string
+	tokens             map[string]*projectToken
+	identityAPI        auth.IdentityAPI
 }
 
-func NewTokenManager(identityAPI auth.IdentityAPI, credentialID, credentialSecret string) *TokenManager {
+// Credential is an application credential. It is bound to the project it was created in, and the
+// identity API rejects a request to scope its tokens to any other project.
+type Credential struct {
+	ID     string
+	Secret string
+}
+
+type projectToken struct {
+	token     string
+	expiresAt time.Time
+}
+
+// NewTokenManager returns a token manager whose default tokens are for projectID, or for the
+// application credential's own project when projectID is empty. Tokens for a project other than
+// the credential's own are issued with the matching entry of projectCredentials.
+func NewTokenManager(identityAPI auth.IdentityAPI, credential Credential, projectID string, projectCredentials map[string]Credential) *TokenManager {
 	return &TokenManager{
-		credentialID:     credentialID,
-		credentialSecret: credentialSecret,
-		identityAPI:      identityAPI,
+		credential:         credential,
+		projectCredentials: projectCredentials,
+		projectID:          projectID,
+		tokens:             make(map[string]*projectToken),
+		identityAPI:        identityAPI,
 	}
 }
 
 func (tm *TokenManager) GetValidToken(ctx context.Context) (string, error) {
+	return tm.GetValidProjectToken(ctx, "")
+}
+
+// GetValidProjectToken returns a cached token for projectID, issuing a new one when needed.
+// An empty projectID selects the default project of the token manager.
+func (tm *TokenManager) GetValidProjectToken(ctx context.Context, projectID string) (string, error) {
 	tm.mutex.Lock()
 
-	if tm.currentToken != "" && time.Now().Add(5*time.Minute).Before(tm.expiresAt) {
+	projectID = tm.resolveProject(projectID)
+	current := tm.tokens[projectID]
+	if current != nil && time.Now().Add(5*time.Minute).Before(current.expiresAt) {
 		tm.mutex.Unlock()
-		return tm.currentToken, nil
+		return current.token, nil
 	}
 
-	if tm.currentToken != "" {
-		if isValid, err := tm.validateToken(ctx, tm.currentToken); err == nil && isValid {
+	if current != nil {
+		if isValid, err := tm.validateToken(ctx, current.token); err == nil && isValid {
 			tm.mutex.Unlock()
-			return tm.currentToken, nil
+			return current.token, nil
 		}
 	}
 
 	tm.mutex.Unlock()
 
-	return tm.IssueNewToken(ctx)
+	return tm.IssueNewProjectToken(ctx, projectID)
 }
 
 func (tm *TokenManager) IssueNewToken(ctx context.Context) (string, error) {
+	return tm.IssueNewProjectToken(ctx, "")
+}
+
+func (tm *TokenManager) IssueNewProjectToken(ctx context.Context, projectID string) (string, error) {
 	tm.mutex.Lock()
 	defer tm.mutex.Unlock()
 
+	projectID = tm.resolveProject(projectID)
+	credential, ok := tm.projectCredentials[projectID]
+	ownCredential := !ok
+	if ownCredential {
+		if projectID != "" && tm.ownProjectID != "" {
+			return "", missingProjectCredentialError(tm.ownProjectID, projectID)
+		}
+		credential = tm.credential
+	}
+
+	// Application credentials are bound to one project, so the request never carries a scope.
 	authReq := auth.SwaggerIdPwdRequest{
 		Auth: &auth.SwaggerAuth{
 			Identity: &auth.SwaggerIdPwd{
 				Methods: []string{"application_credential"},
 				ApplicationCredential: &auth.SwaggerApplicationCredential{
-					Id:     &tm.credentialID,
-					Secret: &tm.credentialSecret,
+					Id:     &credential.ID,
+					Secret: &credential.Secret,
 				},
 			},
 		},
@@ -67,6 +107,9 @@ func (tm *TokenManager) IssueNewToken(ctx context.Context) (string, error) {
 
 	resp, httpResp, err := tm.identityAPI.IssueToken(ctx).SwaggerIdPwdRequest(authReq).Execute()
 	if err != nil {
+		if projectID != "" {
+			return "", fmt.Errorf("failed to issue token for project %s: %w", projectID, err)
+		}
 		return "", fmt.Errorf("failed to issue token: %w", err)
 	}
 
@@ -84,10 +127,10 @@ func (tm *TokenManager) IssueNewToken(ctx context.Context) (string, error) {
 		return "", fmt.Errorf("no token found in response headers")
 	}
 
-	tm.currentToken = token
+	issued := &projectToken{token: token}
 	if resp != nil && resp.Token != nil && resp.Token.ExpiresAt != nil {
 		if expiresAt, err := time.Parse(time.RFC3339, *resp.Token.ExpiresAt); err == nil {
-			tm.expiresAt = expiresAt
+			issued.expiresAt = expiresAt
 		} else {
 			return "", fmt.Errorf("failed to parse token expiration time: %w", err)
 		}
@@ -95,9 +138,30 @@ func (tm *TokenManager) IssueNewToken(ctx context.Context) (string, error) {
 		return "", fmt.Errorf("no expiration time found in token response")
 	}
 
+	tokenProjectID := ""
+	if resp.Token.Project != nil {
+		tokenProjectID = resp.Token.Project.GetId()
+	}
+	if projectID != "" && tokenProjectID != projectID {
+		if ownCredential {
+			return "", missingProjectCredentialError(tokenProjectID, projectID)
+		}
+		return "", fmt.Errorf("the project_credential for project %s belongs to project %s", projectID, tokenProjectID)
+	}
+
+	if ownCredential {
+		tm.ownProjectID = tokenProjectID
+		projectID = ""
+	}
+	tm.tokens[projectID] = issued
+
 	return token, nil
 }
 
+func missingProjectCredentialError(ownProjectID, projectID string) error {
+	return fmt.Errorf("the application credential belongs to project %s and cannot issue tokens for project %s: add a project_credential for project %s", ownProjectID, projectID, projectID)
+}
+
 func (tm *TokenManager) validateToken(ctx context.Context, token string) (bool, error) {
 	_, httpResp, err := tm.identityAPI.ValidateToken(ctx).
 		XAuthToken(token).
@@ -125,32 +189,26 @@ func (tm *TokenManager) validateToken(ctx context.Context, token string) (bool,
 }
 
 func (tm *TokenManager) InvalidateToken() {
+	tm.InvalidateProjectToken("")
+}
+
+func (tm *TokenManager) InvalidateProjectToken(projectID string) {
 	tm.mutex.Lock()
 	defer tm.mutex.Unlock()
-	tm.currentToken = ""
+	delete(tm.tokens, tm.resolveProject(projectID))
 }
 
-func IsAuthError(err error) bool {
-	if err == nil {
-		return false
+// resolveProject maps a requested project to its token cache key. The empty key holds tokens
+// of the provider's application credential, which also serve that credential's own project.
+func (tm *TokenManager) resolveProject(projectID string) string {
+	if projectID == "" {
+		projectID = tm.projectID
 	}
-
-	errStr := strings.ToLower(err.Error())
-	authErrorPatterns := []string{
-		"401",
-		"unauthorized",
-		"authentication",
-		"invalid token",
-		"token expired",
-		"access denied",
-		"forbidden",
+	if _, ok := tm.projectCredentials[projectID]; ok {
+		return projectID
 	}
-
-	for _, pattern := range authErrorPatterns {
-		if strings.Contains(errStr, pattern) {
-			return true
-		}
+	if projectID == tm.ownProjectID {
+		return ""
 	}
-
-	return false
+	return projectID
 }
@@ -0,0 +1,150 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+package common
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"strings"
+	"syscall"
+
+	"golang.org/x/net/http2"
+)
+
+// requestIDHeaders are checked in order for the ID KakaoCloud support uses to trace a request.
+var requestIDHeaders = []string{
+	"X-Request-Id",
+	"X-Openstack-Request-Id",
+	"X-Compute-Request-Id",
+}
+
+// APIError is an error response from the KakaoCloud API.
+type APIError struct {
+	StatusCode int
+	Code       string
+	Message    string
+	RequestID  string
+	Err        error
+}
+
+func (e *APIError) Error() string {
+	if e.Err != nil {
+		return e.Err.Error()
+	}
+	if e.Message != "" {
+		return fmt.Sprintf("%d %s: %s", e.StatusCode, http.StatusText(e.StatusCode), e.Message)
+	}
+	return fmt.Sprintf("%d %s", e.StatusCode, http.StatusText(e.StatusCode))
+}
+
+func (e *APIError) Unwrap() error {
+	return e.Err
+}
+
+// NewAPIError wraps err with the status, error body and request ID of resp. It returns err
+// unchanged when there is no error response to classify. The response body is restored so
+// it can still be read afterwards.
+func NewAPIError(resp *http.Response, err error) error {
+	if err == nil {
+		return nil
+	}
+	var apiErr *APIError
+	if errors.As(err, &apiErr) || resp == nil || resp.StatusCode < http.StatusBadRequest {
+		return err
+	}
+
+	apiErr = &APIError{
+		StatusCode: resp.StatusCode,
+		Err:        err,
+	}
+	for _, header := range requestIDHeaders {
+		if id := resp.Header.Get(header); id != "" {
+			apiErr.RequestID = id
+			break
+		}
+	}
+
+	if resp.Body != nil {
+		body, readErr := io.ReadAll(resp.Body)
+		_ = resp.Body.Close()
+		resp.Body = io.NopCloser(bytes.NewReader(body))
+
+		var parsed apiError
+		if readErr == nil && json.Unmarshal(body, &parsed) == nil {
+			apiErr.Code = parsed.Error.Code
+			apiErr.Message = parsed.Error.Message
+		}
+	}
+	return apiErr
+}
+
+func statusCodeOf(err error) int {
+	var apiErr *APIError
+	if errors.As(err, &apiErr) {
+		return apiErr.StatusCode
+	}
+	return 0
+}
+
+func IsNotFound(err error) bool {
+	return statusCodeOf(err) == http.StatusNotFound
+}
+
+func IsConflict(err error) bool {
+	return statusCodeOf(err) == http.StatusConflict
+}
+
+func IsThrottled(err error) bool {
+	return statusCodeOf(err) == http.StatusTooManyRequests
+}
+
+// IsAuthExpired reports whether the request was rejected because the token is no longer valid.
+// A 403 is a permission error and is not fixed by issuing a new token.
+func IsAuthExpired(err error) bool {
+	return statusCodeOf(err) == http.StatusUnauthorized
+}
+
+// IsServiceUnavailable reports whether a gateway in front of the API failed to serve the request.
+func IsServiceUnavailable(err error) bool {
+	switch statusCodeOf(err) {
+	case http.StatusBadGateway, http.StatusServiceUnavailable, http.StatusGatewayTimeout:
+		return true
+	}
+	return false
+}
+
+// http2GoAwayPrefix starts the message of the GOAWAY error of the HTTP/2 transport bundled in
+// net/http, whose type is not exported.
+const http2GoAwayPrefix = "http2: server sent GOAWAY"
+
+// IsTransientNetworkError reports whether the request failed below HTTP in a way that a new
+// attempt can fix: a network timeout, a reset connection, a broken pipe, a connection closed
+// mid-response, or an HTTP/2 GOAWAY. Failures such as DNS errors or refused connections are not transient, and
+// neither is the caller's context being done.
+func IsTransientNetworkError(err error) bool {
+	if err == nil || errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) {
+		return false
+	}
+	if statusCodeOf(err) != 0 {
+		return false
+	}
+
+	if errors.Is(err, io.EOF) || errors.Is(err, io.ErrUnexpectedEOF) ||
+		errors.Is(err, syscall.ECONNRESET) || errors.Is(err, syscall.EPIPE) {
+		return true
+	}
+
+	var netErr net.Error
+	if errors.As(err, &netErr) && netErr.Timeout() {
+		return true
+	}
+
+	var goAway http2.GoAwayError
+	return errors.As(err, &goAway) || strings.Contains(err.Error(), http2GoAwayPrefix)
+}
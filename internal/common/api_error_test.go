@@ -0,0 +1,128 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+package common
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/url"
+	"os"
+	"strings"
+	"syscall"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"golang.org/x/net/http2"
+)
+
+func errorResponse(status int, body string) *http.Response {
+	return &http.Response{
+		StatusCode: status,
+		Header:     http.Header{"X-Request-Id": []string{"req-123"}},
+		Body:       io.NopCloser(strings.NewReader(body)),
+	}
+}
+
+func TestNewAPIError(t *testing.T) {
+	resp := errorResponse(http.StatusConflict, `{"error":{"code":"Conflict","message":"vpc is in use"}}`)
+	sdkErr := errors.New("409 Conflict")
+
+	err := NewAPIError(resp, sdkErr)
+
+	var apiErr *APIError
+	if !errors.As(err, &apiErr) {
+		t.Fatalf("NewAPIError() = %T, want *APIError", err)
+	}
+	if apiErr.StatusCode != http.StatusConflict || apiErr.Code != "Conflict" || apiErr.Message != "vpc is in use" || apiErr.RequestID != "req-123" {
+		t.Errorf("NewAPIError() = %+v", apiErr)
+	}
+	if !errors.Is(err, sdkErr) || err.Error() != sdkErr.Error() {
+		t.Errorf("NewAPIError() does not wrap the SDK error: %v", err)
+	}
+	if body, _ := io.ReadAll(resp.Body); !strings.Contains(string(body), "vpc is in use") {
+		t.Errorf("response body was not restored: %q", body)
+	}
+	if NewAPIError(resp, err) != err {
+		t.Error("NewAPIError() re-wrapped an *APIError")
+	}
+
+	if got := NewAPIError(nil, sdkErr); got != sdkErr {
+		t.Errorf("NewAPIError(nil) = %v, want the original error", got)
+	}
+	if got := NewAPIError(resp, nil); got != nil {
+		t.Errorf("NewAPIError(resp, nil) = %v, want nil", got)
+	}
+}
+
+func TestAPIErrorClassification(t *testing.T) {
+	classify := func(status int, body string) error {
+		return NewAPIError(errorResponse(status, body), fmt.Errorf("%d %s", status, http.StatusText(status)))
+	}
+
+	forbidden := classify(http.StatusForbidden, `{"error":{"message":"access denied: token expired"}}`)
+	if IsAuthExpired(forbidden) {
+		t.Error("IsAuthExpired(403) = true, want false")
+	}
+	if !IsAuthExpired(classify(http.StatusUnauthorized, "")) {
+		t.Error("IsAuthExpired(401) = false, want true")
+	}
+	if IsAuthExpired(errors.New("GetVpc forbidden-401-vpc not found")) {
+		t.Error("IsAuthExpired() matched an untyped error")
+	}
+
+	if !IsNotFound(classify(http.StatusNotFound, "")) || IsNotFound(classify(http.StatusBadRequest, `{"error":{"message":"404"}}`)) {
+		t.Error("IsNotFound() misclassified")
+	}
+	if !IsConflict(classify(http.StatusConflict, "")) {
+		t.Error("IsConflict(409) = false, want true")
+	}
+	if !IsThrottled(classify(http.StatusTooManyRequests, "")) {
+		t.Error("IsThrottled(429) = false, want true")
+	}
+	if !IsServiceUnavailable(classify(http.StatusServiceUnavailable, "")) || IsServiceUnavailable(classify(http.StatusInternalServerError, "")) {
+		t.Error("IsServiceUnavailable() misclassified")
+	}
+}
+
+func TestIsTransientNetworkError(t *testing.T) {
+	for _, tc := range []struct {
+		name string
+		err  error
+		want bool
+	}{
+		{name: "reset", err: &url.Error{Op: "Get", URL: "https://example.com", Err: syscall.ECONNRESET}, want: true},
+		{name: "broken pipe", err: &url.Error{Op: "Post", URL: "https://example.com", Err: &net.OpError{Op: "write", Net: "tcp", Err: os.NewSyscallError("write", syscall.EPIPE)}}, want: true},
+		{name: "eof", err: fmt.Errorf("read body: %w", io.ErrUnexpectedEOF), want: true},
+		{name: "cancelled", err: &url.Error{Op: "Get", URL: "https://example.com", Err: context.Canceled}, want: false},
+		{name: "message only", err: errors.New("image EOF-builder not found"), want: false},
+		{name: "api error", err: NewAPIError(errorResponse(http.StatusNotFound, ""), errors.New("EOF")), want: false},
+		{name: "timeout", err: &url.Error{Op: "Get", URL: "https://example.com", Err: os.ErrDeadlineExceeded}, want: true},
+		{name: "goaway", err: &url.Error{Op: "Get", URL: "https://example.com", Err: http2.GoAwayError{ErrCode: http2.ErrCodeNo}}, want: true},
+		{name: "bundled goaway", err: errors.New("http2: server sent GOAWAY and closed the connection; LastStreamID=1, ErrCode=NO_ERROR, debug=\"\""), want: true},
+		{name: "refused", err: &url.Error{Op: "Get", URL: "https://example.com", Err: syscall.ECONNREFUSED}, want: false},
+		{name: "dns", err: &url.Error{Op: "Get", URL: "https://example.com", Err: &net.DNSError{Err: "no such host", Name: "example.com", IsNotFound: true}}, want: false},
+	} {
+		if got := IsTransientNetworkError(tc.err); got != tc.want {
+			t.Errorf("%s: IsTransientNetworkError() = %t, want %t", tc.name, got, tc.want)
+		}
+	}
+}
+
+func TestAddApiActionError_includesRequestID(t *testing.T) {
+	var diags diag.Diagnostics
+	resp := errorResponse(http.StatusInternalServerError, `{"error":{"code":"InternalError","message":"something went wrong"}}`)
+
+	AddApiActionError(context.Background(), nil, resp, "GetVpc", errors.New("500 Internal Server Error"), &diags)
+
+	if !diags.HasError() {
+		t.Fatal("expected an error diagnostic")
+	}
+	detail := diags.Errors()[0].Detail()
+	if !strings.Contains(detail, "something went wrong") || !strings.Contains(detail, "Request ID: req-123") {
+		t.Errorf("detail = %q", detail)
+	}
+}
@@ -6,69 +6,99 @@ import (
 	"context"
 	"fmt"
 	"net/http"
-	"terraform-provider-kakaocloud/internal/auth"
 	"time"
 
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
 
+// ExecuteWithRetryAndAuth runs operation against the rate limit budget of service, the key of
+// the API that operation calls.
 func ExecuteWithRetryAndAuth[T any](
 	ctx context.Context,
 	kc *KakaoCloudClient,
 	respDiags *diag.Diagnostics,
+	service string,
 	operation func() (T, *http.Response, error),
 ) (T, *http.Response, error) {
 	var zero T
 	maxAuthRetries := 2
-	max429Retries := 1000
-	interval := 1000 * time.Millisecond
+	policy := kc.RetryPolicy
+	if policy == (RetryPolicy{}) {
+		policy = DefaultRetryPolicy()
+	}
 
 	action := GetCallerMethodName()
 
 	for authAttempt := 1; authAttempt <= maxAuthRetries; authAttempt++ {
-		token, err := kc.TokenManager.GetValidToken(ctx)
+		token, err := kc.TokenManager.GetValidProjectToken(ctx, kc.ProjectID)
 		if err != nil {
 			return zero, nil, fmt.Errorf("failed to get valid token: %w", err)
 		}
 		kc.XAuthToken = token
 
-		for i := 0; i < max429Retries; i++ {
+		for attempt := 1; ; attempt++ {
+			if err := kc.RateLimiter.Wait(ctx, service); err != nil {
+				respDiags.AddError(
+					fmt.Sprintf("Error during %s", action),
+					fmt.Sprintf("Context cancelled while waiting for the %s rate limit", service),
+				)
+				return zero, nil, err
+			}
+
 			result, httpResp, err := operation()
+			err = NewAPIError(httpResp, err)
 
-			if httpResp == nil || httpResp.StatusCode != http.StatusTooManyRequests {
-				if auth.IsAuthError(err) && authAttempt < maxAuthRetries {
-					kc.TokenManager.InvalidateToken()
+			reason := retryReason(httpResp, err)
+			if reason == "" {
+				if IsAuthExpired(err) && authAttempt < maxAuthRetries {
+					kc.TokenManager.InvalidateProjectToken(kc.ProjectID)
 					break
 				}
 
 				return result, httpResp, err
 			}
 
-			tflog.Warn(ctx, fmt.Sprintf(
-				"Received 429 Too Many Requests during %s. Retrying %d/%d after %dms...",
-				action, i+1, max429Retries, interval.Milliseconds(),
-			))
+			fields := map[string]any{
+				"operation":   action,
+				"attempt":     attempt,
+				"max_retries": policy.MaxRetries,
+				"reason":      reason,
+			}
+			if httpResp != nil {
+				fields["status"] = httpResp.StatusCode
+			}
+			if err != nil {
+				fields["error"] = err.Error()
+			}
+
+			if attempt > policy.MaxRetries {
+				tflog.Warn(ctx, "KakaoCloud API request retries exhausted", fields)
+				respDiags.AddError(
+					fmt.Sprintf("Error during %s", action),
+					fmt.Sprintf("Exceeded max retry attempts (%d) after %s", policy.MaxRetries, reason),
+				)
+				if err == nil {
+					err = fmt.Errorf("max retries exceeded for %s", action)
+				}
+				return result, httpResp, err
+			}
+
+			backoff := policy.Backoff(attempt, httpResp)
+			fields["backoff_ms"] = backoff.Milliseconds()
+			tflog.Warn(ctx, "Retrying KakaoCloud API request", fields)
 
 			select {
-			case <-time.After(interval):
+			case <-time.After(backoff):
 				continue
 			case <-ctx.Done():
 				respDiags.AddError(
 					fmt.Sprintf("Error during %s", action),
-					"Context cancelled while retrying due to 429",
+					fmt.Sprintf("Context cancelled while retrying after %s", reason),
 				)
 				return zero, httpResp, ctx.Err()
 			}
 		}
-
-		if authAttempt == maxAuthRetries {
-			respDiags.AddError(
-				fmt.Sprintf("Error during %s", action),
-				fmt.Sprintf("Exceeded max retry attempts (%d) due to 429 Too Many Requests", max429Retries),
-			)
-			return zero, nil, fmt.Errorf("max retries exceeded for %s", action)
-		}
 	}
 
 	return zero, nil, fmt.Errorf("unexpected error: should not reach here")
@@ -16,7 +16,7 @@ func (c *KakaoCloudClient) loadServiceAzPolicyFromConfigAPI(ctx context.Context)
 
 	result := make(map[string]map[string]struct{})
 
-	respModel, httpResp, err := ExecuteWithRetryAndAuth(ctx, c, diags,
+	respModel, httpResp, err := ExecuteWithRetryAndAuth(ctx, c, diags, ServiceConfig,
 		func() (*config.AzPolicyResponse, *http.Response, error) {
 			return c.ApiClient.ConfigAPI.ResolveAzPolicy(ctx).
 				XAuthToken(c.XAuthToken).Execute()
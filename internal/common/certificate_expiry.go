@@ -0,0 +1,79 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+package common
+
+import (
+	"fmt"
+	"math"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+const DefaultCertificateExpiryWarningDays = 30
+
+var certificateExpirationLayouts = []string{
+	time.RFC3339Nano,
+	"2006-01-02T15:04:05.999999999",
+	"2006-01-02 15:04:05",
+}
+
+// ParseCertificateExpiration parses an expiration returned by the API. Values without a zone are UTC.
+func ParseCertificateExpiration(expiration string) (time.Time, bool) {
+	for _, layout := range certificateExpirationLayouts {
+		if t, err := time.Parse(layout, expiration); err == nil {
+			return t.UTC(), true
+		}
+	}
+	return time.Time{}, false
+}
+
+// DaysUntilCertificateExpiry returns the number of whole days from now until expiration, negative
+// once the certificate has expired.
+func DaysUntilCertificateExpiry(expiration string, now time.Time) (int64, bool) {
+	t, ok := ParseCertificateExpiration(expiration)
+	if !ok {
+		return 0, false
+	}
+	return int64(math.Floor(t.Sub(now).Hours() / 24)), true
+}
+
+// DaysUntilCertificateExpiryValue is DaysUntilCertificateExpiry as a Terraform value, null when
+// the expiration is missing or cannot be parsed.
+func DaysUntilCertificateExpiryValue(expiration types.String) types.Int64 {
+	if expiration.IsNull() || expiration.IsUnknown() {
+		return types.Int64Null()
+	}
+	days, ok := DaysUntilCertificateExpiry(expiration.ValueString(), time.Now())
+	if !ok {
+		return types.Int64Null()
+	}
+	return types.Int64Value(days)
+}
+
+// CertificateExpiryWarningDays returns the provider's certificate_expiry_warning_days. 0 disables warnings.
+func (c *KakaoCloudClient) CertificateExpiryWarningDays() int64 {
+	if c == nil || c.Config == nil || c.Config.CertificateExpiryWarningDays.IsNull() || c.Config.CertificateExpiryWarningDays.IsUnknown() {
+		return DefaultCertificateExpiryWarningDays
+	}
+	return c.Config.CertificateExpiryWarningDays.ValueInt64()
+}
+
+// AddCertificateExpiryWarning adds a warning when a certificate expires within windowDays.
+func AddCertificateExpiryWarning(diags *diag.Diagnostics, attrPath path.Path, name string, daysUntilExpiry types.Int64, windowDays int64) {
+	if windowDays <= 0 || daysUntilExpiry.IsNull() || daysUntilExpiry.IsUnknown() {
+		return
+	}
+
+	days := daysUntilExpiry.ValueInt64()
+	switch {
+	case days < 0:
+		diags.AddAttributeWarning(attrPath, "Certificate expired",
+			fmt.Sprintf("Certificate %q expired %d day(s) ago. Rotate it to avoid TLS failures.", name, -days))
+	case days < windowDays:
+		diags.AddAttributeWarning(attrPath, "Certificate expiring soon",
+			fmt.Sprintf("Certificate %q expires in %d day(s), within the %d day warning window set by certificate_expiry_warning_days.", name, days, windowDays))
+	}
+}
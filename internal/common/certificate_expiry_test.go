@@ -0,0 +1,67 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+package common
+
+import (
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestDaysUntilCertificateExpiry(t *testing.T) {
+	now := time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		expiration string
+		want       int64
+		wantOk     bool
+	}{
+		{"2026-01-31T12:00:00Z", 30, true},
+		{"2026-01-31T11:59:59Z", 29, true},
+		{"2026-01-11T12:00:00", 10, true},
+		{"2026-01-02 12:00:00", 1, true},
+		{"2026-01-01T21:00:00+09:00", 0, true},
+		{"2025-12-31T12:00:00Z", -1, true},
+		{"not a date", 0, false},
+	}
+	for _, tt := range tests {
+		got, ok := DaysUntilCertificateExpiry(tt.expiration, now)
+		if ok != tt.wantOk || got != tt.want {
+			t.Errorf("DaysUntilCertificateExpiry(%q) = %d, %v, want %d, %v", tt.expiration, got, ok, tt.want, tt.wantOk)
+		}
+	}
+}
+
+func TestAddCertificateExpiryWarning(t *testing.T) {
+	tests := []struct {
+		name   string
+		days   types.Int64
+		window int64
+		want   string
+	}{
+		{"outside window", types.Int64Value(30), 30, ""},
+		{"inside window", types.Int64Value(29), 30, "Certificate expiring soon"},
+		{"expired", types.Int64Value(-2), 30, "Certificate expired"},
+		{"disabled", types.Int64Value(-2), 0, ""},
+		{"unknown expiration", types.Int64Null(), 30, ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var diags diag.Diagnostics
+			AddCertificateExpiryWarning(&diags, path.Root("secrets"), "example", tt.days, tt.window)
+
+			if tt.want == "" {
+				if len(diags) != 0 {
+					t.Fatalf("expected no diagnostics, got %v", diags)
+				}
+				return
+			}
+			if len(diags) != 1 || diags[0].Summary() != tt.want || diags[0].Severity() != diag.SeverityWarning {
+				t.Fatalf("expected one %q warning, got %v", tt.want, diags)
+			}
+		})
+	}
+}
@@ -5,6 +5,9 @@ package common
 import (
 	"fmt"
 	"os"
+	"slices"
+	"strings"
+	"sync"
 	"terraform-provider-kakaocloud/internal/auth"
 
 	"github.com/hashicorp/terraform-plugin-framework/types"
@@ -13,11 +16,23 @@ import (
 )
 
 type Config struct {
-	ApplicationCredentialID     types.String
-	ApplicationCredentialSecret types.String
-	ServiceRealm                types.String
-	Region                      types.String
-	EndpointOverrides           map[string]string
+	ApplicationCredentialID      types.String
+	ApplicationCredentialSecret  types.String
+	ServiceRealm                 types.String
+	Region                       types.String
+	EndpointOverrides            map[string]string
+	DefaultTags                  map[string]string
+	Profile                      types.String
+	SharedCredentialsFile        types.String
+	ProjectID                    types.String
+	ProjectCredentials           map[string]auth.Credential
+	MaxRetries                   types.Int64
+	RetryMinBackoff              types.String
+	RetryMaxBackoff              types.String
+	RateLimitRequestsPerSecond   types.Float64
+	RateLimitBurst               types.Int64
+	ServiceRateLimits            map[string]float64
+	CertificateExpiryWarningDays types.Int64
 }
 
 type KakaoCloudClient struct {
@@ -26,7 +41,20 @@ type KakaoCloudClient struct {
 	ApiClient       *kakaocloud.APIClient
 	XAuthToken      string
 	XApiVersion     string
+	UserAgent       string
 	ServiceAzPolicy map[string]map[string]struct{}
+	ProjectID       string
+	RetryPolicy     RetryPolicy
+	RateLimiter     *RateLimiter
+
+	regionClients *regionClientCache
+}
+
+// regionClientCache holds the clients returned by ForRegion so that the endpoints of a region
+// are resolved only once. It is shared by every copy of a client.
+type regionClientCache struct {
+	mu      sync.Mutex
+	clients map[string]*KakaoCloudClient
 }
 
 func NewClient(ctx context.Context, config *Config, userAgent, apiVersion string) (*KakaoCloudClient, error) {
@@ -34,8 +62,25 @@ func NewClient(ctx context.Context, config *Config, userAgent, apiVersion string
 		return nil, fmt.Errorf("config validation failed: %w", err)
 	}
 
+	retryPolicy, err := newRetryPolicy(config)
+	if err != nil {
+		return nil, fmt.Errorf("config validation failed: %w", err)
+	}
+
+	rateLimiter, err := newRateLimiter(config)
+	if err != nil {
+		return nil, fmt.Errorf("config validation failed: %w", err)
+	}
+
 	client := &KakaoCloudClient{
-		Config: config,
+		Config:      config,
+		XApiVersion: apiVersion,
+		UserAgent:   userAgent,
+		RetryPolicy: retryPolicy,
+		RateLimiter: rateLimiter,
+		regionClients: &regionClientCache{
+			clients: make(map[string]*KakaoCloudClient),
+		},
 	}
 
 	endpoints := client.initEndpoints()
@@ -47,8 +92,12 @@ func NewClient(ctx context.Context, config *Config, userAgent, apiVersion string
 
 	client.TokenManager = auth.NewTokenManager(
 		client.ApiClient.IdentityAPI,
-		client.Config.ApplicationCredentialID.ValueString(),
-		client.Config.ApplicationCredentialSecret.ValueString(),
+		auth.Credential{
+			ID:     client.Config.ApplicationCredentialID.ValueString(),
+			Secret: client.Config.ApplicationCredentialSecret.ValueString(),
+		},
+		client.Config.ProjectID.ValueString(),
+		client.Config.ProjectCredentials,
 	)
 
 	resolvedEndpoints, err := client.loadEndpointsFromConfigAPI(ctx, &endpoints)
@@ -70,32 +119,169 @@ func NewClient(ctx context.Context, config *Config, userAgent, apiVersion string
 	return client, nil
 }
 
-func completeConfig(config *Config) error {
+// ForProject returns a copy of the client whose API calls use a token for projectID. Unless
+// projectID is the application credential's own project, it needs a project_credential.
+// An empty projectID returns the client itself.
+func (c *KakaoCloudClient) ForProject(projectID string) *KakaoCloudClient {
+	if projectID == "" || projectID == c.ProjectID {
+		return c
+	}
+
+	scoped := *c
+	scoped.ProjectID = projectID
+	scoped.XAuthToken = ""
+	return &scoped
+}
+
+// ForRegion returns a copy of the client whose service endpoints are resolved for region.
+// An empty region or the provider's own region returns the client itself. The copy is cached
+// per project and region, so later calls reuse both its endpoints and its token.
+func (c *KakaoCloudClient) ForRegion(ctx context.Context, region string) (*KakaoCloudClient, error) {
+	if region == "" || region == c.Config.Region.ValueString() {
+		return c, nil
+	}
+	if !slices.Contains(RegionAll, region) {
+		return nil, fmt.Errorf("region %q must be one of: %s", region, strings.Join(RegionAll, ", "))
+	}
+
+	key := c.ProjectID + "/" + region
+	if c.regionClients != nil {
+		c.regionClients.mu.Lock()
+		defer c.regionClients.mu.Unlock()
+		if scoped, ok := c.regionClients.clients[key]; ok {
+			return scoped, nil
+		}
+	}
+
+	regionConfig := *c.Config
+	regionConfig.Region = types.StringValue(region)
+
+	scoped := *c
+	scoped.Config = &regionConfig
+
+	endpoints, err := c.loadRegionEndpointsFromConfigAPI(ctx, region)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load endpoints for region %q: %w", region, err)
+	}
 
-	if config.ApplicationCredentialID.IsNull() {
-		config.ApplicationCredentialID = types.StringValue(os.Getenv("KAKAOCLOUD_APPLICATION_CREDENTIAL_ID"))
+	scoped.ApiClient = kakaocloud.NewAPIClient(kakaocloud.Config{
+		Endpoints: *endpoints,
+		UserAgent: c.UserAgent,
+		Version:   c.XApiVersion,
+	})
+	if c.regionClients != nil {
+		c.regionClients.clients[key] = &scoped
 	}
-	if config.ApplicationCredentialSecret.IsNull() {
-		config.ApplicationCredentialSecret = types.StringValue(os.Getenv("KAKAOCLOUD_APPLICATION_CREDENTIAL_SECRET"))
+	return &scoped, nil
+}
+
+func completeConfig(config *Config) error {
+	profile, err := loadConfigProfile(config)
+	if err != nil {
+		return err
 	}
 
-	if config.ApplicationCredentialID.ValueString() == "" {
-		return fmt.Errorf("application_credential_id is required")
+	if err := resolveApplicationCredential(config, profile); err != nil {
+		return err
 	}
-	if config.ApplicationCredentialSecret.ValueString() == "" {
-		return fmt.Errorf("application_credential_secret is required")
+
+	config.ProjectID = resolveConfigString(config.ProjectID, os.Getenv(EnvProjectID), profile.ProjectID)
+
+	config.ServiceRealm = resolveConfigString(config.ServiceRealm, profile.ServiceRealm, ServiceRealmPublic)
+	if !slices.Contains(ServiceRealmAll, config.ServiceRealm.ValueString()) {
+		return fmt.Errorf("service_realm %q must be one of: %s", config.ServiceRealm.ValueString(), strings.Join(ServiceRealmAll, ", "))
+	}
+	config.Region = resolveConfigString(config.Region, profile.Region, RegionKR2)
+	if !slices.Contains(RegionAll, config.Region.ValueString()) {
+		return fmt.Errorf("region %q must be one of: %s", config.Region.ValueString(), strings.Join(RegionAll, ", "))
 	}
 
-	if config.ServiceRealm.IsUnknown() || config.ServiceRealm.IsNull() {
-		config.ServiceRealm = types.StringValue("public")
+	endpointOverrides := make(map[string]string, len(profile.EndpointOverrides)+len(config.EndpointOverrides))
+	for service, endpoint := range profile.EndpointOverrides {
+		endpointOverrides[service] = endpoint
+	}
+	for service, endpoint := range config.EndpointOverrides {
+		endpointOverrides[service] = endpoint
 	}
-	if config.Region.IsUnknown() || config.Region.IsNull() {
-		config.Region = types.StringValue("kr-central-2")
+	config.EndpointOverrides = endpointOverrides
+
+	if config.DefaultTags == nil {
+		config.DefaultTags = make(map[string]string)
 	}
 
-	if config.EndpointOverrides == nil {
-		config.EndpointOverrides = make(map[string]string)
+	if config.CertificateExpiryWarningDays.IsNull() || config.CertificateExpiryWarningDays.IsUnknown() {
+		config.CertificateExpiryWarningDays = types.Int64Value(DefaultCertificateExpiryWarningDays)
 	}
 
 	return nil
 }
+
+// resolveApplicationCredential takes the application credential ID and secret together from the
+// first source that sets either of them: the provider arguments, the environment, then the
+// profile. Mixing an ID from one source with a secret from another would authenticate with a
+// credential that does not exist, so a source that sets only one of them is an error.
+func resolveApplicationCredential(config *Config, profile *SharedProfile) error {
+	sources := []struct {
+		name   string
+		id     string
+		secret string
+	}{
+		{"provider configuration", config.ApplicationCredentialID.ValueString(), config.ApplicationCredentialSecret.ValueString()},
+		{"environment", os.Getenv("KAKAOCLOUD_APPLICATION_CREDENTIAL_ID"), os.Getenv("KAKAOCLOUD_APPLICATION_CREDENTIAL_SECRET")},
+		{fmt.Sprintf("profile %q", config.Profile.ValueString()), profile.ApplicationCredentialID, profile.ApplicationCredentialSecret},
+	}
+
+	for _, source := range sources {
+		if source.id == "" && source.secret == "" {
+			continue
+		}
+		if source.id == "" {
+			return fmt.Errorf("application_credential_id is required when the %s sets application_credential_secret", source.name)
+		}
+		if source.secret == "" {
+			return fmt.Errorf("application_credential_secret is required when the %s sets application_credential_id", source.name)
+		}
+		config.ApplicationCredentialID = types.StringValue(source.id)
+		config.ApplicationCredentialSecret = types.StringValue(source.secret)
+		return nil
+	}
+	return fmt.Errorf("application_credential_id and application_credential_secret are required")
+}
+
+// loadConfigProfile selects the profile from the provider argument, KAKAOCLOUD_PROFILE or
+// "default", in that order. The default profile may be absent; an explicitly selected one may not.
+func loadConfigProfile(config *Config) (*SharedProfile, error) {
+	config.Profile = resolveConfigString(config.Profile, os.Getenv(EnvProfile))
+	required := config.Profile.ValueString() != ""
+	if !required {
+		config.Profile = types.StringValue(DefaultProfileName)
+	}
+
+	config.SharedCredentialsFile = resolveConfigString(config.SharedCredentialsFile, os.Getenv(EnvSharedCredentialsFile))
+	if config.SharedCredentialsFile.ValueString() != "" {
+		required = true
+	} else {
+		path, err := DefaultSharedCredentialsFile()
+		if err != nil {
+			if required {
+				return nil, fmt.Errorf("failed to locate shared credentials file: %w", err)
+			}
+			return &SharedProfile{}, nil
+		}
+		config.SharedCredentialsFile = types.StringValue(path)
+	}
+
+	return LoadSharedProfile(config.SharedCredentialsFile.ValueString(), config.Profile.ValueString(), required)
+}
+
+func resolveConfigString(value types.String, fallbacks ...string) types.String {
+	if !value.IsNull() && !value.IsUnknown() && value.ValueString() != "" {
+		return value
+	}
+	for _, fallback := range fallbacks {
+		if fallback != "" {
+			return types.StringValue(fallback)
+		}
+	}
+	return types.StringValue("")
+}
@@ -0,0 +1,431 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+package common
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"slices"
+	"strings"
+	"terraform-provider-kakaocloud/internal/acctest/mockserver"
+	"terraform-provider-kakaocloud/internal/auth"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/kakaoenterprise/kc-sdk-go/services/vpc"
+)
+
+func newTestClient(t *testing.T) (*KakaoCloudClient, *mockserver.Server) {
+	t.Helper()
+
+	return newTestClientWithProjectCredentials(t, nil)
+}
+
+func newTestClientWithProjectCredentials(t *testing.T, projectCredentials map[string]auth.Credential) (*KakaoCloudClient, *mockserver.Server) {
+	t.Helper()
+
+	server := mockserver.New()
+	for projectId, credential := range projectCredentials {
+		server.AddCredential(credential.ID, credential.Secret, projectId)
+	}
+	t.Cleanup(server.Close)
+
+	config := &Config{
+		ApplicationCredentialID:     types.StringValue(mockserver.CredentialId),
+		ApplicationCredentialSecret: types.StringValue(mockserver.CredentialSecret),
+		EndpointOverrides: map[string]string{
+			"iam":    server.Endpoint("iam"),
+			"config": server.Endpoint("config"),
+		},
+		RetryMinBackoff:    types.StringValue("10ms"),
+		RetryMaxBackoff:    types.StringValue("50ms"),
+		ProjectCredentials: projectCredentials,
+	}
+
+	kc, err := NewClient(context.Background(), config, "terraform-provider-kakaocloud/test", "1.3.0")
+	if err != nil {
+		t.Fatalf("NewClient() error = %v", err)
+	}
+	return kc, server
+}
+
+func TestNewClient_resolvesEndpointsAndAzPolicy(t *testing.T) {
+	kc, _ := newTestClient(t)
+
+	if kc.XAuthToken != mockserver.Token {
+		t.Errorf("XAuthToken = %q, want %q", kc.XAuthToken, mockserver.Token)
+	}
+	zones, ok := kc.ServiceAzPolicy["bcs"]
+	if !ok {
+		t.Fatalf("ServiceAzPolicy has no entry for bcs: %v", kc.ServiceAzPolicy)
+	}
+	for _, az := range mockserver.AvailabilityZones {
+		if _, ok := zones[az]; !ok {
+			t.Errorf("availability zone %s missing from bcs policy", az)
+		}
+	}
+}
+
+func TestListAllPages(t *testing.T) {
+	kc, server := newTestClient(t)
+	ctx := context.Background()
+
+	vpcs := server.Collection("vpc", "vpcs")
+	total := int(DefaultPageSize)*2 + 5
+	for i := 0; i < total; i++ {
+		id := fmt.Sprintf("vpc-%04d", i)
+		vpcs.Put(id, map[string]any{
+			"id":                  id,
+			"name":                id,
+			"cidr_block":          "10.0.0.0/16",
+			"provisioning_status": "ACTIVE",
+		})
+	}
+
+	var diags diag.Diagnostics
+	pages, _, err := ListAllPages(ctx, kc, nil, &diags, ServiceVPC,
+		func(limit int32, offset int32) (*vpc.VPCListModel, *http.Response, error) {
+			return kc.ApiClient.VPCAPI.ListVpcs(ctx).Limit(limit).Offset(offset).XAuthToken(kc.XAuthToken).Execute()
+		},
+		func(page *vpc.VPCListModel) int {
+			return len(page.Vpcs)
+		},
+	)
+	if err != nil {
+		t.Fatalf("ListAllPages() error = %v", err)
+	}
+	if diags.HasError() || diags.WarningsCount() > 0 {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+	if len(pages) != 3 {
+		t.Errorf("got %d pages, want 3", len(pages))
+	}
+
+	count := 0
+	for _, page := range pages {
+		count += len(page.Vpcs)
+	}
+	if count != total {
+		t.Errorf("got %d items, want %d", count, total)
+	}
+}
+
+func TestListAllPages_shortPages(t *testing.T) {
+	kc, server := newTestClient(t)
+	ctx := context.Background()
+
+	vpcs := server.Collection("vpc", "vpcs")
+	vpcs.MaxLimit = 100
+	total := 250
+	for i := 0; i < total; i++ {
+		id := fmt.Sprintf("vpc-%04d", i)
+		vpcs.Put(id, map[string]any{
+			"id":                  id,
+			"name":                id,
+			"cidr_block":          "10.0.0.0/16",
+			"provisioning_status": "ACTIVE",
+		})
+	}
+
+	var diags diag.Diagnostics
+	pages, _, err := ListAllPages(ctx, kc, nil, &diags, ServiceVPC,
+		func(limit int32, offset int32) (*vpc.VPCListModel, *http.Response, error) {
+			return kc.ApiClient.VPCAPI.ListVpcs(ctx).Limit(limit).Offset(offset).XAuthToken(kc.XAuthToken).Execute()
+		},
+		func(page *vpc.VPCListModel) int {
+			return len(page.Vpcs)
+		},
+	)
+	if err != nil {
+		t.Fatalf("ListAllPages() error = %v", err)
+	}
+
+	count := 0
+	for _, page := range pages {
+		count += len(page.Vpcs)
+	}
+	if len(pages) != 3 || count != total {
+		t.Errorf("got %d items in %d pages, want %d items in 3 pages", count, len(pages), total)
+	}
+}
+
+func TestListAllPagesByMarker(t *testing.T) {
+	kc, _ := newTestClient(t)
+	ctx := context.Background()
+
+	items := []string{"a", "b", "c", "d", "e"}
+	var markers []string
+	fetchPage := func(limit int32, marker string) ([]string, *http.Response, error) {
+		markers = append(markers, marker)
+		start := 0
+		if marker != "" {
+			start = slices.Index(items, marker) + 1
+		}
+		// The API serves at most two items per page, whatever the limit.
+		end := min(start+2, len(items))
+		return items[start:end], &http.Response{StatusCode: http.StatusOK}, nil
+	}
+	nextMarker := func(page []string) string {
+		if len(page) == 0 {
+			return ""
+		}
+		return page[len(page)-1]
+	}
+
+	var diags diag.Diagnostics
+	pages, _, err := ListAllPagesByMarker(ctx, kc, nil, &diags, ServiceVPC, fetchPage,
+		func(page []string) int { return len(page) }, nextMarker)
+	if err != nil {
+		t.Fatalf("ListAllPagesByMarker() error = %v", err)
+	}
+
+	var got []string
+	for _, page := range pages {
+		got = append(got, page...)
+	}
+	if !slices.Equal(got, items) {
+		t.Errorf("got items %v, want %v", got, items)
+	}
+	if want := []string{"", "b", "d", "e"}; !slices.Equal(markers, want) {
+		t.Errorf("requested markers %q, want %q ending with an empty page", markers, want)
+	}
+}
+
+func TestListAllPages_cancelledAfterFirstPage(t *testing.T) {
+	kc, server := newTestClient(t)
+
+	vpcs := server.Collection("vpc", "vpcs")
+	for i := 0; i < int(DefaultPageSize)+5; i++ {
+		id := fmt.Sprintf("vpc-%04d", i)
+		vpcs.Put(id, map[string]any{
+			"id":                  id,
+			"name":                id,
+			"cidr_block":          "10.0.0.0/16",
+			"provisioning_status": "ACTIVE",
+		})
+	}
+
+	list := func(partial bool) ([]*vpc.VPCListModel, diag.Diagnostics, error) {
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+
+		fetchPage := func(limit int32, offset int32) (*vpc.VPCListModel, *http.Response, error) {
+			defer cancel()
+			return kc.ApiClient.VPCAPI.ListVpcs(ctx).Limit(limit).Offset(offset).XAuthToken(kc.XAuthToken).Execute()
+		}
+		countItems := func(page *vpc.VPCListModel) int {
+			return len(page.Vpcs)
+		}
+
+		var diags diag.Diagnostics
+		var pages []*vpc.VPCListModel
+		var err error
+		if partial {
+			pages, _, err = ListAllPagesPartial(ctx, kc, nil, &diags, ServiceVPC, fetchPage, countItems)
+		} else {
+			pages, _, err = ListAllPages(ctx, kc, nil, &diags, ServiceVPC, fetchPage, countItems)
+		}
+		return pages, diags, err
+	}
+
+	if pages, _, err := list(false); !errors.Is(err, context.Canceled) || pages != nil {
+		t.Errorf("ListAllPages() = %d pages, %v, want an error", len(pages), err)
+	}
+
+	pages, diags, err := list(true)
+	if err != nil {
+		t.Fatalf("ListAllPagesPartial() error = %v", err)
+	}
+	if len(pages) != 1 || diags.WarningsCount() != 1 {
+		t.Errorf("ListAllPagesPartial() = %d pages, %d warnings, want the first page and a warning", len(pages), diags.WarningsCount())
+	}
+}
+
+func TestExecuteWithRetryAndAuth_retriesThrottledRequests(t *testing.T) {
+	kc, server := newTestClient(t)
+	ctx := context.Background()
+
+	server.FailNext(http.MethodGet, "/vpcs", http.StatusTooManyRequests)
+
+	var diags diag.Diagnostics
+	_, httpResp, err := ExecuteWithRetryAndAuth(ctx, kc, &diags, ServiceVPC,
+		func() (*vpc.VPCListModel, *http.Response, error) {
+			return kc.ApiClient.VPCAPI.ListVpcs(ctx).XAuthToken(kc.XAuthToken).Execute()
+		},
+	)
+	if err != nil {
+		t.Fatalf("ExecuteWithRetryAndAuth() error = %v", err)
+	}
+	if httpResp.StatusCode != http.StatusOK {
+		t.Errorf("StatusCode = %d, want %d", httpResp.StatusCode, http.StatusOK)
+	}
+}
+
+func TestExecuteWithRetryAndAuth_retriesGatewayErrorsOnReads(t *testing.T) {
+	kc, server := newTestClient(t)
+	ctx := context.Background()
+
+	server.FailNext(http.MethodGet, "/vpcs", http.StatusBadGateway, http.StatusServiceUnavailable, http.StatusGatewayTimeout)
+
+	var diags diag.Diagnostics
+	_, httpResp, err := ExecuteWithRetryAndAuth(ctx, kc, &diags, ServiceVPC,
+		func() (*vpc.VPCListModel, *http.Response, error) {
+			return kc.ApiClient.VPCAPI.ListVpcs(ctx).XAuthToken(kc.XAuthToken).Execute()
+		},
+	)
+	if err != nil {
+		t.Fatalf("ExecuteWithRetryAndAuth() error = %v", err)
+	}
+	if httpResp.StatusCode != http.StatusOK {
+		t.Errorf("StatusCode = %d, want %d", httpResp.StatusCode, http.StatusOK)
+	}
+}
+
+func TestExecuteWithRetryAndAuth_stopsAfterMaxRetries(t *testing.T) {
+	kc, server := newTestClient(t)
+	ctx := context.Background()
+	kc.RetryPolicy.MaxRetries = 2
+
+	server.FailNext(http.MethodGet, "/vpcs", http.StatusTooManyRequests, http.StatusTooManyRequests, http.StatusTooManyRequests, http.StatusTooManyRequests)
+
+	var diags diag.Diagnostics
+	_, httpResp, err := ExecuteWithRetryAndAuth(ctx, kc, &diags, ServiceVPC,
+		func() (*vpc.VPCListModel, *http.Response, error) {
+			return kc.ApiClient.VPCAPI.ListVpcs(ctx).XAuthToken(kc.XAuthToken).Execute()
+		},
+	)
+	if err == nil {
+		t.Fatal("expected an error after exhausting retries")
+	}
+	if !diags.HasError() {
+		t.Error("expected a diagnostic after exhausting retries")
+	}
+	if httpResp == nil || httpResp.StatusCode != http.StatusTooManyRequests {
+		t.Errorf("expected the last 429 response to be returned, got %v", httpResp)
+	}
+}
+
+func listVpcsForTest(ctx context.Context, client *KakaoCloudClient) error {
+	var diags diag.Diagnostics
+	_, _, err := ExecuteWithRetryAndAuth(ctx, client, &diags, ServiceVPC,
+		func() (*vpc.VPCListModel, *http.Response, error) {
+			return client.ApiClient.VPCAPI.ListVpcs(ctx).XAuthToken(client.XAuthToken).Execute()
+		},
+	)
+	return err
+}
+
+func TestForProject_usesProjectScopedTokens(t *testing.T) {
+	const otherProject = "0123456789abcdef0123456789abcdef"
+	kc, server := newTestClientWithProjectCredentials(t, map[string]auth.Credential{
+		otherProject: {ID: "other-credential-id", Secret: "other-credential-secret"},
+	})
+	ctx := context.Background()
+
+	listVpcs := func(client *KakaoCloudClient) {
+		t.Helper()
+
+		if err := listVpcsForTest(ctx, client); err != nil {
+			t.Fatalf("ExecuteWithRetryAndAuth() error = %v", err)
+		}
+	}
+
+	if kc.ForProject("") != kc {
+		t.Error("ForProject(\"\") should return the client itself")
+	}
+
+	scoped := kc.ForProject(otherProject)
+	listVpcs(scoped)
+	listVpcs(scoped)
+	if scoped.XAuthToken != mockserver.ProjectToken(otherProject) {
+		t.Errorf("scoped XAuthToken = %q, want %q", scoped.XAuthToken, mockserver.ProjectToken(otherProject))
+	}
+	if got := server.ProjectTokensIssued(otherProject); got != 1 {
+		t.Errorf("ProjectTokensIssued(%s) = %d, want 1 cached token", otherProject, got)
+	}
+
+	issued := server.TokensIssued()
+	listVpcs(kc.ForProject(mockserver.ProjectId))
+	if server.TokensIssued() != issued {
+		t.Error("the credential's own project should reuse the unscoped token")
+	}
+	if kc.XAuthToken != mockserver.Token {
+		t.Errorf("XAuthToken = %q, want the unscoped token", kc.XAuthToken)
+	}
+}
+
+func TestForProject_requiresProjectCredential(t *testing.T) {
+	kc, server := newTestClient(t)
+	ctx := context.Background()
+
+	const otherProject = "0123456789abcdef0123456789abcdef"
+	err := listVpcsForTest(ctx, kc.ForProject(otherProject))
+	if err == nil || !strings.Contains(err.Error(), "add a project_credential for project "+otherProject) {
+		t.Fatalf("ExecuteWithRetryAndAuth() error = %v, want a missing project_credential error", err)
+	}
+	if got := server.ProjectTokensIssued(otherProject); got != 0 {
+		t.Errorf("ProjectTokensIssued(%s) = %d, want 0", otherProject, got)
+	}
+}
+
+func TestForRegion_returnsClientForOwnRegion(t *testing.T) {
+	kc, _ := newTestClient(t)
+	ctx := context.Background()
+
+	for _, region := range []string{"", kc.Config.Region.ValueString()} {
+		scoped, err := kc.ForRegion(ctx, region)
+		if err != nil {
+			t.Fatalf("ForRegion(%q) error = %v", region, err)
+		}
+		if scoped != kc {
+			t.Errorf("ForRegion(%q) should return the client itself", region)
+		}
+	}
+
+	if _, err := kc.ForRegion(ctx, "kr-central-9"); err == nil {
+		t.Error("ForRegion() should reject an unknown region")
+	}
+}
+
+func TestForRegion_cachesClientPerRegion(t *testing.T) {
+	kc, server := newTestClient(t)
+	ctx := context.Background()
+
+	region := RegionKR1
+	if region == kc.Config.Region.ValueString() {
+		region = RegionKR3
+	}
+
+	countResolves := func() int {
+		n := 0
+		for _, req := range server.Requests() {
+			if strings.Contains(req, "endpoint") {
+				n++
+			}
+		}
+		return n
+	}
+
+	before := countResolves()
+	first, err := kc.ForRegion(ctx, region)
+	if err != nil {
+		t.Fatalf("ForRegion(%q) error = %v", region, err)
+	}
+	second, err := kc.ForRegion(ctx, region)
+	if err != nil {
+		t.Fatalf("ForRegion(%q) error = %v", region, err)
+	}
+
+	if first != second {
+		t.Error("ForRegion() should return the cached client for the same region")
+	}
+	if got := countResolves() - before; got != 1 {
+		t.Errorf("endpoints resolved %d times, want 1", got)
+	}
+	if first.Config.Region.ValueString() != region {
+		t.Errorf("Region = %q, want %q", first.Config.Region.ValueString(), region)
+	}
+}
@@ -87,6 +87,14 @@ const (
 	VpcProvisioningStatusDeleting = "PENDING_DELETE"
 )
 
+const (
+	VpcPeeringStatusActive            = "ACTIVE"
+	VpcPeeringStatusPendingAcceptance = "PENDING_ACCEPTANCE"
+	VpcPeeringStatusRejected          = "REJECTED"
+	VpcPeeringStatusError             = "ERROR"
+	VpcPeeringStatusDeleting          = "PENDING_DELETE"
+)
+
 const (
 	NetworkInterfaceStatusAvailable = "available"
 	NetworkInterfaceStatusInUse     = "in_use"
@@ -107,6 +115,13 @@ const (
 
 const (
 	ImageStatusActive = "active"
+	ImageStatusError  = "error"
+	ImageStatusKilled = "killed"
+)
+
+const (
+	ImageTaskStatusSuccess = "success"
+	ImageTaskStatusFailure = "failure"
 )
 
 const (
@@ -116,6 +131,12 @@ const (
 	LoadBalancerProvisioningStatusDeleting = "PENDING_DELETE"
 )
 
+const (
+	LoadBalancerOperatingStatusOnline    = "ONLINE"
+	LoadBalancerOperatingStatusDraining  = "DRAINING"
+	LoadBalancerOperatingStatusNoMonitor = "NO_MONITOR"
+)
+
 const (
 	ClusterStatusProvisioned  = "Provisioned"
 	ClusterStatusProvisioning = "Provisioning"
@@ -0,0 +1,156 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+package common
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+const (
+	DefaultProfileName = "default"
+
+	EnvProfile               = "KAKAOCLOUD_PROFILE"
+	EnvSharedCredentialsFile = "KAKAOCLOUD_SHARED_CREDENTIALS_FILE"
+	EnvProjectID             = "KAKAOCLOUD_PROJECT_ID"
+
+	endpointOverridePrefix = "endpoint_overrides."
+)
+
+// SharedProfile is a named profile in the shared credentials file.
+type SharedProfile struct {
+	ApplicationCredentialID     string            `yaml:"application_credential_id"`
+	ApplicationCredentialSecret string            `yaml:"application_credential_secret"`
+	ServiceRealm                string            `yaml:"service_realm"`
+	Region                      string            `yaml:"region"`
+	ProjectID                   string            `yaml:"project_id"`
+	EndpointOverrides           map[string]string `yaml:"endpoint_overrides"`
+}
+
+func DefaultSharedCredentialsFile() (string, error) {
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(home, ".kakaocloud", "credentials"), nil
+}
+
+// LoadSharedProfile reads the named profile from an INI or YAML credentials file.
+// A file or profile that is missing, and a file that cannot be read or parsed, are only errors
+// when required is set. Otherwise the file may belong to another tool or provider version.
+func LoadSharedProfile(path string, name string, required bool) (*SharedProfile, error) {
+	profiles, err := readSharedCredentialsFile(path)
+	if err != nil {
+		if !required {
+			return &SharedProfile{}, nil
+		}
+		return nil, err
+	}
+
+	profile, ok := profiles[name]
+	if !ok || profile == nil {
+		if required {
+			return nil, fmt.Errorf("profile %q not found in shared credentials file %q", name, path)
+		}
+		return &SharedProfile{}, nil
+	}
+	return profile, nil
+}
+
+func readSharedCredentialsFile(path string) (map[string]*SharedProfile, error) {
+	data, err := os.ReadFile(expandHomeDir(path))
+	if err != nil {
+		return nil, fmt.Errorf("failed to read shared credentials file %q: %w", path, err)
+	}
+
+	var profiles map[string]*SharedProfile
+	switch strings.ToLower(filepath.Ext(path)) {
+	case ".yaml", ".yml":
+		if err := yaml.Unmarshal(data, &profiles); err != nil {
+			return nil, fmt.Errorf("failed to parse shared credentials file %q: %w", path, err)
+		}
+	default:
+		profiles, err = parseSharedCredentialsINI(data)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse shared credentials file %q: %w", path, err)
+		}
+	}
+	return profiles, nil
+}
+
+func parseSharedCredentialsINI(data []byte) (map[string]*SharedProfile, error) {
+	profiles := make(map[string]*SharedProfile)
+	var current *SharedProfile
+
+	scanner := bufio.NewScanner(bytes.NewReader(data))
+	lineNo := 0
+	for scanner.Scan() {
+		lineNo++
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") || strings.HasPrefix(line, ";") {
+			continue
+		}
+
+		if strings.HasPrefix(line, "[") && strings.HasSuffix(line, "]") {
+			name := strings.TrimSpace(line[1 : len(line)-1])
+			name = strings.TrimSpace(strings.TrimPrefix(name, "profile "))
+			if name == "" {
+				return nil, fmt.Errorf("line %d: empty profile name", lineNo)
+			}
+			current = &SharedProfile{}
+			profiles[name] = current
+			continue
+		}
+
+		key, value, ok := strings.Cut(line, "=")
+		if !ok {
+			return nil, fmt.Errorf("line %d: expected key = value", lineNo)
+		}
+		if current == nil {
+			return nil, fmt.Errorf("line %d: key outside of a profile section", lineNo)
+		}
+		key = strings.TrimSpace(key)
+		value = strings.Trim(strings.TrimSpace(value), `"'`)
+
+		switch {
+		case key == "application_credential_id":
+			current.ApplicationCredentialID = value
+		case key == "application_credential_secret":
+			current.ApplicationCredentialSecret = value
+		case key == "service_realm":
+			current.ServiceRealm = value
+		case key == "region":
+			current.Region = value
+		case key == "project_id":
+			current.ProjectID = value
+		case strings.HasPrefix(key, endpointOverridePrefix):
+			if current.EndpointOverrides == nil {
+				current.EndpointOverrides = make(map[string]string)
+			}
+			current.EndpointOverrides[strings.TrimPrefix(key, endpointOverridePrefix)] = value
+		default:
+			return nil, fmt.Errorf("line %d: unknown key %q", lineNo, key)
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	return profiles, nil
+}
+
+func expandHomeDir(path string) string {
+	if path != "~" && !strings.HasPrefix(path, "~/") {
+		return path
+	}
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return path
+	}
+	return filepath.Join(home, strings.TrimPrefix(path, "~"))
+}
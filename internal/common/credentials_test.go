@@ -0,0 +1,249 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+package common
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+const testCredentialsINI = `
+# shared credentials
+[default]
+application_credential_id     = default-id
+application_credential_secret = "default-secret"
+
+[profile gov]
+application_credential_id     = gov-id
+application_credential_secret = gov-secret
+service_realm                 = gov
+region                        = kr-central-1
+project_id                    = 0123456789abcdef0123456789abcdef
+endpoint_overrides.iam        = https://iam.gov.example.com
+`
+
+const testCredentialsYAML = `
+default:
+  application_credential_id: default-id
+  application_credential_secret: default-secret
+gov:
+  application_credential_id: gov-id
+  application_credential_secret: gov-secret
+  service_realm: gov
+  region: kr-central-1
+  endpoint_overrides:
+    iam: https://iam.gov.example.com
+`
+
+func writeCredentialsFile(t *testing.T, name string, content string) string {
+	t.Helper()
+
+	path := filepath.Join(t.TempDir(), name)
+	if err := os.WriteFile(path, []byte(content), 0o600); err != nil {
+		t.Fatalf("WriteFile() error = %v", err)
+	}
+	return path
+}
+
+func clearCredentialEnv(t *testing.T) {
+	t.Helper()
+
+	t.Setenv("HOME", t.TempDir())
+	for _, key := range []string{
+		"KAKAOCLOUD_APPLICATION_CREDENTIAL_ID",
+		"KAKAOCLOUD_APPLICATION_CREDENTIAL_SECRET",
+		EnvProfile,
+		EnvSharedCredentialsFile,
+		EnvProjectID,
+	} {
+		t.Setenv(key, "")
+	}
+}
+
+func TestLoadSharedProfile(t *testing.T) {
+	for _, tc := range []struct {
+		name    string
+		content string
+	}{
+		{name: "credentials", content: testCredentialsINI},
+		{name: "credentials.yaml", content: testCredentialsYAML},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			path := writeCredentialsFile(t, tc.name, tc.content)
+
+			profile, err := LoadSharedProfile(path, "gov", true)
+			if err != nil {
+				t.Fatalf("LoadSharedProfile() error = %v", err)
+			}
+			if profile.ApplicationCredentialID != "gov-id" || profile.ApplicationCredentialSecret != "gov-secret" {
+				t.Errorf("credentials = %q/%q", profile.ApplicationCredentialID, profile.ApplicationCredentialSecret)
+			}
+			if profile.ServiceRealm != "gov" || profile.Region != "kr-central-1" {
+				t.Errorf("service_realm = %q, region = %q", profile.ServiceRealm, profile.Region)
+			}
+			if got := profile.EndpointOverrides["iam"]; got != "https://iam.gov.example.com" {
+				t.Errorf("endpoint_overrides[iam] = %q", got)
+			}
+
+			profile, err = LoadSharedProfile(path, DefaultProfileName, true)
+			if err != nil {
+				t.Fatalf("LoadSharedProfile(default) error = %v", err)
+			}
+			if profile.ApplicationCredentialSecret != "default-secret" {
+				t.Errorf("default secret = %q", profile.ApplicationCredentialSecret)
+			}
+		})
+	}
+}
+
+func TestLoadSharedProfile_missing(t *testing.T) {
+	path := writeCredentialsFile(t, "credentials", testCredentialsINI)
+
+	if _, err := LoadSharedProfile(path, "staging", true); err == nil {
+		t.Error("expected an error for a missing required profile")
+	}
+	if _, err := LoadSharedProfile(filepath.Join(t.TempDir(), "none"), DefaultProfileName, true); err == nil {
+		t.Error("expected an error for a missing required file")
+	}
+	profile, err := LoadSharedProfile(filepath.Join(t.TempDir(), "none"), DefaultProfileName, false)
+	if err != nil || profile.ApplicationCredentialID != "" {
+		t.Errorf("LoadSharedProfile(optional) = %+v, %v", profile, err)
+	}
+
+	invalid := writeCredentialsFile(t, "credentials", "[default]\nunknown_key = value")
+	if _, err := LoadSharedProfile(invalid, DefaultProfileName, true); err == nil {
+		t.Error("expected an error for an invalid required file")
+	}
+	profile, err = LoadSharedProfile(invalid, DefaultProfileName, false)
+	if err != nil || profile.ApplicationCredentialID != "" {
+		t.Errorf("LoadSharedProfile(optional invalid) = %+v, %v", profile, err)
+	}
+}
+
+func TestParseSharedCredentialsINI_invalid(t *testing.T) {
+	for _, content := range []string{
+		"application_credential_id = orphan",
+		"[default]\nunknown_key = value",
+		"[default]\nnot a pair",
+	} {
+		if _, err := parseSharedCredentialsINI([]byte(content)); err == nil {
+			t.Errorf("parseSharedCredentialsINI(%q) expected an error", content)
+		}
+	}
+}
+
+func TestCompleteConfig_credentialChain(t *testing.T) {
+	clearCredentialEnv(t)
+	path := writeCredentialsFile(t, "credentials", testCredentialsINI)
+	t.Setenv(EnvSharedCredentialsFile, path)
+	t.Setenv(EnvProfile, "gov")
+
+	config := &Config{
+		EndpointOverrides: map[string]string{"config": "https://config.example.com"},
+	}
+	if err := completeConfig(config); err != nil {
+		t.Fatalf("completeConfig() error = %v", err)
+	}
+	if config.ApplicationCredentialID.ValueString() != "gov-id" {
+		t.Errorf("application_credential_id = %q, want the profile value", config.ApplicationCredentialID.ValueString())
+	}
+	if config.ServiceRealm.ValueString() != "gov" || config.Region.ValueString() != "kr-central-1" {
+		t.Errorf("service_realm = %q, region = %q", config.ServiceRealm.ValueString(), config.Region.ValueString())
+	}
+	if config.ProjectID.ValueString() != "0123456789abcdef0123456789abcdef" {
+		t.Errorf("project_id = %q, want the profile value", config.ProjectID.ValueString())
+	}
+	if config.EndpointOverrides["iam"] != "https://iam.gov.example.com" || config.EndpointOverrides["config"] != "https://config.example.com" {
+		t.Errorf("endpoint_overrides = %v", config.EndpointOverrides)
+	}
+
+	t.Setenv("KAKAOCLOUD_APPLICATION_CREDENTIAL_ID", "env-id")
+	t.Setenv("KAKAOCLOUD_APPLICATION_CREDENTIAL_SECRET", "env-secret")
+	config = &Config{
+		Profile: types.StringValue(DefaultProfileName),
+		Region:  types.StringValue("kr-central-2"),
+	}
+	if err := completeConfig(config); err != nil {
+		t.Fatalf("completeConfig() error = %v", err)
+	}
+	if config.ApplicationCredentialID.ValueString() != "env-id" || config.ApplicationCredentialSecret.ValueString() != "env-secret" {
+		t.Errorf("application credential = %q/%q, want the environment pair",
+			config.ApplicationCredentialID.ValueString(), config.ApplicationCredentialSecret.ValueString())
+	}
+	if config.ServiceRealm.ValueString() != ServiceRealmPublic || config.Region.ValueString() != "kr-central-2" {
+		t.Errorf("service_realm = %q, region = %q", config.ServiceRealm.ValueString(), config.Region.ValueString())
+	}
+
+	config = &Config{
+		ApplicationCredentialID:     types.StringValue("arg-id"),
+		ApplicationCredentialSecret: types.StringValue("arg-secret"),
+		Profile:                     types.StringValue(DefaultProfileName),
+	}
+	if err := completeConfig(config); err != nil {
+		t.Fatalf("completeConfig() error = %v", err)
+	}
+	if config.ApplicationCredentialID.ValueString() != "arg-id" || config.ApplicationCredentialSecret.ValueString() != "arg-secret" {
+		t.Errorf("application credential = %q/%q, want the argument pair",
+			config.ApplicationCredentialID.ValueString(), config.ApplicationCredentialSecret.ValueString())
+	}
+}
+
+func TestCompleteConfig_partialCredential(t *testing.T) {
+	clearCredentialEnv(t)
+	t.Setenv("KAKAOCLOUD_APPLICATION_CREDENTIAL_ID", "env-id")
+	t.Setenv("KAKAOCLOUD_APPLICATION_CREDENTIAL_SECRET", "env-secret")
+
+	if err := completeConfig(&Config{ApplicationCredentialSecret: types.StringValue("arg-secret")}); err == nil {
+		t.Error("expected an error when the arguments set only the secret")
+	}
+
+	t.Setenv("KAKAOCLOUD_APPLICATION_CREDENTIAL_SECRET", "")
+	path := writeCredentialsFile(t, "credentials", testCredentialsINI)
+	t.Setenv(EnvSharedCredentialsFile, path)
+	if err := completeConfig(&Config{}); err == nil {
+		t.Error("expected an error when the environment sets only the ID")
+	}
+}
+
+func TestCompleteConfig_invalidDefaultFile(t *testing.T) {
+	clearCredentialEnv(t)
+	home := t.TempDir()
+	t.Setenv("HOME", home)
+	if err := os.MkdirAll(filepath.Join(home, ".kakaocloud"), 0o700); err != nil {
+		t.Fatalf("MkdirAll() error = %v", err)
+	}
+	if err := os.WriteFile(filepath.Join(home, ".kakaocloud", "credentials"), []byte("[default]\nunknown_key = value"), 0o600); err != nil {
+		t.Fatalf("WriteFile() error = %v", err)
+	}
+
+	config := &Config{
+		ApplicationCredentialID:     types.StringValue("arg-id"),
+		ApplicationCredentialSecret: types.StringValue("arg-secret"),
+	}
+	if err := completeConfig(config); err != nil {
+		t.Fatalf("completeConfig() error = %v, want the default file to be ignored", err)
+	}
+
+	config = &Config{
+		ApplicationCredentialID:     types.StringValue("arg-id"),
+		ApplicationCredentialSecret: types.StringValue("arg-secret"),
+		Profile:                     types.StringValue(DefaultProfileName),
+	}
+	if err := completeConfig(config); err == nil {
+		t.Error("expected an error for an invalid file when the profile is set explicitly")
+	}
+}
+
+func TestCompleteConfig_noCredentials(t *testing.T) {
+	clearCredentialEnv(t)
+
+	if err := completeConfig(&Config{}); err == nil {
+		t.Error("expected an error when no credentials are configured")
+	}
+	if err := completeConfig(&Config{Profile: types.StringValue("missing")}); err == nil {
+		t.Error("expected an error for a profile without a credentials file")
+	}
+}
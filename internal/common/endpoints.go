@@ -118,7 +118,7 @@ func applySDKDefaultEndpoints(endpoints *kakaocloud.Endpoints) {
 func (c *KakaoCloudClient) loadEndpointsFromConfigAPI(ctx context.Context, endpoints *kakaocloud.Endpoints) (*kakaocloud.Endpoints, error) {
 	diags := &diag.Diagnostics{}
 
-	respModel, httpResp, err := ExecuteWithRetryAndAuth(ctx, c, diags,
+	respModel, httpResp, err := ExecuteWithRetryAndAuth(ctx, c, diags, ServiceConfig,
 		func() (*config.ClientEndpointResponse, *http.Response, error) {
 			return c.ApiClient.ConfigAPI.ResolveClientEndpoint(ctx).
 				XAuthToken(c.XAuthToken).Execute()
@@ -141,3 +141,27 @@ func (c *KakaoCloudClient) loadEndpointsFromConfigAPI(ctx context.Context, endpo
 	}
 	return endpoints, nil
 }
+
+// loadRegionEndpointsFromConfigAPI resolves service endpoints for another region. Unlike the
+// provider's own region there is no fallback to the SDK defaults, which point at the default region.
+func (c *KakaoCloudClient) loadRegionEndpointsFromConfigAPI(ctx context.Context, region string) (*kakaocloud.Endpoints, error) {
+	diags := &diag.Diagnostics{}
+
+	respModel, httpResp, err := ExecuteWithRetryAndAuth(ctx, c, diags, ServiceConfig,
+		func() (*config.ClientEndpointResponse, *http.Response, error) {
+			return c.ApiClient.ConfigAPI.ResolveClientEndpoint(ctx).
+				Region(region).
+				XAuthToken(c.XAuthToken).Execute()
+		},
+	)
+	if err != nil {
+		AddApiActionError(ctx, c, httpResp, "ResolveClientEndpoint", err, diags)
+		return nil, err
+	}
+
+	endpoints := c.initEndpoints()
+	if !applyClientEndpoint(&endpoints, respModel.Data) {
+		return nil, fmt.Errorf("no client endpoints returned for region %q", region)
+	}
+	return &endpoints, nil
+}
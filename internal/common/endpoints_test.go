@@ -0,0 +1,53 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+package common
+
+import (
+	"testing"
+
+	kakaocloud "github.com/kakaoenterprise/kc-sdk-go/common"
+	"github.com/kakaoenterprise/kc-sdk-go/services/config"
+)
+
+func TestApplyClientEndpoint(t *testing.T) {
+	endpoints := kakaocloud.Endpoints{
+		IAM: "https://iam.example.com",
+	}
+	clientEndpoint := &config.ClientEndpoint{
+		ServiceEndpoints: map[string]string{
+			"vpc":           "https://vpc.example.com",
+			"load-balancer": "https://lb.example.com",
+			"volume":        "https://volume.example.com",
+			"unknown":       "https://unknown.example.com",
+		},
+	}
+
+	if ok := applyClientEndpoint(&endpoints, clientEndpoint); !ok {
+		t.Fatal("applyClientEndpoint() = false, want true")
+	}
+
+	if endpoints.IAM != "https://iam.example.com" {
+		t.Errorf("IAM = %q, want it to be left untouched", endpoints.IAM)
+	}
+	if endpoints.VPC != "https://vpc.example.com" {
+		t.Errorf("VPC = %q", endpoints.VPC)
+	}
+	if endpoints.LoadBalancer != "https://lb.example.com" {
+		t.Errorf("LoadBalancer = %q", endpoints.LoadBalancer)
+	}
+	if endpoints.Volume != "https://volume.example.com" {
+		t.Errorf("Volume = %q", endpoints.Volume)
+	}
+	if endpoints.BCS != "" {
+		t.Errorf("BCS = %q, want empty", endpoints.BCS)
+	}
+}
+
+func TestApplyClientEndpoint_nil(t *testing.T) {
+	if applyClientEndpoint(nil, &config.ClientEndpoint{}) {
+		t.Error("applyClientEndpoint(nil, ...) = true, want false")
+	}
+	if applyClientEndpoint(&kakaocloud.Endpoints{}, nil) {
+		t.Error("applyClientEndpoint(..., nil) = true, want false")
+	}
+}
@@ -3,9 +3,8 @@
 package common
 
 import (
-	"encoding/json"
+	"errors"
 	"fmt"
-	"io"
 	"net/http"
 	"strings"
 
@@ -32,33 +31,23 @@ func AddApiActionError(
 	typeName, tfObjectType := ExtractTypeMetadata(ctx, obj)
 	action := GetCallerMethodName()
 
-	var parsed apiError
-	var errorMsg string
+	var apiErr *APIError
+	errors.As(NewAPIError(resp, err), &apiErr)
 
-	if len(message) > 0 && message[0] != "" {
+	var errorMsg string
+	switch {
+	case len(message) > 0 && message[0] != "":
 		errorMsg = message[0]
-	} else {
-		if resp != nil && resp.Body != nil {
-			defer func(Body io.ReadCloser) {
-				err := Body.Close()
-				if err != nil {
-				}
-			}(resp.Body)
-			if body, readErr := io.ReadAll(resp.Body); readErr == nil {
-				if marshalErr := json.Unmarshal(body, &parsed); marshalErr != nil {
-					errorMsg = marshalErr.Error()
-				} else {
-					errorMsg = parsed.Error.Message
-				}
-			} else {
-				errorMsg = readErr.Error()
-			}
-		} else {
-			errorMsg = "no response body"
-		}
+	case apiErr != nil && apiErr.Message != "":
+		errorMsg = apiErr.Message
+	case resp == nil || resp.Body == nil:
+		errorMsg = "no response body"
 	}
 
 	fullMessage := fmt.Sprintf("Could not %s %s (API: %s): %s\n%s", action, typeName, apiName, err.Error(), errorMsg)
+	if apiErr != nil && apiErr.RequestID != "" {
+		fullMessage += fmt.Sprintf("\nRequest ID: %s", apiErr.RequestID)
+	}
 	diags.AddError(fmt.Sprintf("%s %s: %s", action, tfObjectType, typeName), fullMessage)
 }
 
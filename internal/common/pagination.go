@@ -0,0 +1,208 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+package common
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+const DefaultPageSize int32 = 1000
+
+// ListAllPages follows offset/limit paging until the total reported in the response body is
+// reached or, when the API reports none, until an empty page is returned. A page shorter than
+// the page size does not end the listing, since some APIs serve fewer items than requested.
+// It fails when the context is done before the last page, so callers that poll or compare the
+// list never act on part of it.
+func ListAllPages[T any](
+	ctx context.Context,
+	kc *KakaoCloudClient,
+	obj interface{},
+	respDiags *diag.Diagnostics,
+	service string,
+	fetchPage func(limit int32, offset int32) (T, *http.Response, error),
+	countItems func(T) int,
+) ([]T, *http.Response, error) {
+	return listPages(ctx, kc, obj, respDiags, service, false, offsetPager(fetchPage, countItems))
+}
+
+// ListAllPagesPartial is ListAllPages for data sources. If the context deadline is hit after at
+// least one page was fetched, the pages collected so far are returned together with a warning
+// diagnostic instead of an error.
+func ListAllPagesPartial[T any](
+	ctx context.Context,
+	kc *KakaoCloudClient,
+	obj interface{},
+	respDiags *diag.Diagnostics,
+	service string,
+	fetchPage func(limit int32, offset int32) (T, *http.Response, error),
+	countItems func(T) int,
+) ([]T, *http.Response, error) {
+	return listPages(ctx, kc, obj, respDiags, service, true, offsetPager(fetchPage, countItems))
+}
+
+// ListAllPagesByMarker follows marker/limit paging for APIs that return the marker of the next
+// page instead of accepting an offset. The first page is requested with an empty marker, and
+// listing stops on an empty page or when nextMarker returns "" or the marker just used.
+func ListAllPagesByMarker[T any](
+	ctx context.Context,
+	kc *KakaoCloudClient,
+	obj interface{},
+	respDiags *diag.Diagnostics,
+	service string,
+	fetchPage func(limit int32, marker string) (T, *http.Response, error),
+	countItems func(T) int,
+	nextMarker func(T) string,
+) ([]T, *http.Response, error) {
+	return listPages(ctx, kc, obj, respDiags, service, false, markerPager(fetchPage, countItems, nextMarker))
+}
+
+// ListAllPagesByMarkerPartial is ListAllPagesByMarker for data sources, with the partial results
+// of ListAllPagesPartial.
+func ListAllPagesByMarkerPartial[T any](
+	ctx context.Context,
+	kc *KakaoCloudClient,
+	obj interface{},
+	respDiags *diag.Diagnostics,
+	service string,
+	fetchPage func(limit int32, marker string) (T, *http.Response, error),
+	countItems func(T) int,
+	nextMarker func(T) string,
+) ([]T, *http.Response, error) {
+	return listPages(ctx, kc, obj, respDiags, service, true, markerPager(fetchPage, countItems, nextMarker))
+}
+
+// pager fetches the next page of a listing. It reports the number of items on the page and
+// whether more pages follow. It only advances after a successful fetch, so a retried request
+// asks for the same page again.
+type pager[T any] func() (page T, httpResp *http.Response, count int, more bool, err error)
+
+func offsetPager[T any](
+	fetchPage func(limit int32, offset int32) (T, *http.Response, error),
+	countItems func(T) int,
+) pager[T] {
+	offset := int32(0)
+	return func() (T, *http.Response, int, bool, error) {
+		page, httpResp, err := fetchPage(DefaultPageSize, offset)
+		if err != nil {
+			return page, httpResp, 0, false, err
+		}
+
+		count := countItems(page)
+		offset += int32(count)
+		if total, ok := pageTotal(httpResp); ok {
+			return page, httpResp, count, count > 0 && int(offset) < total, nil
+		}
+		return page, httpResp, count, count > 0, nil
+	}
+}
+
+func markerPager[T any](
+	fetchPage func(limit int32, marker string) (T, *http.Response, error),
+	countItems func(T) int,
+	nextMarker func(T) string,
+) pager[T] {
+	marker := ""
+	return func() (T, *http.Response, int, bool, error) {
+		page, httpResp, err := fetchPage(DefaultPageSize, marker)
+		if err != nil {
+			return page, httpResp, 0, false, err
+		}
+
+		count := countItems(page)
+		next := nextMarker(page)
+		more := count > 0 && next != "" && next != marker
+		marker = next
+		return page, httpResp, count, more, nil
+	}
+}
+
+// pageTotal reads the total item count that list APIs report in the pagination object of the
+// response body. The body is restored for later readers.
+func pageTotal(httpResp *http.Response) (int, bool) {
+	if httpResp == nil || httpResp.Body == nil {
+		return 0, false
+	}
+	body, err := io.ReadAll(httpResp.Body)
+	_ = httpResp.Body.Close()
+	httpResp.Body = io.NopCloser(bytes.NewReader(body))
+	if err != nil {
+		return 0, false
+	}
+
+	var decoded struct {
+		Pagination *struct {
+			Total *int `json:"total"`
+		} `json:"pagination"`
+	}
+	if err := json.Unmarshal(body, &decoded); err != nil || decoded.Pagination == nil || decoded.Pagination.Total == nil {
+		return 0, false
+	}
+	return *decoded.Pagination.Total, true
+}
+
+func listPages[T any](
+	ctx context.Context,
+	kc *KakaoCloudClient,
+	obj interface{},
+	respDiags *diag.Diagnostics,
+	service string,
+	allowPartial bool,
+	next pager[T],
+) ([]T, *http.Response, error) {
+	var pages []T
+	var httpResp *http.Response
+	fetched := 0
+
+	for {
+		if len(pages) > 0 && ctx.Err() != nil {
+			if !allowPartial {
+				return nil, httpResp, fmt.Errorf("listing stopped after %d items: %w", fetched, ctx.Err())
+			}
+			addPartialListWarning(ctx, obj, respDiags, fetched)
+			return pages, httpResp, nil
+		}
+
+		var count int
+		var more bool
+		page, resp, err := ExecuteWithRetryAndAuth(ctx, kc, respDiags, service,
+			func() (T, *http.Response, error) {
+				page, resp, n, m, err := next()
+				count, more = n, m
+				return page, resp, err
+			},
+		)
+		if err != nil {
+			if allowPartial && len(pages) > 0 && ctx.Err() != nil {
+				addPartialListWarning(ctx, obj, respDiags, fetched)
+				return pages, httpResp, nil
+			}
+			return nil, resp, err
+		}
+		httpResp = resp
+
+		pages = append(pages, page)
+		fetched += count
+
+		if !more {
+			return pages, httpResp, nil
+		}
+
+		tflog.Debug(ctx, fmt.Sprintf("Fetched %d items so far, requesting the next page", fetched))
+	}
+}
+
+func addPartialListWarning(ctx context.Context, obj interface{}, respDiags *diag.Diagnostics, fetched int) {
+	typeName, tfObjectType := ExtractTypeMetadata(ctx, obj)
+	respDiags.AddWarning(
+		fmt.Sprintf("Partial results for %s: %s", tfObjectType, typeName),
+		fmt.Sprintf("The timeout was reached while paging through results. Only the first %d items were read; increase the read timeout to fetch the complete list.", fetched),
+	)
+}
@@ -0,0 +1,189 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+package common
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+)
+
+// DefaultRateLimitBurst is the burst used once a rate limit is configured. Requests are not
+// limited unless rate_limit sets a rate.
+const DefaultRateLimitBurst = 20
+
+// Service keys identify the API a request is sent to. They match the service endpoint keys
+// returned by the config API and are the keys accepted in rate_limit.services.
+const (
+	ServiceIAM              = "iam"
+	ServiceConfig           = "config"
+	ServiceVPC              = "vpc"
+	ServiceNetwork          = "network"
+	ServiceLoadBalancer     = "load-balancer"
+	ServiceVolume           = "volume"
+	ServiceImage            = "image"
+	ServiceBCS              = "bcs"
+	ServiceKubernetesEngine = "kubernetes-engine"
+	ServiceTGW              = "tgw"
+	ServiceMySQL            = "mysql"
+)
+
+var RateLimitServices = []string{
+	ServiceIAM,
+	ServiceConfig,
+	ServiceVPC,
+	ServiceNetwork,
+	ServiceLoadBalancer,
+	ServiceVolume,
+	ServiceImage,
+	ServiceBCS,
+	ServiceKubernetesEngine,
+	ServiceTGW,
+	ServiceMySQL,
+}
+
+// RateLimiter is a set of per-service token buckets shared by every request of a client.
+type RateLimiter struct {
+	mu      sync.Mutex
+	rate    float64
+	burst   int
+	rates   map[string]float64
+	buckets map[string]*tokenBucket
+}
+
+type tokenBucket struct {
+	rate   float64
+	burst  float64
+	tokens float64
+	last   time.Time
+}
+
+func NewRateLimiter(requestsPerSecond float64, burst int, serviceRates map[string]float64) *RateLimiter {
+	if burst < 1 {
+		burst = 1
+	}
+	rates := make(map[string]float64, len(serviceRates))
+	for service, rate := range serviceRates {
+		rates[service] = rate
+	}
+	return &RateLimiter{
+		rate:    requestsPerSecond,
+		burst:   burst,
+		rates:   rates,
+		buckets: make(map[string]*tokenBucket),
+	}
+}
+
+// newRateLimiter returns nil, which disables limiting, unless the configuration sets a rate for
+// all services or for at least one of them.
+func newRateLimiter(config *Config) (*RateLimiter, error) {
+	rate := 0.0
+	if !config.RateLimitRequestsPerSecond.IsNull() && !config.RateLimitRequestsPerSecond.IsUnknown() {
+		rate = config.RateLimitRequestsPerSecond.ValueFloat64()
+		if rate < 0 {
+			return nil, fmt.Errorf("rate_limit.requests_per_second must not be negative")
+		}
+	}
+
+	burst := DefaultRateLimitBurst
+	if !config.RateLimitBurst.IsNull() && !config.RateLimitBurst.IsUnknown() {
+		if config.RateLimitBurst.ValueInt64() < 1 {
+			return nil, fmt.Errorf("rate_limit.burst must be at least 1")
+		}
+		burst = int(config.RateLimitBurst.ValueInt64())
+	}
+
+	for service, serviceRate := range config.ServiceRateLimits {
+		if !slices.Contains(RateLimitServices, service) {
+			return nil, fmt.Errorf("rate_limit.services key %q must be one of: %s", service, strings.Join(RateLimitServices, ", "))
+		}
+		if serviceRate < 0 {
+			return nil, fmt.Errorf("rate_limit.services[%q] must not be negative", service)
+		}
+	}
+
+	enabled := rate > 0
+	for _, serviceRate := range config.ServiceRateLimits {
+		enabled = enabled || serviceRate > 0
+	}
+	if !enabled {
+		return nil, nil
+	}
+
+	return NewRateLimiter(rate, burst, config.ServiceRateLimits), nil
+}
+
+// Wait blocks until the service budget allows another request or ctx is done.
+// A budget of zero requests per second disables limiting for that service.
+func (l *RateLimiter) Wait(ctx context.Context, service string) error {
+	if l == nil {
+		return nil
+	}
+
+	l.mu.Lock()
+	bucket := l.bucket(service)
+	if bucket == nil {
+		l.mu.Unlock()
+		return nil
+	}
+	delay := bucket.reserve(time.Now())
+	l.mu.Unlock()
+
+	if delay <= 0 {
+		return nil
+	}
+
+	timer := time.NewTimer(delay)
+	defer timer.Stop()
+	select {
+	case <-timer.C:
+		return nil
+	case <-ctx.Done():
+		l.mu.Lock()
+		bucket.tokens = min(bucket.tokens+1, bucket.burst)
+		l.mu.Unlock()
+		return ctx.Err()
+	}
+}
+
+func (l *RateLimiter) bucket(service string) *tokenBucket {
+	if bucket, ok := l.buckets[service]; ok {
+		return bucket
+	}
+
+	rate := l.rate
+	if serviceRate, ok := l.rates[service]; ok {
+		rate = serviceRate
+	}
+	if rate <= 0 {
+		l.buckets[service] = nil
+		return nil
+	}
+
+	bucket := &tokenBucket{
+		rate:   rate,
+		burst:  float64(l.burst),
+		tokens: float64(l.burst),
+		last:   time.Now(),
+	}
+	l.buckets[service] = bucket
+	return bucket
+}
+
+// reserve takes a token and returns how long the caller must wait for it. Tokens may go
+// negative, so concurrent callers queue up behind each other instead of waking together.
+func (b *tokenBucket) reserve(now time.Time) time.Duration {
+	if elapsed := now.Sub(b.last); elapsed > 0 {
+		b.tokens = min(b.tokens+elapsed.Seconds()*b.rate, b.burst)
+		b.last = now
+	}
+
+	b.tokens--
+	if b.tokens >= 0 {
+		return 0
+	}
+	return time.Duration(-b.tokens / b.rate * float64(time.Second))
+}
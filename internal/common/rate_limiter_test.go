@@ -0,0 +1,90 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+package common
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestTokenBucketReserve(t *testing.T) {
+	now := time.Now()
+	bucket := &tokenBucket{rate: 2, burst: 2, tokens: 2, last: now}
+
+	if d := bucket.reserve(now); d != 0 {
+		t.Fatalf("first reserve() = %s, want 0", d)
+	}
+	if d := bucket.reserve(now); d != 0 {
+		t.Fatalf("second reserve() = %s, want 0", d)
+	}
+	if d := bucket.reserve(now); d != 500*time.Millisecond {
+		t.Fatalf("third reserve() = %s, want 500ms", d)
+	}
+	if d := bucket.reserve(now); d != time.Second {
+		t.Fatalf("fourth reserve() = %s, want 1s", d)
+	}
+
+	if d := bucket.reserve(now.Add(10 * time.Second)); d != 0 {
+		t.Fatalf("reserve() after refill = %s, want 0", d)
+	}
+	if bucket.tokens != 1 {
+		t.Errorf("tokens = %v, want the refill capped at burst", bucket.tokens)
+	}
+}
+
+func TestRateLimiterWait(t *testing.T) {
+	limiter := NewRateLimiter(1, 1, map[string]float64{"vpc": 0})
+	ctx := context.Background()
+
+	for i := 0; i < 5; i++ {
+		if err := limiter.Wait(ctx, "vpc"); err != nil {
+			t.Fatalf("Wait(vpc) error = %v", err)
+		}
+	}
+
+	if err := limiter.Wait(ctx, "bcs"); err != nil {
+		t.Fatalf("Wait(bcs) error = %v", err)
+	}
+	cancelled, cancel := context.WithTimeout(ctx, 10*time.Millisecond)
+	defer cancel()
+	if err := limiter.Wait(cancelled, "bcs"); !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("Wait(bcs) error = %v, want %v", err, context.DeadlineExceeded)
+	}
+
+	var nilLimiter *RateLimiter
+	if err := nilLimiter.Wait(ctx, "bcs"); err != nil {
+		t.Errorf("nil Wait() error = %v", err)
+	}
+}
+
+func TestNewRateLimiter(t *testing.T) {
+	limiter, err := newRateLimiter(&Config{})
+	if err != nil {
+		t.Fatalf("newRateLimiter(empty) error = %v", err)
+	}
+	if limiter != nil {
+		t.Errorf("newRateLimiter(empty) = %+v, want no limiter", limiter)
+	}
+
+	limiter, err = newRateLimiter(&Config{ServiceRateLimits: map[string]float64{ServiceLoadBalancer: 5}})
+	if err != nil {
+		t.Fatalf("newRateLimiter(services) error = %v", err)
+	}
+	if limiter == nil || limiter.rate != 0 || limiter.burst != DefaultRateLimitBurst {
+		t.Errorf("newRateLimiter(services) = %+v, want only %s limited", limiter, ServiceLoadBalancer)
+	}
+
+	if _, err := newRateLimiter(&Config{ServiceRateLimits: map[string]float64{"loadbalancer": 1}}); err == nil {
+		t.Error("expected an error for an unknown service key")
+	}
+	if _, err := newRateLimiter(&Config{RateLimitBurst: types.Int64Value(0)}); err == nil {
+		t.Error("expected an error for a burst below 1")
+	}
+	if _, err := newRateLimiter(&Config{RateLimitRequestsPerSecond: types.Float64Value(-1)}); err == nil {
+		t.Error("expected an error for a negative rate")
+	}
+}
@@ -0,0 +1,137 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+package common
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/rand/v2"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	// DefaultMaxRetries replaces the former budget of 1000 retries one second apart. With the
+	// backoff capped at DefaultRetryMaxBackoff, 20 retries wait up to about eight minutes.
+	DefaultMaxRetries      = 20
+	DefaultRetryMinBackoff = 1 * time.Second
+	DefaultRetryMaxBackoff = 30 * time.Second
+)
+
+// RetryPolicy controls how ExecuteWithRetryAndAuth retries throttled and transient failures.
+type RetryPolicy struct {
+	MaxRetries int
+	MinBackoff time.Duration
+	MaxBackoff time.Duration
+}
+
+func DefaultRetryPolicy() RetryPolicy {
+	return RetryPolicy{
+		MaxRetries: DefaultMaxRetries,
+		MinBackoff: DefaultRetryMinBackoff,
+		MaxBackoff: DefaultRetryMaxBackoff,
+	}
+}
+
+func newRetryPolicy(config *Config) (RetryPolicy, error) {
+	policy := DefaultRetryPolicy()
+
+	if !config.MaxRetries.IsNull() && !config.MaxRetries.IsUnknown() {
+		if config.MaxRetries.ValueInt64() < 0 {
+			return policy, fmt.Errorf("max_retries must not be negative")
+		}
+		policy.MaxRetries = int(config.MaxRetries.ValueInt64())
+	}
+	if v := config.RetryMinBackoff.ValueString(); v != "" {
+		d, err := time.ParseDuration(v)
+		if err != nil || d <= 0 {
+			return policy, fmt.Errorf("retry_min_backoff %q must be a positive duration", v)
+		}
+		policy.MinBackoff = d
+	}
+	if v := config.RetryMaxBackoff.ValueString(); v != "" {
+		d, err := time.ParseDuration(v)
+		if err != nil || d <= 0 {
+			return policy, fmt.Errorf("retry_max_backoff %q must be a positive duration", v)
+		}
+		policy.MaxBackoff = d
+	}
+	if policy.MaxBackoff < policy.MinBackoff {
+		return policy, fmt.Errorf("retry_max_backoff (%s) must not be less than retry_min_backoff (%s)", policy.MaxBackoff, policy.MinBackoff)
+	}
+	return policy, nil
+}
+
+// Backoff returns how long to wait before the given retry attempt, starting at 1.
+// A Retry-After header on the response takes precedence over the exponential backoff, within
+// MinBackoff and MaxBackoff.
+func (p RetryPolicy) Backoff(attempt int, httpResp *http.Response) time.Duration {
+	if httpResp != nil {
+		if d, ok := parseRetryAfter(httpResp.Header.Get("Retry-After"), time.Now()); ok {
+			return min(max(d, p.MinBackoff), p.MaxBackoff)
+		}
+	}
+
+	backoff := p.MinBackoff
+	for i := 1; i < attempt && backoff < p.MaxBackoff; i++ {
+		backoff *= 2
+	}
+	backoff = min(backoff, p.MaxBackoff)
+
+	half := backoff / 2
+	if half <= 0 {
+		return backoff
+	}
+	return half + rand.N(half+1)
+}
+
+func parseRetryAfter(value string, now time.Time) (time.Duration, bool) {
+	value = strings.TrimSpace(value)
+	if value == "" {
+		return 0, false
+	}
+	if seconds, err := strconv.Atoi(value); err == nil {
+		if seconds < 0 {
+			return 0, false
+		}
+		return time.Duration(seconds) * time.Second, true
+	}
+	if at, err := http.ParseTime(value); err == nil {
+		return max(at.Sub(now), 0), true
+	}
+	return 0, false
+}
+
+// retryReason reports why a response should be retried, or an empty string when it is final.
+// Throttled requests are always retried; gateway errors and network failures only for
+// idempotent reads.
+func retryReason(httpResp *http.Response, err error) string {
+	if httpResp != nil {
+		switch httpResp.StatusCode {
+		case http.StatusTooManyRequests:
+			return "throttled"
+		case http.StatusBadGateway, http.StatusServiceUnavailable, http.StatusGatewayTimeout:
+			if httpResp.Request != nil && isIdempotentMethod(httpResp.Request.Method) {
+				return "server error"
+			}
+		}
+		return ""
+	}
+
+	if err == nil || errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) {
+		return ""
+	}
+	var urlErr *url.Error
+	if errors.As(err, &urlErr) && isIdempotentMethod(urlErr.Op) {
+		return "network error"
+	}
+	return ""
+}
+
+func isIdempotentMethod(method string) bool {
+	return strings.EqualFold(method, http.MethodGet) || strings.EqualFold(method, http.MethodHead)
+}
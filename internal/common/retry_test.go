@@ -0,0 +1,124 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+package common
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestParseRetryAfter(t *testing.T) {
+	now := time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)
+
+	for _, tc := range []struct {
+		value string
+		want  time.Duration
+		ok    bool
+	}{
+		{value: "", ok: false},
+		{value: "7", want: 7 * time.Second, ok: true},
+		{value: "-1", ok: false},
+		{value: now.Add(90 * time.Second).Format(http.TimeFormat), want: 90 * time.Second, ok: true},
+		{value: now.Add(-time.Minute).Format(http.TimeFormat), want: 0, ok: true},
+		{value: "soon", ok: false},
+	} {
+		got, ok := parseRetryAfter(tc.value, now)
+		if ok != tc.ok || got != tc.want {
+			t.Errorf("parseRetryAfter(%q) = %s, %t, want %s, %t", tc.value, got, ok, tc.want, tc.ok)
+		}
+	}
+}
+
+func TestRetryPolicyBackoff(t *testing.T) {
+	policy := RetryPolicy{MaxRetries: 5, MinBackoff: 100 * time.Millisecond, MaxBackoff: time.Second}
+
+	for attempt, ceiling := range map[int]time.Duration{
+		1: 100 * time.Millisecond,
+		2: 200 * time.Millisecond,
+		4: 800 * time.Millisecond,
+		5: time.Second,
+		9: time.Second,
+	} {
+		for i := 0; i < 20; i++ {
+			got := policy.Backoff(attempt, nil)
+			if got < ceiling/2 || got > ceiling {
+				t.Fatalf("Backoff(%d) = %s, want between %s and %s", attempt, got, ceiling/2, ceiling)
+			}
+		}
+	}
+
+	resp := &http.Response{Header: http.Header{"Retry-After": []string{"3"}}}
+	if got := policy.Backoff(1, resp); got != time.Second {
+		t.Errorf("Backoff with Retry-After = %s, want it capped at 1s", got)
+	}
+
+	resp = &http.Response{Header: http.Header{"Retry-After": []string{"0"}}}
+	if got := policy.Backoff(1, resp); got != 100*time.Millisecond {
+		t.Errorf("Backoff with Retry-After = %s, want at least 100ms", got)
+	}
+
+	policy.MaxBackoff = 5 * time.Second
+	resp = &http.Response{Header: http.Header{"Retry-After": []string{"3"}}}
+	if got := policy.Backoff(1, resp); got != 3*time.Second {
+		t.Errorf("Backoff with Retry-After = %s, want 3s", got)
+	}
+}
+
+func TestRetryReason(t *testing.T) {
+	response := func(method string, status int) *http.Response {
+		return &http.Response{StatusCode: status, Request: &http.Request{Method: method}}
+	}
+
+	for _, tc := range []struct {
+		name string
+		resp *http.Response
+		err  error
+		want string
+	}{
+		{name: "throttled post", resp: response(http.MethodPost, http.StatusTooManyRequests), want: "throttled"},
+		{name: "unavailable get", resp: response(http.MethodGet, http.StatusServiceUnavailable), want: "server error"},
+		{name: "gateway timeout get", resp: response(http.MethodGet, http.StatusGatewayTimeout), want: "server error"},
+		{name: "bad gateway post", resp: response(http.MethodPost, http.StatusBadGateway), want: ""},
+		{name: "internal error get", resp: response(http.MethodGet, http.StatusInternalServerError), want: ""},
+		{name: "not found", resp: response(http.MethodGet, http.StatusNotFound), want: ""},
+		{name: "network get", err: &url.Error{Op: "Get", URL: "https://example.com", Err: errors.New("connection reset by peer")}, want: "network error"},
+		{name: "network post", err: &url.Error{Op: "Post", URL: "https://example.com", Err: errors.New("connection reset by peer")}, want: ""},
+		{name: "cancelled", err: &url.Error{Op: "Get", URL: "https://example.com", Err: context.Canceled}, want: ""},
+	} {
+		if got := retryReason(tc.resp, tc.err); got != tc.want {
+			t.Errorf("%s: retryReason() = %q, want %q", tc.name, got, tc.want)
+		}
+	}
+}
+
+func TestNewRetryPolicy(t *testing.T) {
+	policy, err := newRetryPolicy(&Config{})
+	if err != nil || policy != DefaultRetryPolicy() {
+		t.Fatalf("newRetryPolicy(empty) = %+v, %v", policy, err)
+	}
+
+	policy, err = newRetryPolicy(&Config{
+		MaxRetries:      types.Int64Value(3),
+		RetryMinBackoff: types.StringValue("250ms"),
+		RetryMaxBackoff: types.StringValue("5s"),
+	})
+	if err != nil {
+		t.Fatalf("newRetryPolicy() error = %v", err)
+	}
+	if policy.MaxRetries != 3 || policy.MinBackoff != 250*time.Millisecond || policy.MaxBackoff != 5*time.Second {
+		t.Errorf("newRetryPolicy() = %+v", policy)
+	}
+
+	if _, err := newRetryPolicy(&Config{RetryMinBackoff: types.StringValue("10s"), RetryMaxBackoff: types.StringValue("1s")}); err == nil {
+		t.Error("expected an error when retry_max_backoff is less than retry_min_backoff")
+	}
+	if _, err := newRetryPolicy(&Config{RetryMinBackoff: types.StringValue("fast")}); err == nil {
+		t.Error("expected an error for an invalid duration")
+	}
+}
@@ -0,0 +1,165 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+package common
+
+import (
+	"context"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	rschema "github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+const TagFilterPrefix = "tag:"
+
+// TaggedResourceTypes lists the resources that support tags and default_tags. Tags are stored as
+// user metadata, which the APIs of other resources do not offer.
+var TaggedResourceTypes = []string{
+	"kakaocloud_volume",
+}
+
+func TagsResourceSchemaAttributes() map[string]rschema.Attribute {
+	return map[string]rschema.Attribute{
+		"tags": rschema.MapAttribute{
+			ElementType: types.StringType,
+			Optional:    true,
+		},
+		"tags_all": rschema.MapAttribute{
+			ElementType: types.StringType,
+			Computed:    true,
+		},
+	}
+}
+
+func ExpandTags(ctx context.Context, tags types.Map, respDiags *diag.Diagnostics) map[string]string {
+	result := make(map[string]string)
+	if tags.IsNull() || tags.IsUnknown() {
+		return result
+	}
+	respDiags.Append(tags.ElementsAs(ctx, &result, false)...)
+	return result
+}
+
+func MergeTags(defaultTags map[string]string, tags map[string]string) map[string]string {
+	merged := make(map[string]string, len(defaultTags)+len(tags))
+	for k, v := range defaultTags {
+		merged[k] = v
+	}
+	for k, v := range tags {
+		merged[k] = v
+	}
+	return merged
+}
+
+// ModifyPlanTagsAll sets tags_all in the plan to the provider default_tags merged with the resource tags.
+func ModifyPlanTagsAll(
+	ctx context.Context,
+	kc *KakaoCloudClient,
+	req resource.ModifyPlanRequest,
+	resp *resource.ModifyPlanResponse,
+) {
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var tags types.Map
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("tags"), &tags)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if kc == nil || tags.IsUnknown() {
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("tags_all"), types.MapUnknown(types.StringType))...)
+		return
+	}
+
+	tagMap := make(map[string]string)
+	for k, v := range tags.Elements() {
+		s, ok := v.(types.String)
+		if !ok || s.IsUnknown() {
+			resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("tags_all"), types.MapUnknown(types.StringType))...)
+			return
+		}
+		tagMap[k] = s.ValueString()
+	}
+
+	tagsAll, diags := types.MapValueFrom(ctx, types.StringType, MergeTags(kc.Config.DefaultTags, tagMap))
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("tags_all"), tagsAll)...)
+}
+
+// FlattenTags maps remote metadata back to tags and tags_all. Only keys that are already managed
+// by the resource are kept, so metadata written by the platform or other tools does not show up as drift.
+func FlattenTags(
+	ctx context.Context,
+	remote map[string]string,
+	priorTags types.Map,
+	priorTagsAll types.Map,
+	respDiags *diag.Diagnostics,
+) (types.Map, types.Map) {
+	managed := ExpandTags(ctx, priorTags, respDiags)
+	managedAll := MergeTags(ExpandTags(ctx, priorTagsAll, respDiags), managed)
+
+	tags := make(map[string]string)
+	for k := range managed {
+		if v, ok := remote[k]; ok {
+			tags[k] = v
+		}
+	}
+	tagsAll := make(map[string]string)
+	for k := range managedAll {
+		if v, ok := remote[k]; ok {
+			tagsAll[k] = v
+		}
+	}
+
+	tagsValue := types.MapNull(types.StringType)
+	if !priorTags.IsNull() {
+		v, diags := types.MapValueFrom(ctx, types.StringType, tags)
+		respDiags.Append(diags...)
+		tagsValue = v
+	}
+	tagsAllValue, diags := types.MapValueFrom(ctx, types.StringType, tagsAll)
+	respDiags.Append(diags...)
+
+	return tagsValue, tagsAllValue
+}
+
+// BuildTagsMetadata returns the full metadata to send to the API: the remote metadata with
+// previously managed keys removed and the new tags_all applied on top.
+func BuildTagsMetadata(remote map[string]string, oldTagsAll map[string]string, newTagsAll map[string]string) map[string]string {
+	result := make(map[string]string, len(remote)+len(newTagsAll))
+	for k, v := range remote {
+		if _, managed := oldTagsAll[k]; managed {
+			continue
+		}
+		result[k] = v
+	}
+	for k, v := range newTagsAll {
+		result[k] = v
+	}
+	return result
+}
+
+func ParseTagFilterName(name string) (string, bool) {
+	if !strings.HasPrefix(name, TagFilterPrefix) {
+		return "", false
+	}
+	key := strings.TrimPrefix(name, TagFilterPrefix)
+	return key, key != ""
+}
+
+func MatchTagFilters(tags map[string]string, tagFilters map[string]string) bool {
+	for k, v := range tagFilters {
+		if tv, ok := tags[k]; !ok || tv != v {
+			return false
+		}
+	}
+	return true
+}
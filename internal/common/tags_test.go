@@ -0,0 +1,104 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+package common
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestMergeTags(t *testing.T) {
+	got := MergeTags(
+		map[string]string{"owner": "platform", "environment": "default"},
+		map[string]string{"environment": "prod"},
+	)
+	want := map[string]string{"owner": "platform", "environment": "prod"}
+	if !reflect.DeepEqual(got, want) {
+		t.Fatalf("MergeTags() = %v, want %v", got, want)
+	}
+}
+
+func TestBuildTagsMetadata(t *testing.T) {
+	remote := map[string]string{"readonly": "False", "owner": "platform", "stale": "x"}
+	oldTagsAll := map[string]string{"owner": "platform", "stale": "x"}
+	newTagsAll := map[string]string{"owner": "team"}
+
+	got := BuildTagsMetadata(remote, oldTagsAll, newTagsAll)
+	want := map[string]string{"readonly": "False", "owner": "team"}
+	if !reflect.DeepEqual(got, want) {
+		t.Fatalf("BuildTagsMetadata() = %v, want %v", got, want)
+	}
+}
+
+func TestFlattenTags(t *testing.T) {
+	ctx := context.Background()
+	var diags diag.Diagnostics
+
+	priorTags := types.MapValueMust(types.StringType, map[string]attr.Value{
+		"environment": types.StringValue("dev"),
+	})
+	priorTagsAll := types.MapValueMust(types.StringType, map[string]attr.Value{
+		"environment": types.StringValue("dev"),
+		"owner":       types.StringValue("platform"),
+	})
+	remote := map[string]string{"environment": "prod", "owner": "platform", "attached_mode": "rw"}
+
+	tags, tagsAll := FlattenTags(ctx, remote, priorTags, priorTagsAll, &diags)
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+
+	gotTags := ExpandTags(ctx, tags, &diags)
+	if want := map[string]string{"environment": "prod"}; !reflect.DeepEqual(gotTags, want) {
+		t.Errorf("tags = %v, want %v", gotTags, want)
+	}
+	gotTagsAll := ExpandTags(ctx, tagsAll, &diags)
+	if want := map[string]string{"environment": "prod", "owner": "platform"}; !reflect.DeepEqual(gotTagsAll, want) {
+		t.Errorf("tags_all = %v, want %v", gotTagsAll, want)
+	}
+
+	tags, _ = FlattenTags(ctx, remote, types.MapNull(types.StringType), priorTagsAll, &diags)
+	if !tags.IsNull() {
+		t.Errorf("tags = %v, want null when not configured", tags)
+	}
+}
+
+func TestParseTagFilterName(t *testing.T) {
+	tests := []struct {
+		name   string
+		key    string
+		wantOk bool
+	}{
+		{name: "tag:environment", key: "environment", wantOk: true},
+		{name: "tag:", wantOk: false},
+		{name: "name", wantOk: false},
+	}
+	for _, tt := range tests {
+		key, ok := ParseTagFilterName(tt.name)
+		if key != tt.key || ok != tt.wantOk {
+			t.Errorf("ParseTagFilterName(%q) = (%q, %v), want (%q, %v)", tt.name, key, ok, tt.key, tt.wantOk)
+		}
+	}
+}
+
+func TestMatchTagFilters(t *testing.T) {
+	tags := map[string]string{"environment": "prod", "empty": ""}
+
+	if !MatchTagFilters(tags, map[string]string{"environment": "prod"}) {
+		t.Error("expected match on equal value")
+	}
+	if MatchTagFilters(tags, map[string]string{"environment": "dev"}) {
+		t.Error("expected no match on different value")
+	}
+	if MatchTagFilters(tags, map[string]string{"missing": ""}) {
+		t.Error("expected no match on missing key")
+	}
+	if !MatchTagFilters(tags, map[string]string{"empty": ""}) {
+		t.Error("expected match on empty value")
+	}
+}
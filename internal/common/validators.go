@@ -182,6 +182,35 @@ func (v base64Validator) ValidateString(_ context.Context, req validator.StringR
 	}
 }
 
+func DurationValidator() []validator.String {
+	return []validator.String{durationValidator{}}
+}
+
+type durationValidator struct{}
+
+func (v durationValidator) Description(_ context.Context) string {
+	return "Value must be a positive duration such as 500ms, 2s or 1m"
+}
+
+func (v durationValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v durationValidator) ValidateString(_ context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	d, err := time.ParseDuration(req.ConfigValue.ValueString())
+	if err != nil || d <= 0 {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid duration",
+			fmt.Sprintf("%q is not a positive duration such as 500ms, 2s or 1m.", req.ConfigValue.ValueString()),
+		)
+	}
+}
+
 func NameValidator(maxLength int) []validator.String {
 	return []validator.String{
 		stringvalidator.LengthBetween(4, maxLength),
@@ -6,7 +6,6 @@ import (
 	"context"
 	"fmt"
 	"net/http"
-	"strings"
 	"time"
 
 	"github.com/hashicorp/terraform-plugin-framework/diag"
@@ -55,6 +54,7 @@ func PollUntilResultWithTimeout[T any](
 		case <-ticker.C:
 			result, httpResp, err := fetch(ctxWithTimeout)
 			if err != nil {
+				err = NewAPIError(httpResp, err)
 
 				if isTransientPollError(err) {
 					tflog.Warn(ctxWithTimeout, fmt.Sprintf(
@@ -64,7 +64,7 @@ func PollUntilResultWithTimeout[T any](
 					continue
 				}
 
-				if httpResp != nil && httpResp.StatusCode == http.StatusNotFound {
+				if IsNotFound(err) {
 					retry404Count++
 					if retry404Count <= max404Retries {
 						tflog.Warn(ctxWithTimeout, fmt.Sprintf(
@@ -108,24 +108,7 @@ func PollUntilResultWithTimeout[T any](
 }
 
 func isTransientPollError(err error) bool {
-	if err == nil {
-		return false
-	}
-
-	msg := err.Error()
-
-	switch {
-	case strings.Contains(msg, "GOAWAY"):
-		return true
-	case strings.Contains(msg, "connection reset"):
-		return true
-	case strings.Contains(msg, "broken pipe"):
-		return true
-	case strings.Contains(msg, "EOF"):
-		return true
-	}
-
-	return false
+	return IsTransientNetworkError(err) || IsThrottled(err) || IsServiceUnavailable(err)
 }
 
 func PollUntilResult[T any](
@@ -173,6 +156,8 @@ func PollUntilDeletion(
 			deleted, httpResp, err := check(ctx)
 
 			if err != nil {
+				err = NewAPIError(httpResp, err)
+
 				if isTransientPollError(err) {
 					tflog.Warn(ctx, fmt.Sprintf(
 						"Transient polling error (%v). Retrying...",
@@ -181,7 +166,7 @@ func PollUntilDeletion(
 					continue
 				}
 
-				if httpResp != nil && httpResp.StatusCode == http.StatusNotFound {
+				if IsNotFound(err) {
 					return
 				}
 
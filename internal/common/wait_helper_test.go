@@ -0,0 +1,177 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+package common
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/url"
+	"syscall"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+)
+
+const testPollInterval = 5 * time.Millisecond
+
+type fakeStatus struct {
+	status string
+}
+
+func TestPollUntilResult_reachesTargetStatus(t *testing.T) {
+	var diags diag.Diagnostics
+	statuses := []string{"creating", "creating", "available"}
+	calls := 0
+
+	result, ok := PollUntilResult(context.Background(), nil, testPollInterval, "volume", "vol-1",
+		[]string{"available", "error"}, &diags,
+		func(ctx context.Context) (*fakeStatus, *http.Response, error) {
+			s := statuses[calls]
+			calls++
+			return &fakeStatus{status: s}, &http.Response{StatusCode: http.StatusOK}, nil
+		},
+		func(v *fakeStatus) string { return v.status },
+	)
+
+	if !ok || diags.HasError() {
+		t.Fatalf("expected success, got ok=%v diags=%v", ok, diags)
+	}
+	if result.status != "available" {
+		t.Errorf("status = %q, want %q", result.status, "available")
+	}
+	if calls != 3 {
+		t.Errorf("fetch called %d times, want 3", calls)
+	}
+}
+
+func TestPollUntilResult_retriesNotFound(t *testing.T) {
+	var diags diag.Diagnostics
+	calls := 0
+
+	_, ok := PollUntilResult(context.Background(), nil, testPollInterval, "volume", "vol-1",
+		[]string{"available"}, &diags,
+		func(ctx context.Context) (*fakeStatus, *http.Response, error) {
+			calls++
+			if calls <= 3 {
+				return nil, &http.Response{StatusCode: http.StatusNotFound}, errors.New("404 Not Found")
+			}
+			return &fakeStatus{status: "available"}, &http.Response{StatusCode: http.StatusOK}, nil
+		},
+		func(v *fakeStatus) string { return v.status },
+	)
+
+	if !ok || diags.HasError() {
+		t.Fatalf("expected success after transient 404s, got ok=%v diags=%v", ok, diags)
+	}
+}
+
+func TestPollUntilResult_givesUpAfterNotFoundRetries(t *testing.T) {
+	var diags diag.Diagnostics
+	calls := 0
+
+	_, ok := PollUntilResult(context.Background(), nil, testPollInterval, "volume", "vol-1",
+		[]string{"available"}, &diags,
+		func(ctx context.Context) (*fakeStatus, *http.Response, error) {
+			calls++
+			return nil, &http.Response{StatusCode: http.StatusNotFound}, errors.New("404 Not Found")
+		},
+		func(v *fakeStatus) string { return v.status },
+	)
+
+	if ok || !diags.HasError() {
+		t.Fatalf("expected failure, got ok=%v diags=%v", ok, diags)
+	}
+	if calls != 11 {
+		t.Errorf("fetch called %d times, want 11", calls)
+	}
+}
+
+func TestPollUntilResult_retriesTransientErrors(t *testing.T) {
+	var diags diag.Diagnostics
+	calls := 0
+
+	_, ok := PollUntilResult(context.Background(), nil, testPollInterval, "volume", "vol-1",
+		[]string{"available"}, &diags,
+		func(ctx context.Context) (*fakeStatus, *http.Response, error) {
+			calls++
+			if calls == 1 {
+				return nil, nil, &url.Error{Op: "Get", URL: "https://volume.example.com", Err: syscall.ECONNRESET}
+			}
+			return &fakeStatus{status: "available"}, &http.Response{StatusCode: http.StatusOK}, nil
+		},
+		func(v *fakeStatus) string { return v.status },
+	)
+
+	if !ok || diags.HasError() {
+		t.Fatalf("expected success after transient error, got ok=%v diags=%v", ok, diags)
+	}
+}
+
+func TestPollUntilResultWithTimeout_deadline(t *testing.T) {
+	var diags diag.Diagnostics
+	timeout := 30 * time.Millisecond
+
+	_, ok := PollUntilResultWithTimeout(context.Background(), nil, testPollInterval, &timeout, "volume", "vol-1",
+		[]string{"available"}, &diags,
+		func(ctx context.Context) (*fakeStatus, *http.Response, error) {
+			return &fakeStatus{status: "creating"}, &http.Response{StatusCode: http.StatusOK}, nil
+		},
+		func(v *fakeStatus) string { return v.status },
+	)
+
+	if ok || !diags.HasError() {
+		t.Fatalf("expected deadline error, got ok=%v diags=%v", ok, diags)
+	}
+}
+
+func TestPollUntilDeletion(t *testing.T) {
+	tests := []struct {
+		name      string
+		check     func(calls int) (bool, *http.Response, error)
+		wantError bool
+	}{
+		{
+			name: "not found",
+			check: func(calls int) (bool, *http.Response, error) {
+				if calls < 3 {
+					return false, &http.Response{StatusCode: http.StatusOK}, nil
+				}
+				return false, &http.Response{StatusCode: http.StatusNotFound}, errors.New("404 Not Found")
+			},
+		},
+		{
+			name: "deleted",
+			check: func(calls int) (bool, *http.Response, error) {
+				return calls >= 2, &http.Response{StatusCode: http.StatusOK}, nil
+			},
+		},
+		{
+			name: "server error",
+			check: func(calls int) (bool, *http.Response, error) {
+				return false, &http.Response{StatusCode: http.StatusInternalServerError}, errors.New("500 Internal Server Error")
+			},
+			wantError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var diags diag.Diagnostics
+			calls := 0
+
+			ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+			defer cancel()
+
+			PollUntilDeletion(ctx, nil, testPollInterval, &diags, func(ctx context.Context) (bool, *http.Response, error) {
+				calls++
+				return tt.check(calls)
+			})
+
+			if diags.HasError() != tt.wantError {
+				t.Errorf("HasError() = %v, want %v (diags=%v)", diags.HasError(), tt.wantError, diags)
+			}
+		})
+	}
+}
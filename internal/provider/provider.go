@@ -5,6 +5,8 @@ package provider
 import (
 	"context"
 	"fmt"
+	"strings"
+	"terraform-provider-kakaocloud/internal/auth"
 	"terraform-provider-kakaocloud/internal/common"
 	"terraform-provider-kakaocloud/internal/service/bcs"
 	"terraform-provider-kakaocloud/internal/service/iam"
@@ -18,12 +20,19 @@ import (
 	"terraform-provider-kakaocloud/internal/service/volume"
 	"terraform-provider-kakaocloud/internal/service/vpc"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/float64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/mapvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/action"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
 )
 
 var (
@@ -40,13 +49,39 @@ func New(version string) func() provider.Provider {
 }
 
 type kakaocloudProviderModel struct {
-	ServiceRealm                types.String `tfsdk:"service_realm"`
-	Region                      types.String `tfsdk:"region"`
-	EndpointOverrides           types.Map    `tfsdk:"endpoint_overrides"`
+	ServiceRealm                 types.String             `tfsdk:"service_realm"`
+	Region                       types.String             `tfsdk:"region"`
+	EndpointOverrides            types.Map                `tfsdk:"endpoint_overrides"`
+	ApplicationCredentialId      types.String             `tfsdk:"application_credential_id"`
+	ApplicationCredentialSecret  types.String             `tfsdk:"application_credential_secret"`
+	Profile                      types.String             `tfsdk:"profile"`
+	SharedCredentialsFile        types.String             `tfsdk:"shared_credentials_file"`
+	ProjectId                    types.String             `tfsdk:"project_id"`
+	MaxRetries                   types.Int64              `tfsdk:"max_retries"`
+	RetryMinBackoff              types.String             `tfsdk:"retry_min_backoff"`
+	RetryMaxBackoff              types.String             `tfsdk:"retry_max_backoff"`
+	CertificateExpiryWarningDays types.Int64              `tfsdk:"certificate_expiry_warning_days"`
+	DefaultTags                  types.Object             `tfsdk:"default_tags"`
+	RateLimit                    types.Object             `tfsdk:"rate_limit"`
+	ProjectCredentials           []projectCredentialModel `tfsdk:"project_credential"`
+}
+
+type projectCredentialModel struct {
+	ProjectId                   types.String `tfsdk:"project_id"`
 	ApplicationCredentialId     types.String `tfsdk:"application_credential_id"`
 	ApplicationCredentialSecret types.String `tfsdk:"application_credential_secret"`
 }
 
+type defaultTagsModel struct {
+	Tags types.Map `tfsdk:"tags"`
+}
+
+type rateLimitModel struct {
+	RequestsPerSecond types.Float64 `tfsdk:"requests_per_second"`
+	Burst             types.Int64   `tfsdk:"burst"`
+	Services          types.Map     `tfsdk:"services"`
+}
+
 type kakaocloudProvider struct {
 	version string
 }
@@ -85,6 +120,97 @@ func (p *kakaocloudProvider) Schema(_ context.Context, _ provider.SchemaRequest,
 				Description: "Application credential secret",
 				Sensitive:   true,
 			},
+			"profile": schema.StringAttribute{
+				Optional:    true,
+				Description: "Profile name in the shared credentials file",
+			},
+			"shared_credentials_file": schema.StringAttribute{
+				Optional:    true,
+				Description: "Path to the shared credentials file, ~/.kakaocloud/credentials by default",
+			},
+			"project_id": schema.StringAttribute{
+				Optional:    true,
+				Description: "Default project of API calls. Unless it is the application credential's own project, it needs a project_credential",
+				Validators:  common.UuidNoHyphenValidator(),
+			},
+			"max_retries": schema.Int64Attribute{
+				Optional:    true,
+				Description: "Maximum number of retries for throttled or transient API failures",
+				Validators:  []validator.Int64{int64validator.AtLeast(0)},
+			},
+			"retry_min_backoff": schema.StringAttribute{
+				Optional:    true,
+				Description: "Initial backoff between retries, e.g. 1s",
+				Validators:  common.DurationValidator(),
+			},
+			"retry_max_backoff": schema.StringAttribute{
+				Optional:    true,
+				Description: "Maximum backoff between retries, e.g. 30s",
+				Validators:  common.DurationValidator(),
+			},
+			"certificate_expiry_warning_days": schema.Int64Attribute{
+				Optional:    true,
+				Description: "Warn during plan when a load balancer certificate expires within this many days, 30 by default. 0 disables the warning",
+				Validators:  []validator.Int64{int64validator.AtLeast(0)},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"default_tags": schema.SingleNestedBlock{
+				Description: fmt.Sprintf("Tags applied to the resources that support tags: %s", strings.Join(common.TaggedResourceTypes, ", ")),
+				Attributes: map[string]schema.Attribute{
+					"tags": schema.MapAttribute{
+						ElementType: types.StringType,
+						Optional:    true,
+						Description: "Default tags merged into the tags of each resource that supports tags",
+					},
+				},
+			},
+			"project_credential": schema.ListNestedBlock{
+				Description: "Application credential for API calls in another project, through project_id or the project override of a resource. Application credentials are bound to the project they were created in, so each project needs its own",
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"project_id": schema.StringAttribute{
+							Required:    true,
+							Description: "Project the application credential was created in",
+							Validators:  common.UuidNoHyphenValidator(),
+						},
+						"application_credential_id": schema.StringAttribute{
+							Required:    true,
+							Description: "Application credential ID",
+							Sensitive:   true,
+						},
+						"application_credential_secret": schema.StringAttribute{
+							Required:    true,
+							Description: "Application credential secret",
+							Sensitive:   true,
+						},
+					},
+				},
+			},
+			"rate_limit": schema.SingleNestedBlock{
+				Description: "Client-side request budget for each service, shared by all parallel operations",
+				Attributes: map[string]schema.Attribute{
+					"requests_per_second": schema.Float64Attribute{
+						Optional:    true,
+						Description: "Requests per second allowed for each service. Requests are not limited when unset or 0",
+						Validators:  []validator.Float64{float64validator.AtLeast(0)},
+					},
+					"burst": schema.Int64Attribute{
+						Optional:    true,
+						Description: "Number of requests that may be sent at once before the limit applies, 20 by default",
+						Validators:  []validator.Int64{int64validator.AtLeast(1)},
+					},
+					"services": schema.MapAttribute{
+						ElementType: types.Float64Type,
+						Optional:    true,
+						Description: "Requests per second for individual services, keyed by service name",
+						Validators: []validator.Map{
+							mapvalidator.KeysAre(stringvalidator.OneOf(common.RateLimitServices...)),
+							mapvalidator.ValueFloat64sAre(float64validator.AtLeast(0)),
+						},
+					},
+				},
+			},
 		},
 	}
 }
@@ -109,12 +235,79 @@ func (p *kakaocloudProvider) Configure(ctx context.Context, req provider.Configu
 		}
 	}
 
+	defaultTags := make(map[string]string)
+	if !config.DefaultTags.IsNull() && !config.DefaultTags.IsUnknown() {
+		var dt defaultTagsModel
+		resp.Diagnostics.Append(config.DefaultTags.As(ctx, &dt, basetypes.ObjectAsOptions{})...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		if !dt.Tags.IsNull() && !dt.Tags.IsUnknown() {
+			resp.Diagnostics.Append(dt.Tags.ElementsAs(ctx, &defaultTags, false)...)
+			if resp.Diagnostics.HasError() {
+				return
+			}
+		}
+		if len(defaultTags) > 0 {
+			resp.Diagnostics.AddAttributeWarning(
+				path.Root("default_tags"),
+				"Default tags are only applied to some resources",
+				fmt.Sprintf("default_tags are applied to %s. Other resources do not support tags and ignore them.",
+					strings.Join(common.TaggedResourceTypes, ", ")),
+			)
+		}
+	}
+
+	var rateLimit rateLimitModel
+	serviceRateLimits := make(map[string]float64)
+	if !config.RateLimit.IsNull() && !config.RateLimit.IsUnknown() {
+		resp.Diagnostics.Append(config.RateLimit.As(ctx, &rateLimit, basetypes.ObjectAsOptions{})...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		if !rateLimit.Services.IsNull() && !rateLimit.Services.IsUnknown() {
+			resp.Diagnostics.Append(rateLimit.Services.ElementsAs(ctx, &serviceRateLimits, false)...)
+			if resp.Diagnostics.HasError() {
+				return
+			}
+		}
+	}
+
+	projectCredentials := make(map[string]auth.Credential, len(config.ProjectCredentials))
+	for _, credential := range config.ProjectCredentials {
+		projectId := credential.ProjectId.ValueString()
+		if _, exists := projectCredentials[projectId]; exists {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("project_credential"),
+				"Duplicate project credential",
+				fmt.Sprintf("More than one project_credential is set for project %s.", projectId),
+			)
+			return
+		}
+		projectCredentials[projectId] = auth.Credential{
+			ID:     credential.ApplicationCredentialId.ValueString(),
+			Secret: credential.ApplicationCredentialSecret.ValueString(),
+		}
+	}
+
 	authConfig := &common.Config{
-		ApplicationCredentialID:     config.ApplicationCredentialId,
-		ApplicationCredentialSecret: config.ApplicationCredentialSecret,
-		ServiceRealm:                config.ServiceRealm,
-		Region:                      config.Region,
-		EndpointOverrides:           endpointOverrides,
+		ApplicationCredentialID:      config.ApplicationCredentialId,
+		ApplicationCredentialSecret:  config.ApplicationCredentialSecret,
+		ServiceRealm:                 config.ServiceRealm,
+		Region:                       config.Region,
+		EndpointOverrides:            endpointOverrides,
+		DefaultTags:                  defaultTags,
+		Profile:                      config.Profile,
+		SharedCredentialsFile:        config.SharedCredentialsFile,
+		ProjectID:                    config.ProjectId,
+		ProjectCredentials:           projectCredentials,
+		MaxRetries:                   config.MaxRetries,
+		RetryMinBackoff:              config.RetryMinBackoff,
+		RetryMaxBackoff:              config.RetryMaxBackoff,
+		RateLimitRequestsPerSecond:   rateLimit.RequestsPerSecond,
+		RateLimitBurst:               rateLimit.Burst,
+		ServiceRateLimits:            serviceRateLimits,
+		CertificateExpiryWarningDays: config.CertificateExpiryWarningDays,
 	}
 
 	userAgent := "terraform-provider-kakaocloud/" + p.version
@@ -150,12 +343,14 @@ func (p *kakaocloudProvider) DataSources(_ context.Context) []func() datasource.
 		bcs.NewInstanceFlavorsDataSource,
 		bcs.NewKeypairDataSource,
 		bcs.NewKeypairsDataSource,
+		bcs.NewCloudinitConfigDataSource,
 
 		volume.NewVolumeTypesDataSource,
 		volume.NewVolumeDataSource,
 		volume.NewVolumesDataSource,
 		volume.NewVolumeSnapshotDataSource,
 		volume.NewVolumeSnapshotsDataSource,
+		volume.NewVolumeSnapshotScheduleSnapshotsDataSource,
 
 		image.NewImagesDataSource,
 		image.NewImageDataSource,
@@ -238,11 +433,16 @@ func (p *kakaocloudProvider) Resources(_ context.Context) []func() resource.Reso
 	return []func() resource.Resource{
 		bcs.NewInstanceResource,
 		bcs.NewKeypairResource,
+		bcs.NewVolumeAttachmentResource,
+		bcs.NewServerGroupResource,
 
 		volume.NewVolumeResource,
 		volume.NewVolumeSnapshotResource,
+		volume.NewVolumeSnapshotCopyResource,
+		volume.NewVolumeSnapshotScheduleResource,
 
 		image.NewImageResource,
+		image.NewImageCopyResource,
 		image.NewImageMemberResource,
 
 		vpc.NewVpcResource,
@@ -250,18 +450,23 @@ func (p *kakaocloudProvider) Resources(_ context.Context) []func() resource.Reso
 		vpc.NewSubnetShareResource,
 		vpc.NewRouteTableResource,
 		vpc.NewNetworkInterfaceResource,
+		vpc.NewVpcPeeringResource,
+		vpc.NewVpcPeeringAccepterResource,
 
 		network.NewPublicIpResource,
 		network.NewSecurityGroupResource,
+		network.NewSecurityGroupRuleResource,
 
 		loadbalancer.NewLoadBalancerResource,
 		loadbalancer.NewBeyondLoadBalancerResource,
 		loadbalancer.NewLoadBalancerListenerResource,
+		loadbalancer.NewLoadBalancerTlsCertificateResource,
 		loadbalancer.NewLoadBalancerL7PolicyResource,
 		loadbalancer.NewLoadBalancerL7PolicyRuleResource,
 		loadbalancer.NewLoadBalancerTargetGroupResource,
 		loadbalancer.NewLoadBalancerTargetGroupMemberResource,
 		loadbalancer.NewLoadBalancerTargetGroupMembersResource,
+		loadbalancer.NewLoadBalancerTrafficSplitResource,
 		loadbalancer.NewLoadBalancerHealthMonitorResource,
 		mysql.NewInstanceGroupResource,
 		mysql.NewBackupResource,
@@ -288,6 +493,12 @@ func (p *kakaocloudProvider) Actions(_ context.Context) []func() action.Action {
 		mysql.NewInstanceGroupScaleInAction,
 		mysql.NewInstanceExportLogsAction,
 		mysql.NewInstanceGroupParameterGroupRetryAction,
+		kubernetesengine.NewClusterNodeCordonAction,
+		kubernetesengine.NewClusterNodeRemoveAction,
+		bcs.NewInstanceRebootAction,
+		bcs.NewInstanceStopStartAction,
+		bcs.NewInstanceResizeAction,
+		image.NewImageExportAction,
 	}
 }
 
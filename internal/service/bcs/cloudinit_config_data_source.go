@@ -0,0 +1,172 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+package bcs
+
+import (
+	"context"
+	"fmt"
+	"terraform-provider-kakaocloud/internal/common"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ datasource.DataSource                   = &cloudinitConfigDataSource{}
+	_ datasource.DataSourceWithValidateConfig = &cloudinitConfigDataSource{}
+)
+
+func NewCloudinitConfigDataSource() datasource.DataSource {
+	return &cloudinitConfigDataSource{}
+}
+
+type cloudinitConfigDataSource struct{}
+
+type cloudinitConfigDataSourceModel struct {
+	Gzip         types.Bool                 `tfsdk:"gzip"`
+	Base64Encode types.Bool                 `tfsdk:"base64_encode"`
+	Boundary     types.String               `tfsdk:"boundary"`
+	Part         []cloudinitConfigPartModel `tfsdk:"part"`
+	Rendered     types.String               `tfsdk:"rendered"`
+	Size         types.Int64                `tfsdk:"size"`
+	MaxSize      types.Int64                `tfsdk:"max_size"`
+}
+
+type cloudinitConfigPartModel struct {
+	ContentType types.String `tfsdk:"content_type"`
+	Content     types.String `tfsdk:"content"`
+	Filename    types.String `tfsdk:"filename"`
+	MergeType   types.String `tfsdk:"merge_type"`
+}
+
+func (d *cloudinitConfigDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_cloudinit_config"
+}
+
+func (d *cloudinitConfigDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"gzip": schema.BoolAttribute{
+				Optional: true,
+			},
+			"base64_encode": schema.BoolAttribute{
+				Optional: true,
+			},
+			"boundary": schema.StringAttribute{
+				Optional:   true,
+				Validators: common.NotBlankValidator(),
+			},
+			"part": schema.ListNestedAttribute{
+				Required: true,
+				Validators: []validator.List{
+					listvalidator.SizeAtLeast(1),
+				},
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"content_type": schema.StringAttribute{
+							Optional:   true,
+							Validators: common.NotBlankValidator(),
+						},
+						"content": schema.StringAttribute{
+							Required: true,
+						},
+						"filename": schema.StringAttribute{
+							Optional: true,
+						},
+						"merge_type": schema.StringAttribute{
+							Optional: true,
+						},
+					},
+				},
+			},
+			"rendered": schema.StringAttribute{
+				Computed: true,
+			},
+			"size": schema.Int64Attribute{
+				Computed: true,
+			},
+			"max_size": schema.Int64Attribute{
+				Computed: true,
+			},
+		},
+	}
+}
+
+func (d *cloudinitConfigDataSource) ValidateConfig(ctx context.Context, req datasource.ValidateConfigRequest, resp *datasource.ValidateConfigResponse) {
+	var config cloudinitConfigDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if config.Gzip.IsUnknown() || config.Base64Encode.IsUnknown() {
+		return
+	}
+	if boolOrDefault(config.Gzip, true) && !boolOrDefault(config.Base64Encode, true) {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("base64_encode"),
+			"Invalid Configuration",
+			"base64_encode must be true when gzip is enabled, because gzipped user_data is binary.",
+		)
+	}
+}
+
+func (d *cloudinitConfigDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var config cloudinitConfigDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	boundary := cloudinitDefaultBoundary
+	if !config.Boundary.IsNull() {
+		boundary = config.Boundary.ValueString()
+	}
+
+	parts := make([]cloudinitPart, 0, len(config.Part))
+	for _, p := range config.Part {
+		part := cloudinitPart{
+			ContentType: cloudinitDefaultContentType,
+			Content:     p.Content.ValueString(),
+			Filename:    p.Filename.ValueString(),
+			MergeType:   p.MergeType.ValueString(),
+		}
+		if !p.ContentType.IsNull() {
+			part.ContentType = p.ContentType.ValueString()
+		}
+		parts = append(parts, part)
+	}
+
+	rendered, size, err := renderCloudinitConfig(parts, boundary, boolOrDefault(config.Gzip, true), boolOrDefault(config.Base64Encode, true))
+	if err != nil {
+		common.AddGeneralError(ctx, d, &resp.Diagnostics, fmt.Sprintf("Failed to render cloud-init config: %v", err))
+		return
+	}
+
+	maxSize := instanceUserDataMaxSizeKB * 1024
+	if size > maxSize {
+		resp.Diagnostics.AddAttributeWarning(
+			path.Root("part"),
+			"User data may be too large",
+			fmt.Sprintf("The rendered cloud-init config is %d bytes, which exceeds the documented %dKB (%d bytes) user_data limit of an instance. Enable gzip or reduce the parts.",
+				size, instanceUserDataMaxSizeKB, maxSize),
+		)
+	}
+
+	config.Rendered = types.StringValue(rendered)
+	config.Size = types.Int64Value(int64(size))
+	config.MaxSize = types.Int64Value(int64(maxSize))
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &config)...)
+}
+
+func boolOrDefault(v types.Bool, def bool) bool {
+	if v.IsNull() || v.IsUnknown() {
+		return def
+	}
+	return v.ValueBool()
+}
@@ -0,0 +1,83 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+package bcs
+
+import (
+	"bytes"
+	"compress/gzip"
+	"encoding/base64"
+	"fmt"
+	"mime/multipart"
+	"net/textproto"
+)
+
+// instanceUserDataMaxSizeKB is the decoded user_data size that BCS documents for an instance
+// ("Enter up to 16KB of user data script", see the user_data attribute of kakaocloud_instance).
+const instanceUserDataMaxSizeKB = 16
+
+const (
+	cloudinitDefaultBoundary    = "MIMEBOUNDARY"
+	cloudinitDefaultContentType = "text/plain"
+)
+
+type cloudinitPart struct {
+	ContentType string
+	Content     string
+	Filename    string
+	MergeType   string
+}
+
+// renderCloudinitConfig writes parts as a MIME multipart archive, optionally gzipped and
+// base64 encoded. It also returns the size of the payload before base64 encoding, which is
+// what the BCS user_data limit applies to.
+func renderCloudinitConfig(parts []cloudinitPart, boundary string, gzipOutput bool, base64Encode bool) (string, int, error) {
+	var archive bytes.Buffer
+	fmt.Fprintf(&archive, "Content-Type: multipart/mixed; boundary=\"%s\"\r\nMIME-Version: 1.0\r\n\r\n", boundary)
+
+	writer := multipart.NewWriter(&archive)
+	if err := writer.SetBoundary(boundary); err != nil {
+		return "", 0, fmt.Errorf("invalid boundary: %w", err)
+	}
+
+	for i, part := range parts {
+		header := textproto.MIMEHeader{}
+		header.Set("Content-Type", part.ContentType)
+		header.Set("MIME-Version", "1.0")
+		header.Set("Content-Transfer-Encoding", "7bit")
+		if part.Filename != "" {
+			header.Set("Content-Disposition", fmt.Sprintf("attachment; filename=\"%s\"", part.Filename))
+		}
+		if part.MergeType != "" {
+			header.Set("X-Merge-Type", part.MergeType)
+		}
+
+		w, err := writer.CreatePart(header)
+		if err != nil {
+			return "", 0, fmt.Errorf("failed to write part %d: %w", i, err)
+		}
+		if _, err := w.Write([]byte(part.Content)); err != nil {
+			return "", 0, fmt.Errorf("failed to write part %d: %w", i, err)
+		}
+	}
+	if err := writer.Close(); err != nil {
+		return "", 0, fmt.Errorf("failed to close multipart archive: %w", err)
+	}
+
+	payload := archive.Bytes()
+	if gzipOutput {
+		var compressed bytes.Buffer
+		gz := gzip.NewWriter(&compressed)
+		if _, err := gz.Write(payload); err != nil {
+			return "", 0, fmt.Errorf("failed to gzip multipart archive: %w", err)
+		}
+		if err := gz.Close(); err != nil {
+			return "", 0, fmt.Errorf("failed to gzip multipart archive: %w", err)
+		}
+		payload = compressed.Bytes()
+	}
+
+	if base64Encode {
+		return base64.StdEncoding.EncodeToString(payload), len(payload), nil
+	}
+	return string(payload), len(payload), nil
+}
@@ -0,0 +1,80 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+package bcs
+
+import (
+	"bytes"
+	"compress/gzip"
+	"encoding/base64"
+	"io"
+	"mime"
+	"mime/multipart"
+	"net/mail"
+	"strings"
+	"testing"
+)
+
+func TestRenderCloudinitConfig(t *testing.T) {
+	parts := []cloudinitPart{
+		{ContentType: "text/cloud-config", Content: "#cloud-config\npackages:\n  - nginx\n", Filename: "init.cfg"},
+		{ContentType: "text/x-shellscript", Content: "#!/bin/sh\necho hello\n", MergeType: "list(append)+dict(recurse_array)+str()"},
+	}
+
+	plain, plainSize, err := renderCloudinitConfig(parts, cloudinitDefaultBoundary, false, false)
+	if err != nil {
+		t.Fatalf("renderCloudinitConfig() error = %v", err)
+	}
+	if plainSize != len(plain) {
+		t.Errorf("size = %d, want %d", plainSize, len(plain))
+	}
+
+	msg, err := mail.ReadMessage(strings.NewReader(plain))
+	if err != nil {
+		t.Fatalf("ReadMessage() error = %v", err)
+	}
+	mediaType, params, err := mime.ParseMediaType(msg.Header.Get("Content-Type"))
+	if err != nil || mediaType != "multipart/mixed" || params["boundary"] != cloudinitDefaultBoundary {
+		t.Fatalf("Content-Type = %q", msg.Header.Get("Content-Type"))
+	}
+
+	reader := multipart.NewReader(msg.Body, params["boundary"])
+	for i, want := range parts {
+		part, err := reader.NextPart()
+		if err != nil {
+			t.Fatalf("NextPart(%d) error = %v", i, err)
+		}
+		if got := part.Header.Get("Content-Type"); got != want.ContentType {
+			t.Errorf("part %d Content-Type = %q, want %q", i, got, want.ContentType)
+		}
+		if got := part.Header.Get("X-Merge-Type"); got != want.MergeType {
+			t.Errorf("part %d X-Merge-Type = %q, want %q", i, got, want.MergeType)
+		}
+		if got := part.FileName(); got != want.Filename {
+			t.Errorf("part %d filename = %q, want %q", i, got, want.Filename)
+		}
+		content, _ := io.ReadAll(part)
+		if string(content) != want.Content {
+			t.Errorf("part %d content = %q, want %q", i, content, want.Content)
+		}
+	}
+
+	encoded, encodedSize, err := renderCloudinitConfig(parts, cloudinitDefaultBoundary, true, true)
+	if err != nil {
+		t.Fatalf("renderCloudinitConfig() error = %v", err)
+	}
+	compressed, err := base64.StdEncoding.DecodeString(encoded)
+	if err != nil {
+		t.Fatalf("rendered output is not base64: %v", err)
+	}
+	if encodedSize != len(compressed) {
+		t.Errorf("size = %d, want the gzipped size %d", encodedSize, len(compressed))
+	}
+	gz, err := gzip.NewReader(bytes.NewReader(compressed))
+	if err != nil {
+		t.Fatalf("rendered output is not gzipped: %v", err)
+	}
+	decompressed, _ := io.ReadAll(gz)
+	if string(decompressed) != plain {
+		t.Error("gzipped output does not match the plain multipart archive")
+	}
+}
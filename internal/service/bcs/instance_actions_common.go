@@ -0,0 +1,108 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+package bcs
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"terraform-provider-kakaocloud/internal/common"
+
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	actionschema "github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/kakaoenterprise/kc-sdk-go/services/bcs"
+)
+
+type instanceActionBase struct {
+	kc *common.KakaoCloudClient
+}
+
+func (a *instanceActionBase) configure(req action.ConfigureRequest, resp *action.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*common.KakaoCloudClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Action Configure Type",
+			fmt.Sprintf("Expected *common.KakaoCloudClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	a.kc = client
+}
+
+func instanceActionInstanceIDAttribute() actionschema.StringAttribute {
+	return actionschema.StringAttribute{
+		Required:   true,
+		Validators: common.UuidValidator(),
+	}
+}
+
+// getInstanceActionStatus waits for an instance in a transitional state to settle and returns
+// its status. Actions only start from active, stopped or shelved instances.
+func getInstanceActionStatus(
+	ctx context.Context,
+	kc *common.KakaoCloudClient,
+	obj interface{},
+	instanceId string,
+	respDiags *diag.Diagnostics,
+) (string, bool) {
+	instance, ok := getInstanceForAction(ctx, kc, obj, instanceId, respDiags)
+	if !ok {
+		return "", false
+	}
+	return instance.GetStatus(), true
+}
+
+// getInstanceForAction is getInstanceActionStatus for actions that need more of the instance
+// than its status.
+func getInstanceForAction(
+	ctx context.Context,
+	kc *common.KakaoCloudClient,
+	obj interface{},
+	instanceId string,
+	respDiags *diag.Diagnostics,
+) (*bcs.BcsInstanceV1ApiGetInstanceModelInstanceModel, bool) {
+	respModel, httpResp, err := common.ExecuteWithRetryAndAuth(ctx, kc, respDiags, common.ServiceBCS,
+		func() (*bcs.ResponseInstanceModel, *http.Response, error) {
+			return kc.ApiClient.InstanceAPI.
+				GetInstance(ctx, instanceId).
+				XAuthToken(kc.XAuthToken).
+				Execute()
+		},
+	)
+	if err != nil {
+		common.AddApiActionError(ctx, obj, httpResp, "GetInstance", err, respDiags)
+		return nil, false
+	}
+
+	if common.IsInstanceValidStatus(respModel.Instance.GetStatus()) {
+		return &respModel.Instance, true
+	}
+
+	result, ok := pollInstanceUntilStatus(
+		ctx,
+		kc,
+		obj,
+		instanceId,
+		[]string{
+			common.InstanceStatusActive,
+			common.InstanceStatusStopped,
+			common.InstanceStatusShelved,
+			common.InstanceStatusError,
+		},
+		respDiags,
+	)
+	if !ok || respDiags.HasError() {
+		return nil, false
+	}
+	if result.GetStatus() == common.InstanceStatusError {
+		common.AddGeneralError(ctx, obj, respDiags, fmt.Sprintf("instance %s is in %q status", instanceId, common.InstanceStatusError))
+		return nil, false
+	}
+	return result, true
+}
@@ -0,0 +1,242 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+package bcs_test
+
+import (
+	"fmt"
+	"net/http"
+	"regexp"
+	"strings"
+	"terraform-provider-kakaocloud/internal/acctest"
+	"terraform-provider-kakaocloud/internal/acctest/mockserver"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+const (
+	testAccInstanceActionInstanceId = "3c4d5e6f-7a8b-4c9d-8e0f-1a2b3c4d5e6f"
+	testAccInstanceActionFlavorId   = "4d5e6f7a-8b9c-4d0e-9f1a-2b3c4d5e6f7a"
+)
+
+func testAccInstanceActionServer(t *testing.T, status string) (*mockserver.Server, *mockserver.Collection) {
+	server := acctest.NewMockServer(t)
+	instances := server.Collection("bcs", "instances")
+	instances.Put(testAccInstanceActionInstanceId, map[string]any{
+		"id":          testAccInstanceActionInstanceId,
+		"name":        "acc-instance",
+		"status":      status,
+		"vm_state":    status,
+		"power_state": "running",
+	})
+	return server, instances
+}
+
+func TestAccInstanceRebootAction_hard(t *testing.T) {
+	server, instances := testAccInstanceActionServer(t, "active")
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_14_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: acctest.ProviderConfig(server, "") + testAccInstanceActionConfig("kakaocloud_instance_reboot", `
+    instance_id = %q
+    type        = "hard"
+`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckInstanceActionRequests(server, "hard"),
+					testAccCheckInstanceActionStatus(instances, "active"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccInstanceRebootAction_soft(t *testing.T) {
+	server, instances := testAccInstanceActionServer(t, "active")
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_14_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: acctest.ProviderConfig(server, "") + testAccInstanceActionConfig("kakaocloud_instance_reboot", `
+    instance_id = %q
+    timeout     = "2m"
+`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckInstanceActionRequests(server, "reboot"),
+					testAccCheckInstanceActionStatus(instances, "active"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccInstanceRebootAction_timeout(t *testing.T) {
+	server, _ := testAccInstanceActionServer(t, "active")
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_14_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: acctest.ProviderConfig(server, "") + testAccInstanceActionConfig("kakaocloud_instance_reboot", `
+    instance_id = %q
+    timeout     = "1s"
+`),
+				ExpectError: regexp.MustCompile(`context deadline exceeded`),
+			},
+		},
+	})
+}
+
+func TestAccInstanceRebootAction_notActive(t *testing.T) {
+	server, _ := testAccInstanceActionServer(t, "stopped")
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_14_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: acctest.ProviderConfig(server, "") + testAccInstanceActionConfig("kakaocloud_instance_reboot", `
+    instance_id = %q
+`),
+				ExpectError: regexp.MustCompile(`must be "active" to reboot`),
+			},
+		},
+	})
+}
+
+func TestAccInstanceStopStartAction(t *testing.T) {
+	for _, status := range []string{"active", "stopped"} {
+		t.Run(status, func(t *testing.T) {
+			server, instances := testAccInstanceActionServer(t, status)
+			want := []string{"start"}
+			if status == "active" {
+				want = []string{"stop", "start"}
+			}
+
+			resource.Test(t, resource.TestCase{
+				ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+				TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+					tfversion.SkipBelow(tfversion.Version1_14_0),
+				},
+				Steps: []resource.TestStep{
+					{
+						Config: acctest.ProviderConfig(server, "") + testAccInstanceActionConfig("kakaocloud_instance_stop_start", `
+    instance_id = %q
+`),
+						Check: resource.ComposeAggregateTestCheckFunc(
+							testAccCheckInstanceActionRequests(server, want...),
+							testAccCheckInstanceActionStatus(instances, "active"),
+						),
+					},
+				},
+			})
+		})
+	}
+}
+
+func TestAccInstanceResizeAction(t *testing.T) {
+	server, instances := testAccInstanceActionServer(t, "active")
+	flavors := server.Register(&mockserver.Collection{
+		Service: "bcs",
+		Name:    "instance-types",
+		Key:     "flavor",
+		ListKey: "flavors",
+	})
+	flavors.Put(testAccInstanceActionFlavorId, map[string]any{
+		"id":            testAccInstanceActionFlavorId,
+		"name":          "m2a.large",
+		"instance_type": "vm",
+		"vcpus":         2,
+		"memory_mb":     8192,
+	})
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_14_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: acctest.ProviderConfig(server, "") + testAccInstanceActionConfig("kakaocloud_instance_resize", `
+    instance_id = %q
+    flavor_id   = "`+testAccInstanceActionFlavorId+`"
+`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckInstanceActionRequests(server, "stop", "resize", "start"),
+					testAccCheckInstanceActionStatus(instances, "active"),
+				),
+			},
+		},
+	})
+}
+
+// testAccCheckInstanceActionRequests checks that the instance received POST requests whose last
+// path segment contains each of names, in order, and no other POST requests.
+func testAccCheckInstanceActionRequests(server *mockserver.Server, names ...string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		prefix := http.MethodPost + " "
+		var actions []string
+		for _, r := range server.Requests() {
+			if !strings.HasPrefix(r, prefix) || !strings.Contains(r, testAccInstanceActionInstanceId+"/") {
+				continue
+			}
+			actions = append(actions, r[strings.LastIndex(r, "/")+1:])
+		}
+		if len(actions) != len(names) {
+			return fmt.Errorf("got instance actions %v, want %v", actions, names)
+		}
+		for i, name := range names {
+			if !strings.Contains(strings.ToLower(actions[i]), name) {
+				return fmt.Errorf("got instance actions %v, want %v", actions, names)
+			}
+		}
+		return nil
+	}
+}
+
+func testAccCheckInstanceActionStatus(instances *mockserver.Collection, want string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		obj, _ := instances.Get(testAccInstanceActionInstanceId)
+		if got := obj["status"]; got != want {
+			return fmt.Errorf("instance status is %v, want %s", got, want)
+		}
+		return nil
+	}
+}
+
+// testAccInstanceActionConfig invokes the action once, when a terraform_data resource is created.
+// body is the action configuration, with %q standing for the instance ID.
+func testAccInstanceActionConfig(actionType string, body string) string {
+	return fmt.Sprintf(`
+action %[1]q "test" {
+  config {
+`+body+`  }
+}
+
+resource "terraform_data" "trigger" {
+  input = "invoke"
+
+  lifecycle {
+    action_trigger {
+      events  = [after_create]
+      actions = [action.%[1]s.test]
+    }
+  }
+}
+`, actionType, testAccInstanceActionInstanceId)
+}
@@ -60,8 +60,14 @@ func (r *instanceResource) updateAttachedVolumes(
 	instanceId string,
 	plans *[]instanceVolumeModel,
 	states *[]instanceVolumeModel,
+	ignoreUnlisted bool,
 	resp *diag.Diagnostics,
 ) bool {
+	// kakaocloud_volume_attachment attaches and detaches volumes on the same instance.
+	mutex := common.LockForID(instanceId)
+	mutex.Lock()
+	defer mutex.Unlock()
+
 	stateMap := make(map[string]instanceVolumeModel)
 	for _, s := range *states {
 		if !s.Id.IsNull() && !s.Id.IsUnknown() {
@@ -81,7 +87,10 @@ func (r *instanceResource) updateAttachedVolumes(
 
 	for _, s := range *states {
 		if _, exists := planMap[s.Id.ValueString()]; !exists {
-			ok := r.detachVolume(ctx, instanceId, s.Id.ValueString(), resp)
+			if ignoreUnlisted {
+				continue
+			}
+			ok := detachInstanceVolume(ctx, r.kc, r, instanceId, s.Id.ValueString(), resp)
 			if !ok {
 				return false
 			}
@@ -91,7 +100,7 @@ func (r *instanceResource) updateAttachedVolumes(
 
 			if !plan.IsDeleteOnTermination.IsNull() && !plan.IsDeleteOnTermination.IsUnknown() &&
 				!plan.IsDeleteOnTermination.Equal(s.IsDeleteOnTermination) {
-				ok := r.updateAttachedVolumeData(ctx, instanceId, &plan, resp)
+				ok := updateInstanceVolumeDeleteOnTermination(ctx, r.kc, r, instanceId, plan.Id.ValueString(), plan.IsDeleteOnTermination.ValueBool(), resp)
 				if !ok {
 					return false
 				}
@@ -106,11 +115,11 @@ func (r *instanceResource) updateAttachedVolumes(
 
 			if !plan.TypeId.IsNull() && !plan.TypeId.IsUnknown() && !plan.TypeId.Equal(s.TypeId) ||
 				!plan.EncryptionSecretId.IsNull() && !plan.EncryptionSecretId.IsUnknown() && !plan.EncryptionSecretId.Equal(s.EncryptionSecretId) {
-				ok := r.detachVolume(ctx, instanceId, s.Id.ValueString(), resp)
+				ok := detachInstanceVolume(ctx, r.kc, r, instanceId, s.Id.ValueString(), resp)
 				if !ok {
 					return false
 				}
-				ok = r.attacheVolume(ctx, instanceId, &plan, resp)
+				ok = attachInstanceVolume(ctx, r.kc, r, instanceId, plan.Id.ValueString(), plan.IsDeleteOnTermination.ValueBool(), resp)
 				if !ok {
 					return false
 				}
@@ -122,7 +131,7 @@ func (r *instanceResource) updateAttachedVolumes(
 		_, exists := stateMap[plan.Id.ValueString()]
 
 		if !exists {
-			ok := r.attacheVolume(ctx, instanceId, &plan, resp)
+			ok := attachInstanceVolume(ctx, r.kc, r, instanceId, plan.Id.ValueString(), plan.IsDeleteOnTermination.ValueBool(), resp)
 			if !ok {
 				return false
 			}
@@ -131,83 +140,87 @@ func (r *instanceResource) updateAttachedVolumes(
 	return true
 }
 
-func (r *instanceResource) updateAttachedVolumeData(
+func updateInstanceVolumeDeleteOnTermination(
 	ctx context.Context,
+	kc *common.KakaoCloudClient,
+	obj interface{},
 	instanceId string,
-	plan *instanceVolumeModel,
+	volumeId string,
+	isDeleteOnTermination bool,
 	resp *diag.Diagnostics,
 ) bool {
 	editReq := bcs.EditVolumeModel{}
-	editReq.SetIsDeleteOnTermination(plan.IsDeleteOnTermination.ValueBool())
+	editReq.SetIsDeleteOnTermination(isDeleteOnTermination)
 
 	body := *bcs.NewBodyUpdateInstanceVolume(editReq)
 
-	_, httpResp, err := common.ExecuteWithRetryAndAuth(ctx, r.kc, resp,
+	_, httpResp, err := common.ExecuteWithRetryAndAuth(ctx, kc, resp, common.ServiceBCS,
 		func() (interface{}, *http.Response, error) {
-			return r.kc.ApiClient.InstanceAttachedVolumeAPI.UpdateInstanceVolume(ctx, instanceId, plan.Id.ValueString()).
-				XAuthToken(r.kc.XAuthToken).
+			return kc.ApiClient.InstanceAttachedVolumeAPI.UpdateInstanceVolume(ctx, instanceId, volumeId).
+				XAuthToken(kc.XAuthToken).
 				BodyUpdateInstanceVolume(body).
 				Execute()
 		},
 	)
 	if err != nil {
-		common.AddApiActionError(ctx, r, httpResp, "UpdateInstanceVolume", err, resp)
+		common.AddApiActionError(ctx, obj, httpResp, "UpdateInstanceVolume", err, resp)
 		return false
 	}
 
 	return true
 }
 
-func (r *instanceResource) attacheVolume(
+func attachInstanceVolume(
 	ctx context.Context,
+	kc *common.KakaoCloudClient,
+	obj interface{},
 	instanceId string,
-	plan *instanceVolumeModel,
+	volumeId string,
+	isDeleteOnTermination bool,
 	resp *diag.Diagnostics,
 ) bool {
 	editReq := bcs.CreateVolumeModel{}
-	if !plan.IsDeleteOnTermination.IsNull() && !plan.IsDeleteOnTermination.IsUnknown() {
-		editReq.SetIsDeleteOnTermination(plan.IsDeleteOnTermination.ValueBool())
-	} else {
-		editReq.SetIsDeleteOnTermination(false)
-	}
+	editReq.SetIsDeleteOnTermination(isDeleteOnTermination)
 
 	body := *bcs.NewBodyAttachVolume(editReq)
 
-	_, httpResp, err := common.ExecuteWithRetryAndAuth(ctx, r.kc, resp,
+	_, httpResp, err := common.ExecuteWithRetryAndAuth(ctx, kc, resp, common.ServiceBCS,
 		func() (*bcs.InstanceAttachedVolumeModelResponse, *http.Response, error) {
-			return r.kc.ApiClient.InstanceAttachedVolumeAPI.AttachVolume(ctx, instanceId, plan.Id.ValueString()).
-				XAuthToken(r.kc.XAuthToken).
+			return kc.ApiClient.InstanceAttachedVolumeAPI.AttachVolume(ctx, instanceId, volumeId).
+				XAuthToken(kc.XAuthToken).
 				BodyAttachVolume(body).
 				Execute()
 		},
 	)
 	if err != nil {
-		common.AddApiActionError(ctx, r, httpResp, "AttachVolume", err, resp)
+		common.AddApiActionError(ctx, obj, httpResp, "AttachVolume", err, resp)
 		return false
 	}
 
-	return r.pollInstanceUntilAllVolumesOk(ctx, instanceId, plan.Id.ValueString(), "attach", resp)
+	return pollInstanceUntilAllVolumesOk(ctx, kc, obj, instanceId, volumeId, "attach", resp)
 }
 
-func (r *instanceResource) detachVolume(
+func detachInstanceVolume(
 	ctx context.Context,
+	kc *common.KakaoCloudClient,
+	obj interface{},
 	instanceId string,
 	volumeId string,
 	resp *diag.Diagnostics,
 ) bool {
-	_, httpResp, err := common.ExecuteWithRetryAndAuth(ctx, r.kc, resp,
+	_, httpResp, err := common.ExecuteWithRetryAndAuth(ctx, kc, resp, common.ServiceBCS,
 		func() (interface{}, *http.Response, error) {
-			return r.kc.ApiClient.InstanceAttachedVolumeAPI.DetachVolume(ctx, instanceId, volumeId).
-				XAuthToken(r.kc.XAuthToken).
+			return kc.ApiClient.InstanceAttachedVolumeAPI.DetachVolume(ctx, instanceId, volumeId).
+				XAuthToken(kc.XAuthToken).
 				Execute()
 		},
 	)
 	if err != nil {
-		common.AddApiActionError(ctx, r, httpResp, "DetachVolume", err, resp)
+		common.AddApiActionError(ctx, obj, httpResp, "DetachVolume", err, resp)
 		return false
 	}
 
-	return r.pollInstanceUntilAllVolumesOk(ctx, instanceId, volumeId, "detach", resp)
+	return pollInstanceUntilAllVolumesOk(ctx, kc, obj, instanceId, volumeId, "detach", resp)
 }
 
 func (r *instanceResource) UpdateVolumeSize(ctx context.Context, kc *common.KakaoCloudClient, volumeId string, newSize int32, diags *diag.Diagnostics) bool {
@@ -216,7 +229,7 @@ func (r *instanceResource) UpdateVolumeSize(ctx context.Context, kc *common.Kaka
 			NewSize: newSize,
 		},
 	}
-	_, httpResp, err := common.ExecuteWithRetryAndAuth(ctx, r.kc, diags,
+	_, httpResp, err := common.ExecuteWithRetryAndAuth(ctx, r.kc, diags, common.ServiceVolume,
 		func() (interface{}, *http.Response, error) {
 			return kc.ApiClient.VolumeAPI.ExtendVolume(ctx, volumeId).
 				XAuthToken(kc.XAuthToken).
@@ -250,8 +263,10 @@ func (r *instanceResource) convertListToInstanceVolumeModel(
 	return result, diags
 }
 
-func (r *instanceResource) pollInstanceUntilAllVolumesOk(
+func pollInstanceUntilAllVolumesOk(
 	ctx context.Context,
+	kc *common.KakaoCloudClient,
+	obj interface{},
 	instanceId string,
 	volumeId string,
 	action string,
@@ -259,16 +274,16 @@ func (r *instanceResource) pollInstanceUntilAllVolumesOk(
 ) bool {
 	for {
 		isOk := false
-		respModel, httpResp, err := common.ExecuteWithRetryAndAuth(ctx, r.kc, diag,
+		respModel, httpResp, err := common.ExecuteWithRetryAndAuth(ctx, kc, diag, common.ServiceBCS,
 			func() (*bcs.ResponseInstanceModel, *http.Response, error) {
-				return r.kc.ApiClient.InstanceAPI.
+				return kc.ApiClient.InstanceAPI.
 					GetInstance(ctx, instanceId).
-					XAuthToken(r.kc.XAuthToken).
+					XAuthToken(kc.XAuthToken).
 					Execute()
 			},
 		)
 		if err != nil {
-			common.AddApiActionError(ctx, r, httpResp, "GetInstance", err, diag)
+			common.AddApiActionError(ctx, obj, httpResp, "GetInstance", err, diag)
 			return false
 		}
 
@@ -286,10 +301,19 @@ func (r *instanceResource) pollInstanceUntilAllVolumesOk(
 				}
 			}
 		}
+		if action == "detach" && len(respModel.Instance.AttachedVolumes) == 0 {
+			isOk = true
+		}
 
 		if isOk {
 			return true
 		}
-		time.Sleep(2 * time.Second)
+
+		select {
+		case <-ctx.Done():
+			common.AddGeneralError(ctx, obj, diag, fmt.Sprintf("Context cancelled while waiting for volume %s to %s", volumeId, action))
+			return false
+		case <-time.After(2 * time.Second):
+		}
 	}
 }
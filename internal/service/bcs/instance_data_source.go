@@ -66,7 +66,7 @@ func (d *instanceDataSource) Read(ctx context.Context, req datasource.ReadReques
 	ctx, cancel := context.WithTimeout(ctx, timeout)
 	defer cancel()
 
-	instanceResp, httpResp, err := common.ExecuteWithRetryAndAuth(ctx, d.kc, &resp.Diagnostics,
+	instanceResp, httpResp, err := common.ExecuteWithRetryAndAuth(ctx, d.kc, &resp.Diagnostics, common.ServiceBCS,
 		func() (*bcs.ResponseInstanceModel, *http.Response, error) {
 			return d.kc.ApiClient.InstanceAPI.GetInstance(ctx, config.Id.ValueString()).XAuthToken(d.kc.XAuthToken).Execute()
 		},
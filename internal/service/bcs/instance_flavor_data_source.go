@@ -66,7 +66,7 @@ func (d *instanceFlavorDataSource) Read(ctx context.Context, req datasource.Read
 	ctx, cancel := context.WithTimeout(ctx, timeout)
 	defer cancel()
 
-	flavorResp, httpResp, err := common.ExecuteWithRetryAndAuth(ctx, d.kc, &resp.Diagnostics,
+	flavorResp, httpResp, err := common.ExecuteWithRetryAndAuth(ctx, d.kc, &resp.Diagnostics, common.ServiceBCS,
 		func() (*bcs.ResponseFlavorModel, *http.Response, error) {
 			return d.kc.ApiClient.FlavorAPI.GetInstanceType(ctx, config.Id.ValueString()).XAuthToken(d.kc.XAuthToken).Execute()
 		},
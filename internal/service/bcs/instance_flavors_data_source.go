@@ -164,9 +164,12 @@ func (d *instanceFlavorsDataSource) Read(ctx context.Context, req datasource.Rea
 		return
 	}
 
-	flavorResp, httpResp, err := common.ExecuteWithRetryAndAuth(ctx, d.kc, &resp.Diagnostics,
-		func() (*bcs.FlavorListModel, *http.Response, error) {
-			return flavorApi.Limit(1000).XAuthToken(d.kc.XAuthToken).Execute()
+	flavorPages, httpResp, err := common.ListAllPagesPartial(ctx, d.kc, d, &resp.Diagnostics, common.ServiceBCS,
+		func(limit int32, offset int32) (*bcs.FlavorListModel, *http.Response, error) {
+			return flavorApi.Limit(limit).Offset(offset).XAuthToken(d.kc.XAuthToken).Execute()
+		},
+		func(page *bcs.FlavorListModel) int {
+			return len(page.Flavors)
 		},
 	)
 	if err != nil {
@@ -175,11 +178,15 @@ func (d *instanceFlavorsDataSource) Read(ctx context.Context, req datasource.Rea
 	}
 
 	var instanceFlavorResult []bcs.BcsInstanceV1ApiGetInstanceTypeModelFlavorModel
-	err = copier.Copy(&instanceFlavorResult, &flavorResp.Flavors)
-	if err != nil {
-		common.AddGeneralError(ctx, d, &resp.Diagnostics,
-			fmt.Sprintf("Failed to convert instanceFlavorResult: %v", err))
-		return
+	for _, flavorResp := range flavorPages {
+		var pageResult []bcs.BcsInstanceV1ApiGetInstanceTypeModelFlavorModel
+		err = copier.Copy(&pageResult, &flavorResp.Flavors)
+		if err != nil {
+			common.AddGeneralError(ctx, d, &resp.Diagnostics,
+				fmt.Sprintf("Failed to convert instanceFlavorResult: %v", err))
+			return
+		}
+		instanceFlavorResult = append(instanceFlavorResult, pageResult...)
 	}
 
 	for _, v := range instanceFlavorResult {
@@ -35,6 +35,7 @@ func mapInstanceBaseModel(
 	base.KeyName = ConvertNullableString(instanceResult.KeyName)
 	base.Hostname = ConvertNullableString(instanceResult.Hostname)
 	base.AvailabilityZone = ConvertNullableString(instanceResult.AvailabilityZone)
+	base.ServerGroupId = ConvertNullableString(instanceResult.ServerGroupId)
 	base.AttachedVolumeCount = ConvertNullableInt64(instanceResult.AttachedVolumeCount)
 	base.SecurityGroupCount = ConvertNullableInt64(instanceResult.SecurityGroupCount)
 	base.InstanceType = ConvertNullableString(instanceResult.InstanceType)
@@ -156,6 +157,7 @@ func mapInstanceListModel(
 	base.KeyName = ConvertNullableString(instanceResult.KeyName)
 	base.Hostname = ConvertNullableString(instanceResult.Hostname)
 	base.AvailabilityZone = ConvertNullableString(instanceResult.AvailabilityZone)
+	base.ServerGroupId = ConvertNullableString(instanceResult.ServerGroupId)
 	base.AttachedVolumeCount = ConvertNullableInt64(instanceResult.AttachedVolumeCount)
 	base.SecurityGroupCount = ConvertNullableInt64(instanceResult.SecurityGroupCount)
 	base.InstanceType = ConvertNullableString(instanceResult.InstanceType)
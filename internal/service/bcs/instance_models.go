@@ -36,6 +36,7 @@ type instanceBaseModel struct {
 	KeyName          types.String `tfsdk:"key_name"`
 	Hostname         types.String `tfsdk:"hostname"`
 	AvailabilityZone types.String `tfsdk:"availability_zone"`
+	ServerGroupId    types.String `tfsdk:"server_group_id"`
 
 	AttachedVolumes     types.List  `tfsdk:"attached_volumes"`
 	AttachedVolumeCount types.Int64 `tfsdk:"attached_volume_count"`
@@ -227,7 +228,9 @@ type instanceResourceModel struct {
 	FlavorId              types.String           `tfsdk:"flavor_id"`
 	Subnets               types.List             `tfsdk:"subnets"`
 	InitialSecurityGroups types.Set              `tfsdk:"initial_security_groups"`
+	SecurityGroupIds      types.Set              `tfsdk:"security_group_ids"`
 	Volumes               types.List             `tfsdk:"volumes"`
+	IgnoreUnlistedVolumes types.Bool             `tfsdk:"ignore_unlisted_volumes"`
 	UserData              types.String           `tfsdk:"user_data"`
 	IsBonding             types.Bool             `tfsdk:"is_bonding"`
 	Timeouts              resourceTimeouts.Value `tfsdk:"timeouts"`
@@ -58,7 +58,7 @@ func (r *instanceResource) setNetworkInterfaceId(ctx context.Context, plan *inst
 		if nicId == "" || usedNicIds[nicId] {
 			continue
 		}
-		nicResp, httpResp, err := common.ExecuteWithRetryAndAuth(ctx, r.kc, respDiags,
+		nicResp, httpResp, err := common.ExecuteWithRetryAndAuth(ctx, r.kc, respDiags, common.ServiceVPC,
 			func() (*vpc.BnsVpcV1ApiGetNetworkInterfaceModelResponseNetworkInterfaceModel, *http.Response, error) {
 				return r.kc.ApiClient.NetworkInterfaceAPI.GetNetworkInterface(ctx, nicId).XAuthToken(r.kc.XAuthToken).Execute()
 			},
@@ -154,7 +154,7 @@ func (r *instanceResource) attacheNetworkInterface(
 	networkInterfaceId string,
 	resp *diag.Diagnostics,
 ) bool {
-	_, httpResp, err := common.ExecuteWithRetryAndAuth(ctx, r.kc, resp,
+	_, httpResp, err := common.ExecuteWithRetryAndAuth(ctx, r.kc, resp, common.ServiceBCS,
 		func() (*bcs.BcsInstanceV1ApiAttachNetworkInterfaceModelResponseInstanceNetworkInterfaceModel, *http.Response, error) {
 			return r.kc.ApiClient.InstanceNetworkInterfaceAPI.AttachNetworkInterface(ctx, instanceId, networkInterfaceId).
 				XAuthToken(r.kc.XAuthToken).
@@ -174,7 +174,7 @@ func (r *instanceResource) detachNetworkInterface(
 	networkInterfaceId string,
 	resp *diag.Diagnostics,
 ) bool {
-	_, httpResp, err := common.ExecuteWithRetryAndAuth(ctx, r.kc, resp,
+	_, httpResp, err := common.ExecuteWithRetryAndAuth(ctx, r.kc, resp, common.ServiceBCS,
 		func() (interface{}, *http.Response, error) {
 			return r.kc.ApiClient.InstanceNetworkInterfaceAPI.DetachNetworkInterface(ctx, instanceId, networkInterfaceId).
 				XAuthToken(r.kc.XAuthToken).
@@ -233,7 +233,7 @@ func (r *instanceResource) pollInstanceUntilNetworkInterfaceOk(
 ) bool {
 	for {
 		isOk := false
-		respModel, httpResp, err := common.ExecuteWithRetryAndAuth(ctx, r.kc, diag,
+		respModel, httpResp, err := common.ExecuteWithRetryAndAuth(ctx, r.kc, diag, common.ServiceBCS,
 			func() (*bcs.ResponseInstanceModel, *http.Response, error) {
 				return r.kc.ApiClient.InstanceAPI.
 					GetInstance(ctx, instanceId).
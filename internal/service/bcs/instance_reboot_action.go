@@ -0,0 +1,179 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+package bcs
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"terraform-provider-kakaocloud/internal/common"
+	. "terraform-provider-kakaocloud/internal/utils"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	actionschema "github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/kakaoenterprise/kc-sdk-go/services/bcs"
+)
+
+const (
+	instanceRebootTypeSoft = "soft"
+	instanceRebootTypeHard = "hard"
+
+	instanceRebootStarted = "reboot started"
+)
+
+var _ action.ActionWithConfigure = &instanceRebootAction{}
+
+func NewInstanceRebootAction() action.Action { return &instanceRebootAction{} }
+
+type instanceRebootAction struct{ instanceActionBase }
+
+type instanceRebootActionModel struct {
+	InstanceId types.String `tfsdk:"instance_id"`
+	Type       types.String `tfsdk:"type"`
+	Timeout    types.String `tfsdk:"timeout"`
+}
+
+func (a *instanceRebootAction) Metadata(_ context.Context, req action.MetadataRequest, resp *action.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_instance_reboot"
+}
+
+func (a *instanceRebootAction) Schema(_ context.Context, _ action.SchemaRequest, resp *action.SchemaResponse) {
+	resp.Schema = actionschema.Schema{
+		Attributes: map[string]actionschema.Attribute{
+			"instance_id": instanceActionInstanceIDAttribute(),
+			"type": actionschema.StringAttribute{
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(instanceRebootTypeSoft, instanceRebootTypeHard),
+				},
+			},
+			"timeout": actionschema.StringAttribute{
+				Optional:   true,
+				Validators: common.DurationValidator(),
+			},
+		},
+	}
+}
+
+func (a *instanceRebootAction) Configure(_ context.Context, req action.ConfigureRequest, resp *action.ConfigureResponse) {
+	a.configure(req, resp)
+}
+
+func (a *instanceRebootAction) Invoke(ctx context.Context, req action.InvokeRequest, resp *action.InvokeResponse) {
+	var config instanceRebootActionModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	timeout := common.DefaultUpdateTimeout
+	if !config.Timeout.IsNull() {
+		parsed, err := time.ParseDuration(config.Timeout.ValueString())
+		if err != nil {
+			common.AddValidationConfigError(ctx, a, &resp.Diagnostics, fmt.Sprintf("Invalid timeout %q: %s", config.Timeout.ValueString(), err))
+			return
+		}
+		timeout = parsed
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	instanceId := config.InstanceId.ValueString()
+	rebootType := instanceRebootTypeSoft
+	if !config.Type.IsNull() {
+		rebootType = config.Type.ValueString()
+	}
+
+	instance, ok := getInstanceForAction(ctx, a.kc, a, instanceId, &resp.Diagnostics)
+	if !ok {
+		return
+	}
+	if status := instance.GetStatus(); status != common.InstanceStatusActive {
+		common.AddGeneralError(ctx, a, &resp.Diagnostics,
+			fmt.Sprintf("instance %s must be %q to reboot, but is %q", instanceId, common.InstanceStatusActive, status))
+		return
+	}
+
+	tflog.Info(ctx, "invoking instance reboot action", map[string]any{
+		"instance_id": instanceId,
+		"type":        rebootType,
+	})
+	if !a.rebootInstance(ctx, instanceId, rebootType, &resp.Diagnostics) {
+		return
+	}
+
+	stopProgress := common.StartActionProgress(ctx, resp.SendProgress, fmt.Sprintf("Waiting for instance %s to become active after %s reboot", instanceId, rebootType))
+	defer stopProgress()
+
+	// The instance is still active right after the request. Waiting for active before the reboot
+	// has begun would return at once.
+	updatedAt := ConvertNullableTime(instance.UpdatedAt).ValueString()
+	_, ok = pollInstance(ctx, a.kc, a, instanceId, []string{instanceRebootStarted}, &resp.Diagnostics,
+		func(v *bcs.BcsInstanceV1ApiGetInstanceModelInstanceModel) string {
+			return instanceRebootPhase(v.GetStatus(), ConvertNullableTime(v.UpdatedAt).ValueString(), updatedAt)
+		},
+	)
+	if !ok || resp.Diagnostics.HasError() {
+		return
+	}
+
+	result, ok := pollInstanceUntilStatus(
+		ctx,
+		a.kc,
+		a,
+		instanceId,
+		[]string{common.InstanceStatusActive, common.InstanceStatusError},
+		&resp.Diagnostics,
+	)
+	if !ok || resp.Diagnostics.HasError() {
+		return
+	}
+	common.CheckResourceAvailableStatus(ctx, a, result.Status.Get(), []string{common.InstanceStatusActive}, &resp.Diagnostics)
+}
+
+// instanceRebootPhase reports instanceRebootStarted once the instance shows the reboot: a status
+// other than active, such as reboot or hard_reboot, or an update time other than the one read
+// before the request, which covers a reboot that finished between two polls.
+func instanceRebootPhase(status string, updatedAt string, updatedAtBefore string) string {
+	if status != common.InstanceStatusActive || updatedAt != updatedAtBefore {
+		return instanceRebootStarted
+	}
+	return status
+}
+
+func (a *instanceRebootAction) rebootInstance(ctx context.Context, instanceId string, rebootType string, respDiags *diag.Diagnostics) bool {
+	if rebootType == instanceRebootTypeHard {
+		_, httpResp, err := common.ExecuteWithRetryAndAuth(ctx, a.kc, respDiags, common.ServiceBCS,
+			func() (interface{}, *http.Response, error) {
+				return a.kc.ApiClient.InstanceRunAnActionAPI.HardRebootInstance(ctx, instanceId).
+					XAuthToken(a.kc.XAuthToken).
+					Execute()
+			},
+		)
+		if err != nil {
+			common.AddApiActionError(ctx, a, httpResp, "HardRebootInstance", err, respDiags)
+			return false
+		}
+		return true
+	}
+
+	_, httpResp, err := common.ExecuteWithRetryAndAuth(ctx, a.kc, respDiags, common.ServiceBCS,
+		func() (interface{}, *http.Response, error) {
+			return a.kc.ApiClient.InstanceRunAnActionAPI.SoftRebootInstance(ctx, instanceId).
+				XAuthToken(a.kc.XAuthToken).
+				Execute()
+		},
+	)
+	if err != nil {
+		common.AddApiActionError(ctx, a, httpResp, "SoftRebootInstance", err, respDiags)
+		return false
+	}
+	return true
+}
@@ -0,0 +1,31 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+package bcs
+
+import (
+	"testing"
+)
+
+func TestInstanceRebootPhase(t *testing.T) {
+	const before = "2026-01-02T03:04:05Z"
+	cases := []struct {
+		name      string
+		status    string
+		updatedAt string
+		want      string
+	}{
+		{"not started", "active", before, "active"},
+		{"reboot", "reboot", before, instanceRebootStarted},
+		{"hard reboot", "hard_reboot", before, instanceRebootStarted},
+		{"error", "error", before, instanceRebootStarted},
+		{"finished between polls", "active", "2026-01-02T03:04:35Z", instanceRebootStarted},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := instanceRebootPhase(tc.status, tc.updatedAt, before); got != tc.want {
+				t.Errorf("instanceRebootPhase(%q, %q) = %q, want %q", tc.status, tc.updatedAt, got, tc.want)
+			}
+		})
+	}
+}
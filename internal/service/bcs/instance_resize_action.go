@@ -0,0 +1,114 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+package bcs
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"terraform-provider-kakaocloud/internal/common"
+
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	actionschema "github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/kakaoenterprise/kc-sdk-go/services/bcs"
+)
+
+var _ action.ActionWithConfigure = &instanceResizeAction{}
+
+func NewInstanceResizeAction() action.Action { return &instanceResizeAction{} }
+
+type instanceResizeAction struct{ instanceActionBase }
+
+type instanceResizeActionModel struct {
+	InstanceId types.String `tfsdk:"instance_id"`
+	FlavorId   types.String `tfsdk:"flavor_id"`
+}
+
+func (a *instanceResizeAction) Metadata(_ context.Context, req action.MetadataRequest, resp *action.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_instance_resize"
+}
+
+func (a *instanceResizeAction) Schema(_ context.Context, _ action.SchemaRequest, resp *action.SchemaResponse) {
+	resp.Schema = actionschema.Schema{
+		Attributes: map[string]actionschema.Attribute{
+			"instance_id": instanceActionInstanceIDAttribute(),
+			"flavor_id": actionschema.StringAttribute{
+				Required:   true,
+				Validators: common.UuidValidator(),
+			},
+		},
+	}
+}
+
+func (a *instanceResizeAction) Configure(_ context.Context, req action.ConfigureRequest, resp *action.ConfigureResponse) {
+	a.configure(req, resp)
+}
+
+func (a *instanceResizeAction) Invoke(ctx context.Context, req action.InvokeRequest, resp *action.InvokeResponse) {
+	var config instanceResizeActionModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, common.DefaultUpdateTimeout)
+	defer cancel()
+
+	instanceId := config.InstanceId.ValueString()
+	flavorId := config.FlavorId.ValueString()
+
+	flavorResp, httpResp, err := common.ExecuteWithRetryAndAuth(ctx, a.kc, &resp.Diagnostics, common.ServiceBCS,
+		func() (*bcs.ResponseFlavorModel, *http.Response, error) {
+			return a.kc.ApiClient.FlavorAPI.GetInstanceType(ctx, flavorId).XAuthToken(a.kc.XAuthToken).Execute()
+		},
+	)
+	if err != nil {
+		common.AddApiActionError(ctx, a, httpResp, "GetInstanceType", err, &resp.Diagnostics)
+		return
+	}
+	if instanceType := flavorResp.Flavor.InstanceType.Get(); instanceType != nil && *instanceType == bcs.INSTANCETYPE_BM {
+		common.AddValidationConfigError(ctx, a, &resp.Diagnostics,
+			"Invalid Configuration: Instances can only be resized to flavors of type 'vm'.")
+		return
+	}
+
+	status, ok := getInstanceActionStatus(ctx, a.kc, a, instanceId, &resp.Diagnostics)
+	if !ok {
+		return
+	}
+	if status != common.InstanceStatusActive && status != common.InstanceStatusStopped {
+		common.AddGeneralError(ctx, a, &resp.Diagnostics,
+			fmt.Sprintf("instance %s must be %q or %q to resize, but is %q", instanceId, common.InstanceStatusActive, common.InstanceStatusStopped, status))
+		return
+	}
+
+	tflog.Info(ctx, "invoking instance resize action", map[string]any{
+		"instance_id": instanceId,
+		"flavor_id":   flavorId,
+		"status":      status,
+	})
+
+	if status == common.InstanceStatusActive {
+		stopProgress := common.StartActionProgress(ctx, resp.SendProgress, fmt.Sprintf("Waiting for instance %s to stop before resizing", instanceId))
+		ok = stopInstance(ctx, a.kc, a, instanceId, &resp.Diagnostics)
+		stopProgress()
+		if !ok {
+			return
+		}
+	}
+
+	stopProgress := common.StartActionProgress(ctx, resp.SendProgress, fmt.Sprintf("Waiting for instance %s to resize to flavor %s", instanceId, flavorId))
+	ok = resizeInstance(ctx, a.kc, a, instanceId, flavorId, &resp.Diagnostics)
+	stopProgress()
+	if !ok {
+		return
+	}
+
+	if status == common.InstanceStatusActive {
+		stopProgress := common.StartActionProgress(ctx, resp.SendProgress, fmt.Sprintf("Waiting for instance %s to start after resizing", instanceId))
+		defer stopProgress()
+		startInstance(ctx, a.kc, a, instanceId, &resp.Diagnostics)
+	}
+}
@@ -103,6 +103,10 @@ func (r *instanceResource) Create(ctx context.Context, req resource.CreateReques
 		createReq.SetAvailabilityZone(bcs.AvailabilityZone(plan.AvailabilityZone.ValueString()))
 	}
 
+	if !plan.ServerGroupId.IsNull() && !plan.ServerGroupId.IsUnknown() {
+		createReq.SetServerGroupId(plan.ServerGroupId.ValueString())
+	}
+
 	if !plan.KeyName.IsNull() && !plan.KeyName.IsUnknown() {
 		createReq.SetKeyName(plan.KeyName.ValueString())
 	}
@@ -224,11 +228,24 @@ func (r *instanceResource) Create(ctx context.Context, req resource.CreateReques
 		createReq.SetSecurityGroups(sg)
 	}
 
+	if !config.SecurityGroupIds.IsNull() && !config.SecurityGroupIds.IsUnknown() {
+		names, ok := r.getSecurityGroupNames(ctx, config.SecurityGroupIds, &resp.Diagnostics)
+		if !ok || resp.Diagnostics.HasError() {
+			return
+		}
+
+		var sg []bcs.CreateInstanceSecurityGroupModel
+		for _, name := range names {
+			sg = append(sg, bcs.CreateInstanceSecurityGroupModel{Name: name})
+		}
+		createReq.SetSecurityGroups(sg)
+	}
+
 	body := bcs.BodyCreateInstance{
 		Instance: createReq,
 	}
 
-	respModel, httpResp, err := common.ExecuteWithRetryAndAuth(ctx, r.kc, &resp.Diagnostics,
+	respModel, httpResp, err := common.ExecuteWithRetryAndAuth(ctx, r.kc, &resp.Diagnostics, common.ServiceBCS,
 		func() (*bcs.ResponseCreateInstanceModel, *http.Response, error) {
 			return r.kc.ApiClient.InstanceAPI.CreateInstance(ctx).
 				XAuthToken(r.kc.XAuthToken).BodyCreateInstance(body).Execute()
@@ -292,6 +309,31 @@ func (r *instanceResource) Create(ctx context.Context, req resource.CreateReques
 		}
 	}
 
+	// Network interfaces attached after creation keep their own security groups, so the groups
+	// set at creation may not cover every interface yet.
+	if !config.SecurityGroupIds.IsNull() && !config.SecurityGroupIds.Equal(plan.SecurityGroupIds) {
+		ok = r.updateSecurityGroups(ctx, plan.Id.ValueString(), config.SecurityGroupIds, plan.SecurityGroupIds, &resp.Diagnostics)
+		if !ok || resp.Diagnostics.HasError() {
+			return
+		}
+
+		result, ok = r.pollInstanceUntilStatus(
+			ctx,
+			plan.Id.ValueString(),
+			[]string{common.InstanceStatusActive, common.InstanceStatusError},
+			&resp.Diagnostics,
+		)
+		if !ok || resp.Diagnostics.HasError() {
+			return
+		}
+
+		ok = r.mapInstance(ctx, &plan, result, &resp.Diagnostics)
+		if !ok || resp.Diagnostics.HasError() {
+			return
+		}
+		plan.SecurityGroupIds = config.SecurityGroupIds
+	}
+
 	if !config.Status.IsNull() && !config.Status.IsUnknown() && config.Status.ValueString() != common.InstanceStatusActive {
 		r.updateStatus(ctx, plan.Id.ValueString(), config.Status.ValueString(), plan.Status.ValueString(), &resp.Diagnostics)
 		plan.Status = config.Status
@@ -322,7 +364,7 @@ func (r *instanceResource) Read(ctx context.Context, req resource.ReadRequest, r
 	ctx, cancel := context.WithTimeout(ctx, timeout)
 	defer cancel()
 
-	respModel, httpResp, err := common.ExecuteWithRetryAndAuth(ctx, r.kc, &resp.Diagnostics,
+	respModel, httpResp, err := common.ExecuteWithRetryAndAuth(ctx, r.kc, &resp.Diagnostics, common.ServiceBCS,
 		func() (*bcs.ResponseInstanceModel, *http.Response, error) {
 			return r.kc.ApiClient.InstanceAPI.
 				GetInstance(ctx, state.Id.ValueString()).
@@ -345,6 +387,11 @@ func (r *instanceResource) Read(ctx context.Context, req resource.ReadRequest, r
 		return
 	}
 
+	r.setSecurityGroupIds(ctx, &state, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	if state.ImageId.IsNull() {
 		if idAttr, ok := state.Image.Attributes()["id"].(types.String); ok {
 			state.ImageId = idAttr
@@ -375,7 +422,7 @@ func (r *instanceResource) Read(ctx context.Context, req resource.ReadRequest, r
 
 		var subnetList []instanceSubnetModel
 		for _, address := range addresses {
-			respModel, httpResp, err := common.ExecuteWithRetryAndAuth(ctx, r.kc, &resp.Diagnostics,
+			respModel, httpResp, err := common.ExecuteWithRetryAndAuth(ctx, r.kc, &resp.Diagnostics, common.ServiceVPC,
 				func() (*vpc.BnsVpcV1ApiGetNetworkInterfaceModelResponseNetworkInterfaceModel, *http.Response, error) {
 					return r.kc.ApiClient.NetworkInterfaceAPI.GetNetworkInterface(ctx, address.NetworkInterfaceId.ValueString()).
 						XAuthToken(r.kc.XAuthToken).Execute()
@@ -504,7 +551,7 @@ func (r *instanceResource) Update(ctx context.Context, req resource.UpdateReques
 
 		body := *bcs.NewBodyUpdateInstance(editReq)
 
-		_, httpResp, err := common.ExecuteWithRetryAndAuth(ctx, r.kc, &resp.Diagnostics,
+		_, httpResp, err := common.ExecuteWithRetryAndAuth(ctx, r.kc, &resp.Diagnostics, common.ServiceBCS,
 			func() (*bcs.InstanceModelResponse, *http.Response, error) {
 				return r.kc.ApiClient.InstanceAPI.UpdateInstance(ctx, plan.Id.ValueString()).
 					XAuthToken(r.kc.XAuthToken).
@@ -536,38 +583,10 @@ func (r *instanceResource) Update(ctx context.Context, req resource.UpdateReques
 				return
 			}
 		}
-		req := bcs.ResizeInstanceModel{
-			Id: plan.FlavorId.ValueString(),
-		}
-
-		body := *bcs.NewBodyResizeInstance(req)
-
-		_, httpResp, err := common.ExecuteWithRetryAndAuth(ctx, r.kc, &resp.Diagnostics,
-			func() (interface{}, *http.Response, error) {
-				return r.kc.ApiClient.InstanceRunAnActionAPI.ResizeInstance(ctx, plan.Id.ValueString()).
-					XAuthToken(r.kc.XAuthToken).
-					BodyResizeInstance(body).
-					Execute()
-			},
-		)
-		if err != nil {
-			common.AddApiActionError(ctx, r, httpResp, "ResizeInstance", err, &resp.Diagnostics)
-			return
-		}
-
-		result, ok := r.pollInstanceUntilStatus(
-			ctx,
-			plan.Id.ValueString(),
-			[]string{common.InstanceStatusStopped, common.InstanceStatusError},
-			&resp.Diagnostics,
-		)
+		ok = resizeInstance(ctx, r.kc, r, plan.Id.ValueString(), plan.FlavorId.ValueString(), &resp.Diagnostics)
 		if !ok || resp.Diagnostics.HasError() {
 			return
 		}
-		common.CheckResourceAvailableStatus(ctx, r, result.Status.Get(), []string{common.InstanceStatusStopped}, &resp.Diagnostics)
-		if resp.Diagnostics.HasError() {
-			return
-		}
 
 		if desiredState != common.InstanceStatusStopped {
 			r.updateStatus(ctx, plan.Id.ValueString(), desiredState, common.InstanceStatusStopped, &resp.Diagnostics)
@@ -583,7 +602,7 @@ func (r *instanceResource) Update(ctx context.Context, req resource.UpdateReques
 			return
 		}
 
-		ok := r.updateAttachedVolumes(ctx, plan.Id.ValueString(), &planList, &stateList, &resp.Diagnostics)
+		ok := r.updateAttachedVolumes(ctx, plan.Id.ValueString(), &planList, &stateList, plan.IgnoreUnlistedVolumes.ValueBool(), &resp.Diagnostics)
 		if !ok || resp.Diagnostics.HasError() {
 			return
 		}
@@ -634,7 +653,16 @@ func (r *instanceResource) Update(ctx context.Context, req resource.UpdateReques
 		}
 	}
 
-	result, httpResp, err := common.ExecuteWithRetryAndAuth(ctx, r.kc, &resp.Diagnostics,
+	securityGroupIds := plan.SecurityGroupIds
+	securityGroupsChanged := !securityGroupIds.IsUnknown() && !securityGroupIds.Equal(state.SecurityGroupIds)
+	if securityGroupsChanged {
+		ok := r.updateSecurityGroups(ctx, plan.Id.ValueString(), securityGroupIds, state.SecurityGroupIds, &resp.Diagnostics)
+		if !ok || resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	result, httpResp, err := common.ExecuteWithRetryAndAuth(ctx, r.kc, &resp.Diagnostics, common.ServiceBCS,
 		func() (*bcs.ResponseInstanceModel, *http.Response, error) {
 			return r.kc.ApiClient.InstanceAPI.
 				GetInstance(ctx, plan.Id.ValueString()).
@@ -650,6 +678,9 @@ func (r *instanceResource) Update(ctx context.Context, req resource.UpdateReques
 	if !ok || resp.Diagnostics.HasError() {
 		return
 	}
+	if securityGroupsChanged {
+		plan.SecurityGroupIds = securityGroupIds
+	}
 
 	if !newName.IsNull() && !newName.IsUnknown() {
 		plan.Name = newName
@@ -683,7 +714,7 @@ func (r *instanceResource) Delete(ctx context.Context, req resource.DeleteReques
 	ctx, cancel := context.WithTimeout(ctx, timeout)
 	defer cancel()
 
-	_, httpResp, err := common.ExecuteWithRetryAndAuth(ctx, r.kc, &resp.Diagnostics,
+	_, httpResp, err := common.ExecuteWithRetryAndAuth(ctx, r.kc, &resp.Diagnostics, common.ServiceBCS,
 		func() (interface{}, *http.Response, error) {
 			httpResp, err := r.kc.ApiClient.InstanceAPI.DeleteInstance(ctx, state.Id.ValueString()).
 				XAuthToken(r.kc.XAuthToken).
@@ -700,7 +731,7 @@ func (r *instanceResource) Delete(ctx context.Context, req resource.DeleteReques
 	}
 
 	common.PollUntilDeletion(ctx, r, 2*time.Second, &resp.Diagnostics, func(ctx context.Context) (bool, *http.Response, error) {
-		_, httpResp, err := common.ExecuteWithRetryAndAuth(ctx, r.kc, &resp.Diagnostics,
+		_, httpResp, err := common.ExecuteWithRetryAndAuth(ctx, r.kc, &resp.Diagnostics, common.ServiceBCS,
 			func() (interface{}, *http.Response, error) {
 				_, httpResp, err := r.kc.ApiClient.InstanceAPI.
 					GetInstance(ctx, state.Id.ValueString()).
@@ -753,6 +784,8 @@ func (r *instanceResource) mapInstance(
 
 	r.setNetworkInterfaceId(ctx, plan, respDiags)
 
+	r.setSecurityGroupIds(ctx, plan, respDiags)
+
 	if respDiags.HasError() {
 		return false
 	}
@@ -765,7 +798,7 @@ func (r *instanceResource) getInstanceTypeFromFlavor(
 	flavorId string,
 	respDiags *diag.Diagnostics,
 ) (*bcs.InstanceType, bool) {
-	flavorResp, httpResp, err := common.ExecuteWithRetryAndAuth(ctx, r.kc, respDiags,
+	flavorResp, httpResp, err := common.ExecuteWithRetryAndAuth(ctx, r.kc, respDiags, common.ServiceBCS,
 		func() (*bcs.ResponseFlavorModel, *http.Response, error) {
 			return r.kc.ApiClient.FlavorAPI.GetInstanceType(ctx, flavorId).XAuthToken(r.kc.XAuthToken).Execute()
 		},
@@ -65,6 +65,9 @@ func getInstanceDataSourceSchema() map[string]schema.Attribute {
 		"availability_zone": schema.StringAttribute{
 			Computed: true,
 		},
+		"server_group_id": schema.StringAttribute{
+			Computed: true,
+		},
 		"attached_volumes": schema.ListNestedAttribute{
 			Computed: true,
 			NestedObject: schema.NestedAttributeObject{
@@ -9,10 +9,12 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/objectplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
@@ -125,6 +127,15 @@ func getInstanceResourceSchema() map[string]schema.Attribute {
 				stringplanmodifier.RequiresReplace(),
 			},
 		},
+		"server_group_id": schema.StringAttribute{
+			Optional:   true,
+			Computed:   true,
+			Validators: common.UuidValidator(),
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+				stringplanmodifier.RequiresReplace(),
+			},
+		},
 		"attached_volumes": schema.ListNestedAttribute{
 			Computed: true,
 			NestedObject: schema.NestedAttributeObject{
@@ -177,14 +188,28 @@ func getInstanceResourceSchema() map[string]schema.Attribute {
 			},
 		},
 		"initial_security_groups": schema.SetNestedAttribute{
-			Optional: true,
+			Optional:           true,
+			DeprecationMessage: "Use security_group_ids instead. initial_security_groups is only applied at creation and will be removed in a future version. To migrate, set security_group_ids to the IDs of the current security_groups and remove initial_security_groups.",
 			Validators: []validator.Set{
 				setvalidator.SizeAtLeast(1),
+				setvalidator.ConflictsWith(path.MatchRoot("security_group_ids")),
 			},
 			NestedObject: schema.NestedAttributeObject{
 				Attributes: getInstanceInitialSecurityGroupsResourceSchemaAttributes(),
 			},
 		},
+		"security_group_ids": schema.SetAttribute{
+			Optional:    true,
+			Computed:    true,
+			ElementType: types.StringType,
+			Validators: []validator.Set{
+				setvalidator.SizeAtLeast(1),
+				setvalidator.ValueStringsAre(common.UuidValidator()...),
+			},
+			PlanModifiers: []planmodifier.Set{
+				setplanmodifier.UseStateForUnknown(),
+			},
+		},
 		"volumes": schema.ListNestedAttribute{
 			Optional: true,
 			Validators: []validator.List{
@@ -194,9 +219,13 @@ func getInstanceResourceSchema() map[string]schema.Attribute {
 				Attributes: getInstanceVolumesResourceSchemaAttributes(),
 			},
 		},
+		"ignore_unlisted_volumes": schema.BoolAttribute{
+			Optional: true,
+		},
 		"user_data": schema.StringAttribute{
-			Optional:  true,
-			WriteOnly: true,
+			Optional:   true,
+			WriteOnly:  true,
+			Validators: common.Base64Validator(0),
 		},
 		"is_bonding": schema.BoolAttribute{
 			Optional: true,
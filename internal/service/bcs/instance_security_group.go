@@ -0,0 +1,255 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+package bcs
+
+import (
+	"fmt"
+	"net/http"
+	"slices"
+	"terraform-provider-kakaocloud/internal/common"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/kakaoenterprise/kc-sdk-go/services/bcs"
+	"github.com/kakaoenterprise/kc-sdk-go/services/network"
+	"github.com/kakaoenterprise/kc-sdk-go/services/vpc"
+	"golang.org/x/net/context"
+)
+
+// setSecurityGroupIds records the security groups that are applied to every network interface of
+// the instance. A group added directly to only some of the interfaces is left out, so that it
+// neither shows up as drift nor gets detached from all interfaces on the next update.
+func (r *instanceResource) setSecurityGroupIds(ctx context.Context, plan *instanceResourceModel, respDiags *diag.Diagnostics) {
+	addressList, diags := r.convertListToInstanceAddressModel(ctx, plan.Addresses)
+	respDiags.Append(diags...)
+	if respDiags.HasError() {
+		return
+	}
+
+	var perInterface [][]string
+	seen := make(map[string]struct{})
+	for _, address := range addressList {
+		nicId := address.NetworkInterfaceId.ValueString()
+		if nicId == "" {
+			continue
+		}
+		if _, ok := seen[nicId]; ok {
+			continue
+		}
+		seen[nicId] = struct{}{}
+
+		sgIds, ok := r.getNetworkInterfaceSecurityGroupIds(ctx, nicId, respDiags)
+		if !ok {
+			return
+		}
+		perInterface = append(perInterface, sgIds)
+	}
+
+	var ids []string
+	if len(perInterface) > 0 {
+		ids = commonSecurityGroupIds(perInterface)
+	} else {
+		var securityGroups []instanceSecurityGroupModel
+		respDiags.Append(plan.SecurityGroups.ElementsAs(ctx, &securityGroups, false)...)
+		if respDiags.HasError() {
+			return
+		}
+		ids = make([]string, 0, len(securityGroups))
+		for _, sg := range securityGroups {
+			ids = append(ids, sg.Id.ValueString())
+		}
+	}
+
+	plan.SecurityGroupIds, diags = types.SetValueFrom(ctx, types.StringType, ids)
+	respDiags.Append(diags...)
+}
+
+// commonSecurityGroupIds returns the IDs that are in every list, in the order of the first one.
+func commonSecurityGroupIds(perInterface [][]string) []string {
+	ids := []string{}
+	if len(perInterface) == 0 {
+		return ids
+	}
+	for _, id := range perInterface[0] {
+		onEvery := true
+		for _, other := range perInterface[1:] {
+			if !slices.Contains(other, id) {
+				onEvery = false
+				break
+			}
+		}
+		if onEvery && !slices.Contains(ids, id) {
+			ids = append(ids, id)
+		}
+	}
+	return ids
+}
+
+// getSecurityGroupNames resolves security group IDs to the names that CreateInstance expects.
+func (r *instanceResource) getSecurityGroupNames(ctx context.Context, ids types.Set, respDiags *diag.Diagnostics) ([]string, bool) {
+	var sgIds []string
+	respDiags.Append(ids.ElementsAs(ctx, &sgIds, false)...)
+	if respDiags.HasError() {
+		return nil, false
+	}
+
+	names := make([]string, 0, len(sgIds))
+	for _, sgId := range sgIds {
+		sgResp, httpResp, err := common.ExecuteWithRetryAndAuth(ctx, r.kc, respDiags, common.ServiceNetwork,
+			func() (*network.BnsNetworkV1ApiGetSecurityGroupModelResponseSecurityGroupModel, *http.Response, error) {
+				return r.kc.ApiClient.SecurityGroupAPI.
+					GetSecurityGroup(ctx, sgId).
+					XAuthToken(r.kc.XAuthToken).
+					Execute()
+			},
+		)
+		if err != nil {
+			common.AddApiActionError(ctx, r, httpResp, "GetSecurityGroup", err, respDiags)
+			return nil, false
+		}
+
+		name := sgResp.SecurityGroup.Name.Get()
+		if name == nil || *name == "" {
+			common.AddGeneralError(ctx, r, respDiags, fmt.Sprintf("Security group %s has no name.", sgId))
+			return nil, false
+		}
+		names = append(names, *name)
+	}
+	return names, true
+}
+
+// diffSecurityGroupIds returns the IDs in desired but not in current, and the other way round.
+func diffSecurityGroupIds(desired []string, current []string) (added []string, removed []string) {
+	for _, id := range desired {
+		if !slices.Contains(current, id) {
+			added = append(added, id)
+		}
+	}
+	for _, id := range current {
+		if !slices.Contains(desired, id) {
+			removed = append(removed, id)
+		}
+	}
+	return added, removed
+}
+
+// mergeSecurityGroupIds applies added and removed to the security groups of a network interface,
+// keeping the ones that are not part of the change in their current order.
+func mergeSecurityGroupIds(existing []string, added []string, removed []string) []string {
+	var sgIds []string
+	for _, id := range existing {
+		if !slices.Contains(removed, id) {
+			sgIds = append(sgIds, id)
+		}
+	}
+	for _, id := range added {
+		if !slices.Contains(sgIds, id) {
+			sgIds = append(sgIds, id)
+		}
+	}
+	return sgIds
+}
+
+// updateSecurityGroups attaches the added and detaches the removed security groups on every
+// network interface of the instance. Security groups that are set directly on a network
+// interface and are not part of the change are left as they are.
+func (r *instanceResource) updateSecurityGroups(
+	ctx context.Context,
+	instanceId string,
+	planIds types.Set,
+	stateIds types.Set,
+	respDiags *diag.Diagnostics,
+) bool {
+	var desired, current []string
+	respDiags.Append(planIds.ElementsAs(ctx, &desired, false)...)
+	respDiags.Append(stateIds.ElementsAs(ctx, &current, false)...)
+	if respDiags.HasError() {
+		return false
+	}
+
+	added, removed := diffSecurityGroupIds(desired, current)
+	if len(added) == 0 && len(removed) == 0 {
+		return true
+	}
+
+	instanceResp, httpResp, err := common.ExecuteWithRetryAndAuth(ctx, r.kc, respDiags, common.ServiceBCS,
+		func() (*bcs.ResponseInstanceModel, *http.Response, error) {
+			return r.kc.ApiClient.InstanceAPI.
+				GetInstance(ctx, instanceId).
+				XAuthToken(r.kc.XAuthToken).
+				Execute()
+		},
+	)
+	if err != nil {
+		common.AddApiActionError(ctx, r, httpResp, "GetInstance", err, respDiags)
+		return false
+	}
+
+	for _, address := range instanceResp.Instance.Addresses {
+		if address.NetworkInterfaceId.Get() == nil || *address.NetworkInterfaceId.Get() == "" {
+			continue
+		}
+		if !r.updateNetworkInterfaceSecurityGroups(ctx, *address.NetworkInterfaceId.Get(), added, removed, respDiags) {
+			return false
+		}
+	}
+	return true
+}
+
+// updateNetworkInterfaceSecurityGroups reads and rewrites the security groups of one network
+// interface. The network interface is locked so that concurrent updates of the same interface,
+// from this or the network interface resource, do not overwrite each other.
+func (r *instanceResource) updateNetworkInterfaceSecurityGroups(
+	ctx context.Context,
+	nicId string,
+	added []string,
+	removed []string,
+	respDiags *diag.Diagnostics,
+) bool {
+	mutex := common.LockForID(nicId)
+	mutex.Lock()
+	defer mutex.Unlock()
+
+	existing, ok := r.getNetworkInterfaceSecurityGroupIds(ctx, nicId, respDiags)
+	if !ok {
+		return false
+	}
+
+	editReq := vpc.EditNetworkInterfaceModel{}
+	editReq.SetSecurityGroups(mergeSecurityGroupIds(existing, added, removed))
+	body := *vpc.NewBodyUpdateNetworkInterface(editReq)
+
+	_, httpResp, err := common.ExecuteWithRetryAndAuth(ctx, r.kc, respDiags, common.ServiceVPC,
+		func() (*vpc.BnsVpcV1ApiUpdateNetworkInterfaceModelResponseNetworkInterfaceModel, *http.Response, error) {
+			return r.kc.ApiClient.NetworkInterfaceAPI.UpdateNetworkInterface(ctx, nicId).
+				XAuthToken(r.kc.XAuthToken).
+				BodyUpdateNetworkInterface(body).
+				Execute()
+		},
+	)
+	if err != nil {
+		common.AddApiActionError(ctx, r, httpResp, "UpdateNetworkInterface", err, respDiags)
+		return false
+	}
+	return true
+}
+
+// getNetworkInterfaceSecurityGroupIds returns the IDs of the security groups set on a network
+// interface.
+func (r *instanceResource) getNetworkInterfaceSecurityGroupIds(ctx context.Context, nicId string, respDiags *diag.Diagnostics) ([]string, bool) {
+	nicResp, httpResp, err := common.ExecuteWithRetryAndAuth(ctx, r.kc, respDiags, common.ServiceVPC,
+		func() (*vpc.BnsVpcV1ApiGetNetworkInterfaceModelResponseNetworkInterfaceModel, *http.Response, error) {
+			return r.kc.ApiClient.NetworkInterfaceAPI.GetNetworkInterface(ctx, nicId).XAuthToken(r.kc.XAuthToken).Execute()
+		},
+	)
+	if err != nil {
+		common.AddApiActionError(ctx, r, httpResp, "GetNetworkInterface", err, respDiags)
+		return nil, false
+	}
+
+	sgIds := make([]string, 0, len(nicResp.NetworkInterface.SecurityGroups))
+	for _, sg := range nicResp.NetworkInterface.SecurityGroups {
+		sgIds = append(sgIds, sg.Id)
+	}
+	return sgIds, true
+}
@@ -0,0 +1,87 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+package bcs
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestDiffSecurityGroupIds(t *testing.T) {
+	added, removed := diffSecurityGroupIds([]string{"a", "b", "c"}, []string{"b", "d"})
+	if !reflect.DeepEqual(added, []string{"a", "c"}) {
+		t.Errorf("added = %v, want [a c]", added)
+	}
+	if !reflect.DeepEqual(removed, []string{"d"}) {
+		t.Errorf("removed = %v, want [d]", removed)
+	}
+
+	added, removed = diffSecurityGroupIds([]string{"a"}, []string{"a"})
+	if added != nil || removed != nil {
+		t.Errorf("unchanged sets = %v, %v, want no changes", added, removed)
+	}
+}
+
+func TestMergeSecurityGroupIds(t *testing.T) {
+	cases := []struct {
+		name     string
+		existing []string
+		added    []string
+		removed  []string
+		want     []string
+	}{
+		{"add", []string{"a"}, []string{"b"}, nil, []string{"a", "b"}},
+		{"remove", []string{"a", "b"}, nil, []string{"a"}, []string{"b"}},
+		{"keep groups set on the interface", []string{"nic", "a"}, []string{"b"}, []string{"a"}, []string{"nic", "b"}},
+		{"already attached", []string{"a", "b"}, []string{"b"}, nil, []string{"a", "b"}},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := mergeSecurityGroupIds(tc.existing, tc.added, tc.removed); !reflect.DeepEqual(got, tc.want) {
+				t.Fatalf("got %v, want %v", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestCommonSecurityGroupIds(t *testing.T) {
+	cases := []struct {
+		name         string
+		perInterface [][]string
+		want         []string
+	}{
+		{"no interfaces", nil, []string{}},
+		{"single interface", [][]string{{"a", "b"}}, []string{"a", "b"}},
+		{"same groups", [][]string{{"a", "b"}, {"b", "a"}}, []string{"a", "b"}},
+		{"group on one interface only", [][]string{{"a", "nic"}, {"a"}}, []string{"a"}},
+		{"nothing in common", [][]string{{"a"}, {"b"}}, []string{}},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := commonSecurityGroupIds(tc.perInterface); !reflect.DeepEqual(got, tc.want) {
+				t.Fatalf("got %v, want %v", got, tc.want)
+			}
+		})
+	}
+}
+
+// A group added directly to one interface is not recorded, so adding another group keeps it on
+// that interface and does not attach it to the others.
+func TestSecurityGroupOnOneInterfaceOnly(t *testing.T) {
+	perInterface := [][]string{{"a", "nic"}, {"a"}}
+
+	current := commonSecurityGroupIds(perInterface)
+	added, removed := diffSecurityGroupIds([]string{"a", "b"}, current)
+	if !reflect.DeepEqual(added, []string{"b"}) || removed != nil {
+		t.Fatalf("added, removed = %v, %v, want [b], []", added, removed)
+	}
+
+	want := [][]string{{"a", "nic", "b"}, {"a", "b"}}
+	for i, existing := range perInterface {
+		if got := mergeSecurityGroupIds(existing, added, removed); !reflect.DeepEqual(got, want[i]) {
+			t.Errorf("interface %d = %v, want %v", i, got, want[i])
+		}
+	}
+}
@@ -40,40 +40,40 @@ func (r *instanceResource) updateStatus(
 		state = result.GetStatus()
 	}
 
-	type transitionFunc func(ctx context.Context, instanceId string, resp *diag.Diagnostics) bool
+	type transitionFunc func(ctx context.Context, kc *common.KakaoCloudClient, obj interface{}, instanceId string, resp *diag.Diagnostics) bool
 
 	transitions := map[string]map[string][]transitionFunc{
 		common.InstanceStatusActive: {
 			common.InstanceStatusStopped: {
-				r.startInstance,
+				startInstance,
 			},
 			common.InstanceStatusShelved: {
-				r.unshelveInstance,
+				unshelveInstance,
 			},
 		},
 		common.InstanceStatusStopped: {
 			common.InstanceStatusActive: {
-				r.stopInstance,
+				stopInstance,
 			},
 			common.InstanceStatusShelved: {
-				r.unshelveInstance,
-				r.stopInstance,
+				unshelveInstance,
+				stopInstance,
 			},
 		},
 		common.InstanceStatusShelved: {
 			common.InstanceStatusActive: {
-				r.shelveInstance,
+				shelveInstance,
 			},
 			common.InstanceStatusStopped: {
-				r.startInstance,
-				r.shelveInstance,
+				startInstance,
+				shelveInstance,
 			},
 		},
 	}
 
 	if next, ok := transitions[plan][state]; ok {
 		for _, fn := range next {
-			if !fn(ctx, instanceId, resp) {
+			if !fn(ctx, r.kc, r, instanceId, resp) {
 				return false
 			}
 		}
@@ -81,27 +81,31 @@ func (r *instanceResource) updateStatus(
 	return true
 }
 
-func (r *instanceResource) startInstance(
+func startInstance(
 	ctx context.Context,
+	kc *common.KakaoCloudClient,
+	obj interface{},
 	instanceId string,
 	resp *diag.Diagnostics,
 ) bool {
-	_, httpResp, err := common.ExecuteWithRetryAndAuth(ctx, r.kc, resp,
+	_, httpResp, err := common.ExecuteWithRetryAndAuth(ctx, kc, resp, common.ServiceBCS,
 		func() (struct{}, *http.Response, error) {
-			_, httpResp, err := r.kc.ApiClient.InstanceRunAnActionAPI.StartInstance(ctx, instanceId).
-				XAuthToken(r.kc.XAuthToken).
+			_, httpResp, err := kc.ApiClient.InstanceRunAnActionAPI.StartInstance(ctx, instanceId).
+				XAuthToken(kc.XAuthToken).
 				Execute()
 			return struct{}{}, httpResp, err
 		},
 	)
 
 	if err != nil {
-		common.AddApiActionError(ctx, r, httpResp, "StartInstance", err, resp)
+		common.AddApiActionError(ctx, obj, httpResp, "StartInstance", err, resp)
 		return false
 	}
 
-	result, ok := r.pollInstanceUntilStatus(
+	result, ok := pollInstanceUntilStatus(
 		ctx,
+		kc,
+		obj,
 		instanceId,
 		[]string{common.InstanceStatusActive, common.InstanceStatusError},
 		resp,
@@ -110,7 +114,7 @@ func (r *instanceResource) startInstance(
 		return false
 	}
 
-	common.CheckResourceAvailableStatus(ctx, r, result.Status.Get(), []string{common.InstanceStatusActive}, resp)
+	common.CheckResourceAvailableStatus(ctx, obj, result.Status.Get(), []string{common.InstanceStatusActive}, resp)
 	if resp.HasError() {
 		return false
 	}
@@ -118,25 +122,29 @@ func (r *instanceResource) startInstance(
 	return true
 }
 
-func (r *instanceResource) stopInstance(
+func stopInstance(
 	ctx context.Context,
+	kc *common.KakaoCloudClient,
+	obj interface{},
 	instanceId string,
 	resp *diag.Diagnostics,
 ) bool {
-	_, httpResp, err := common.ExecuteWithRetryAndAuth(ctx, r.kc, resp,
+	_, httpResp, err := common.ExecuteWithRetryAndAuth(ctx, kc, resp, common.ServiceBCS,
 		func() (interface{}, *http.Response, error) {
-			return r.kc.ApiClient.InstanceRunAnActionAPI.StopInstance(ctx, instanceId).
-				XAuthToken(r.kc.XAuthToken).
+			return kc.ApiClient.InstanceRunAnActionAPI.StopInstance(ctx, instanceId).
+				XAuthToken(kc.XAuthToken).
 				Execute()
 		},
 	)
 
 	if err != nil {
-		common.AddApiActionError(ctx, r, httpResp, "StopInstance", err, resp)
+		common.AddApiActionError(ctx, obj, httpResp, "StopInstance", err, resp)
 		return false
 	}
-	result, ok := r.pollInstanceUntilStatus(
+	result, ok := pollInstanceUntilStatus(
 		ctx,
+		kc,
+		obj,
 		instanceId,
 		[]string{common.InstanceStatusStopped, common.InstanceStatusError},
 		resp,
@@ -145,7 +153,7 @@ func (r *instanceResource) stopInstance(
 		return false
 	}
 
-	common.CheckResourceAvailableStatus(ctx, r, result.Status.Get(), []string{common.InstanceStatusStopped}, resp)
+	common.CheckResourceAvailableStatus(ctx, obj, result.Status.Get(), []string{common.InstanceStatusStopped}, resp)
 	if resp.HasError() {
 		return false
 	}
@@ -153,25 +161,29 @@ func (r *instanceResource) stopInstance(
 	return true
 }
 
-func (r *instanceResource) shelveInstance(
+func shelveInstance(
 	ctx context.Context,
+	kc *common.KakaoCloudClient,
+	obj interface{},
 	instanceId string,
 	resp *diag.Diagnostics,
 ) bool {
-	_, httpResp, err := common.ExecuteWithRetryAndAuth(ctx, r.kc, resp,
+	_, httpResp, err := common.ExecuteWithRetryAndAuth(ctx, kc, resp, common.ServiceBCS,
 		func() (interface{}, *http.Response, error) {
-			return r.kc.ApiClient.InstanceRunAnActionAPI.ShelveInstance(ctx, instanceId).
-				XAuthToken(r.kc.XAuthToken).
+			return kc.ApiClient.InstanceRunAnActionAPI.ShelveInstance(ctx, instanceId).
+				XAuthToken(kc.XAuthToken).
 				Execute()
 		},
 	)
 
 	if err != nil {
-		common.AddApiActionError(ctx, r, httpResp, "ShelveInstance", err, resp)
+		common.AddApiActionError(ctx, obj, httpResp, "ShelveInstance", err, resp)
 		return false
 	}
-	result, ok := r.pollInstanceUntilStatus(
+	result, ok := pollInstanceUntilStatus(
 		ctx,
+		kc,
+		obj,
 		instanceId,
 		[]string{common.InstanceStatusShelved, common.InstanceStatusError},
 		resp,
@@ -180,7 +192,7 @@ func (r *instanceResource) shelveInstance(
 		return false
 	}
 
-	common.CheckResourceAvailableStatus(ctx, r, result.Status.Get(), []string{common.InstanceStatusShelved}, resp)
+	common.CheckResourceAvailableStatus(ctx, obj, result.Status.Get(), []string{common.InstanceStatusShelved}, resp)
 	if resp.HasError() {
 		return false
 	}
@@ -188,25 +200,29 @@ func (r *instanceResource) shelveInstance(
 	return true
 }
 
-func (r *instanceResource) unshelveInstance(
+func unshelveInstance(
 	ctx context.Context,
+	kc *common.KakaoCloudClient,
+	obj interface{},
 	instanceId string,
 	resp *diag.Diagnostics,
 ) bool {
-	_, httpResp, err := common.ExecuteWithRetryAndAuth(ctx, r.kc, resp,
+	_, httpResp, err := common.ExecuteWithRetryAndAuth(ctx, kc, resp, common.ServiceBCS,
 		func() (interface{}, *http.Response, error) {
-			return r.kc.ApiClient.InstanceRunAnActionAPI.UnshelveInstance(ctx, instanceId).
-				XAuthToken(r.kc.XAuthToken).
+			return kc.ApiClient.InstanceRunAnActionAPI.UnshelveInstance(ctx, instanceId).
+				XAuthToken(kc.XAuthToken).
 				Execute()
 		},
 	)
 
 	if err != nil {
-		common.AddApiActionError(ctx, r, httpResp, "UnshelveInstance", err, resp)
+		common.AddApiActionError(ctx, obj, httpResp, "UnshelveInstance", err, resp)
 		return false
 	}
-	result, ok := r.pollInstanceUntilStatus(
+	result, ok := pollInstanceUntilStatus(
 		ctx,
+		kc,
+		obj,
 		instanceId,
 		[]string{common.InstanceStatusActive, common.InstanceStatusError},
 		resp,
@@ -215,7 +231,55 @@ func (r *instanceResource) unshelveInstance(
 		return false
 	}
 
-	common.CheckResourceAvailableStatus(ctx, r, result.Status.Get(), []string{common.InstanceStatusActive}, resp)
+	common.CheckResourceAvailableStatus(ctx, obj, result.Status.Get(), []string{common.InstanceStatusActive}, resp)
+	if resp.HasError() {
+		return false
+	}
+
+	return true
+}
+
+// resizeInstance changes the flavor of a stopped instance and waits until it is stopped again.
+func resizeInstance(
+	ctx context.Context,
+	kc *common.KakaoCloudClient,
+	obj interface{},
+	instanceId string,
+	flavorId string,
+	resp *diag.Diagnostics,
+) bool {
+	req := bcs.ResizeInstanceModel{
+		Id: flavorId,
+	}
+
+	body := *bcs.NewBodyResizeInstance(req)
+
+	_, httpResp, err := common.ExecuteWithRetryAndAuth(ctx, kc, resp, common.ServiceBCS,
+		func() (interface{}, *http.Response, error) {
+			return kc.ApiClient.InstanceRunAnActionAPI.ResizeInstance(ctx, instanceId).
+				XAuthToken(kc.XAuthToken).
+				BodyResizeInstance(body).
+				Execute()
+		},
+	)
+	if err != nil {
+		common.AddApiActionError(ctx, obj, httpResp, "ResizeInstance", err, resp)
+		return false
+	}
+
+	result, ok := pollInstanceUntilStatus(
+		ctx,
+		kc,
+		obj,
+		instanceId,
+		[]string{common.InstanceStatusStopped, common.InstanceStatusError},
+		resp,
+	)
+	if !ok || resp.HasError() {
+		return false
+	}
+
+	common.CheckResourceAvailableStatus(ctx, obj, result.Status.Get(), []string{common.InstanceStatusStopped}, resp)
 	if resp.HasError() {
 		return false
 	}
@@ -228,21 +292,50 @@ func (r *instanceResource) pollInstanceUntilStatus(
 	instanceId string,
 	targetStatuses []string,
 	diag *diag.Diagnostics,
+) (*bcs.BcsInstanceV1ApiGetInstanceModelInstanceModel, bool) {
+	return pollInstanceUntilStatus(ctx, r.kc, r, instanceId, targetStatuses, diag)
+}
+
+func pollInstanceUntilStatus(
+	ctx context.Context,
+	kc *common.KakaoCloudClient,
+	obj interface{},
+	instanceId string,
+	targetStatuses []string,
+	diag *diag.Diagnostics,
+) (*bcs.BcsInstanceV1ApiGetInstanceModelInstanceModel, bool) {
+	return pollInstance(ctx, kc, obj, instanceId, targetStatuses, diag,
+		func(v *bcs.BcsInstanceV1ApiGetInstanceModelInstanceModel) string {
+			return *v.Status.Get()
+		},
+	)
+}
+
+// pollInstance polls the instance until getStatus returns one of targetStatuses. getStatus may
+// derive a status from more than the instance status, such as its update time.
+func pollInstance(
+	ctx context.Context,
+	kc *common.KakaoCloudClient,
+	obj interface{},
+	instanceId string,
+	targetStatuses []string,
+	diag *diag.Diagnostics,
+	getStatus func(*bcs.BcsInstanceV1ApiGetInstanceModelInstanceModel) string,
 ) (*bcs.BcsInstanceV1ApiGetInstanceModelInstanceModel, bool) {
 	return common.PollUntilResult(
 		ctx,
-		r,
+		obj,
 		3*time.Second,
 		"instance",
 		instanceId,
 		targetStatuses,
 		diag,
 		func(ctx context.Context) (*bcs.BcsInstanceV1ApiGetInstanceModelInstanceModel, *http.Response, error) {
-			respModel, httpResp, err := common.ExecuteWithRetryAndAuth(ctx, r.kc, diag,
+			respModel, httpResp, err := common.ExecuteWithRetryAndAuth(ctx, kc, diag, common.ServiceBCS,
 				func() (*bcs.ResponseInstanceModel, *http.Response, error) {
-					return r.kc.ApiClient.InstanceAPI.
+					return kc.ApiClient.InstanceAPI.
 						GetInstance(ctx, instanceId).
-						XAuthToken(r.kc.XAuthToken).
+						XAuthToken(kc.XAuthToken).
 						Execute()
 				},
 			)
@@ -251,8 +344,6 @@ func (r *instanceResource) pollInstanceUntilStatus(
 			}
 			return &respModel.Instance, httpResp, nil
 		},
-		func(v *bcs.BcsInstanceV1ApiGetInstanceModelInstanceModel) string {
-			return *v.Status.Get()
-		},
+		getStatus,
 	)
 }
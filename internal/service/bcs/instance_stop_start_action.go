@@ -0,0 +1,81 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+package bcs
+
+import (
+	"context"
+	"fmt"
+	"terraform-provider-kakaocloud/internal/common"
+
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	actionschema "github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var _ action.ActionWithConfigure = &instanceStopStartAction{}
+
+func NewInstanceStopStartAction() action.Action { return &instanceStopStartAction{} }
+
+type instanceStopStartAction struct{ instanceActionBase }
+
+type instanceStopStartActionModel struct {
+	InstanceId types.String `tfsdk:"instance_id"`
+}
+
+func (a *instanceStopStartAction) Metadata(_ context.Context, req action.MetadataRequest, resp *action.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_instance_stop_start"
+}
+
+func (a *instanceStopStartAction) Schema(_ context.Context, _ action.SchemaRequest, resp *action.SchemaResponse) {
+	resp.Schema = actionschema.Schema{
+		Attributes: map[string]actionschema.Attribute{
+			"instance_id": instanceActionInstanceIDAttribute(),
+		},
+	}
+}
+
+func (a *instanceStopStartAction) Configure(_ context.Context, req action.ConfigureRequest, resp *action.ConfigureResponse) {
+	a.configure(req, resp)
+}
+
+func (a *instanceStopStartAction) Invoke(ctx context.Context, req action.InvokeRequest, resp *action.InvokeResponse) {
+	var config instanceStopStartActionModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, common.DefaultUpdateTimeout)
+	defer cancel()
+
+	instanceId := config.InstanceId.ValueString()
+
+	status, ok := getInstanceActionStatus(ctx, a.kc, a, instanceId, &resp.Diagnostics)
+	if !ok {
+		return
+	}
+	if status != common.InstanceStatusActive && status != common.InstanceStatusStopped {
+		common.AddGeneralError(ctx, a, &resp.Diagnostics,
+			fmt.Sprintf("instance %s must be %q or %q to stop and start, but is %q", instanceId, common.InstanceStatusActive, common.InstanceStatusStopped, status))
+		return
+	}
+
+	tflog.Info(ctx, "invoking instance stop/start action", map[string]any{
+		"instance_id": instanceId,
+		"status":      status,
+	})
+
+	if status == common.InstanceStatusActive {
+		stopProgress := common.StartActionProgress(ctx, resp.SendProgress, fmt.Sprintf("Waiting for instance %s to stop", instanceId))
+		ok = stopInstance(ctx, a.kc, a, instanceId, &resp.Diagnostics)
+		stopProgress()
+		if !ok {
+			return
+		}
+	}
+
+	stopProgress := common.StartActionProgress(ctx, resp.SendProgress, fmt.Sprintf("Waiting for instance %s to start", instanceId))
+	defer stopProgress()
+	startInstance(ctx, a.kc, a, instanceId, &resp.Diagnostics)
+}
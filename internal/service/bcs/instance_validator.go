@@ -145,9 +145,9 @@ func (r *instanceResource) validateSubnetsAndSecurityGroupsUpdate(ctx context.Co
 		return
 	}
 
-	if !plan.InitialSecurityGroups.Equal(state.InitialSecurityGroups) {
+	if !plan.InitialSecurityGroups.IsNull() && !plan.InitialSecurityGroups.Equal(state.InitialSecurityGroups) {
 		common.AddValidationConfigError(ctx, r, &resp.Diagnostics,
-			"Invalid Configuration: Changing the initial security group is not allowed.")
+			"Invalid Configuration: Changing the initial security group is not allowed. Use security_group_ids to manage security groups in place.")
 	}
 
 	planList, planDiags := r.convertListToInstanceSubnetModel(ctx, plan.Subnets)
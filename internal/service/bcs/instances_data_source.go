@@ -188,9 +188,12 @@ func (d *instancesDataSource) Read(ctx context.Context, req datasource.ReadReque
 		return
 	}
 
-	instanceResp, httpResp, err := common.ExecuteWithRetryAndAuth(ctx, d.kc, &resp.Diagnostics,
-		func() (*bcs.InstanceListModel, *http.Response, error) {
-			return instanceApi.Limit(1000).XAuthToken(d.kc.XAuthToken).Execute()
+	instancePages, httpResp, err := common.ListAllPagesPartial(ctx, d.kc, d, &resp.Diagnostics, common.ServiceBCS,
+		func(limit int32, offset int32) (*bcs.InstanceListModel, *http.Response, error) {
+			return instanceApi.Limit(limit).Offset(offset).XAuthToken(d.kc.XAuthToken).Execute()
+		},
+		func(page *bcs.InstanceListModel) int {
+			return len(page.Instances)
 		},
 	)
 	if err != nil {
@@ -198,14 +201,16 @@ func (d *instancesDataSource) Read(ctx context.Context, req datasource.ReadReque
 		return
 	}
 
-	for _, v := range instanceResp.Instances {
-		var tmpInstance instanceBaseModel
-		ok := mapInstanceListModel(ctx, &tmpInstance, &v, &resp.Diagnostics)
-		if !ok || resp.Diagnostics.HasError() {
-			return
-		}
+	for _, instanceResp := range instancePages {
+		for _, v := range instanceResp.Instances {
+			var tmpInstance instanceBaseModel
+			ok := mapInstanceListModel(ctx, &tmpInstance, &v, &resp.Diagnostics)
+			if !ok || resp.Diagnostics.HasError() {
+				return
+			}
 
-		config.Instances = append(config.Instances, tmpInstance)
+			config.Instances = append(config.Instances, tmpInstance)
+		}
 	}
 
 	diags = resp.State.Set(ctx, &config)
@@ -65,7 +65,7 @@ func (d *keypairDataSource) Read(ctx context.Context, req datasource.ReadRequest
 	ctx, cancel := context.WithTimeout(ctx, timeout)
 	defer cancel()
 
-	keypairResp, httpResp, err := common.ExecuteWithRetryAndAuth(ctx, d.kc, &resp.Diagnostics,
+	keypairResp, httpResp, err := common.ExecuteWithRetryAndAuth(ctx, d.kc, &resp.Diagnostics, common.ServiceBCS,
 		func() (*bcs.BcsInstanceV1ApiGetKeypairModelResponseKeypairModel, *http.Response, error) {
 			return d.kc.ApiClient.KeypairAPI.GetKeypair(ctx, config.Name.ValueString()).
 				XAuthToken(d.kc.XAuthToken).
@@ -0,0 +1,139 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+package bcs
+
+import (
+	"context"
+	"crypto/md5"
+	"encoding/base64"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+const (
+	keypairPublicKeyTypeRSA     = "ssh-rsa"
+	keypairPublicKeyTypeEd25519 = "ssh-ed25519"
+)
+
+// parseOpenSSHPublicKey decodes an authorized_keys style public key ("<type> <base64> [comment]")
+// and returns the wire-format key blob.
+func parseOpenSSHPublicKey(value string) ([]byte, error) {
+	fields := strings.Fields(value)
+	if len(fields) < 2 {
+		return nil, errors.New("expected \"<type> <base64-key> [comment]\"")
+	}
+
+	keyType := fields[0]
+	if keyType != keypairPublicKeyTypeRSA && keyType != keypairPublicKeyTypeEd25519 {
+		return nil, fmt.Errorf("unsupported key type %q, must be %s or %s", keyType, keypairPublicKeyTypeRSA, keypairPublicKeyTypeEd25519)
+	}
+
+	blob, err := base64.StdEncoding.DecodeString(fields[1])
+	if err != nil {
+		return nil, errors.New("key data is not valid base64")
+	}
+
+	rest := blob
+	blobType, rest, ok := readSSHString(rest)
+	if !ok || string(blobType) != keyType {
+		return nil, fmt.Errorf("key data does not contain a %s key", keyType)
+	}
+
+	switch keyType {
+	case keypairPublicKeyTypeRSA:
+		var e, n []byte
+		if e, rest, ok = readSSHString(rest); !ok || len(e) == 0 {
+			return nil, errors.New("invalid RSA public exponent")
+		}
+		if n, rest, ok = readSSHString(rest); !ok || len(n) == 0 {
+			return nil, errors.New("invalid RSA modulus")
+		}
+	case keypairPublicKeyTypeEd25519:
+		var key []byte
+		if key, rest, ok = readSSHString(rest); !ok || len(key) != 32 {
+			return nil, errors.New("ed25519 keys must be 32 bytes")
+		}
+	}
+	if len(rest) != 0 {
+		return nil, errors.New("unexpected trailing key data")
+	}
+
+	return blob, nil
+}
+
+func readSSHString(in []byte) ([]byte, []byte, bool) {
+	if len(in) < 4 {
+		return nil, nil, false
+	}
+	length := binary.BigEndian.Uint32(in)
+	if uint64(len(in)-4) < uint64(length) {
+		return nil, nil, false
+	}
+	return in[4 : 4+length], in[4+length:], true
+}
+
+// keypairPublicKeyFingerprint returns the MD5 fingerprint of an OpenSSH public key in the
+// colon-separated hex format used by the keypair API.
+func keypairPublicKeyFingerprint(value string) (string, error) {
+	blob, err := parseOpenSSHPublicKey(value)
+	if err != nil {
+		return "", err
+	}
+
+	sum := md5.Sum(blob)
+	parts := make([]string, len(sum))
+	for i, b := range sum {
+		parts[i] = fmt.Sprintf("%02x", b)
+	}
+	return strings.Join(parts, ":"), nil
+}
+
+// sameKeypairPublicKey reports whether both values encode the same key, ignoring comments and
+// whitespace.
+func sameKeypairPublicKey(a, b types.String) bool {
+	if a.IsNull() || a.IsUnknown() || b.IsNull() || b.IsUnknown() {
+		return false
+	}
+	fa, err := keypairPublicKeyFingerprint(a.ValueString())
+	if err != nil {
+		return false
+	}
+	fb, err := keypairPublicKeyFingerprint(b.ValueString())
+	if err != nil {
+		return false
+	}
+	return fa == fb
+}
+
+func keypairPublicKeyValidator() []validator.String {
+	return []validator.String{publicKeyValidator{}}
+}
+
+type publicKeyValidator struct{}
+
+func (v publicKeyValidator) Description(_ context.Context) string {
+	return "Value must be an OpenSSH ssh-rsa or ssh-ed25519 public key"
+}
+
+func (v publicKeyValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v publicKeyValidator) ValidateString(_ context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	if _, err := parseOpenSSHPublicKey(req.ConfigValue.ValueString()); err != nil {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid public key",
+			fmt.Sprintf("The public key is not a valid OpenSSH public key: %s.", err),
+		)
+	}
+}
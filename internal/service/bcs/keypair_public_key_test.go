@@ -0,0 +1,80 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+package bcs
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+const (
+	testEd25519PublicKey = "ssh-ed25519 AAAAC3NzaC1lZDI1NTE5AAAAILLqE0Sop/aOPSeZ+yT0Qo3IwAJn5hrI8USNW4D7Lpbu dev@example"
+	testRSAPublicKey     = "ssh-rsa AAAAB3NzaC1yc2EAAAADAQABAAABAQCvOO6HakpFuXeQHrCqr3llnMMJghPTPsxMviCda3sQWpc+3gl/3K6mcYye/o8UojQ7+6xVcdhmJxmEXX3CU8M9bSmHqTWVp5cs+5vkZTb0iwD3FDoigKzVXx5XMYw1MA0jz7CYFr1eZou7mft0A+DGUTPji1P6o3o5VADAuMtKitAoUiXd37MtjUEbPrC7EA3ivhQmsbBzLj0BDfXvJahsaKTAcd4eUABEsr0sTtxCwD9iA5NfCKHEmYMptuIPy9SmHSkMpK90+sv12pkPPi0qaKi+FKM2X1kplE7BdUoBX8B9S6wrux+ELDZxsakaJHc36C/lDT42JPBT1ILhsbyV"
+)
+
+func TestKeypairPublicKeyFingerprint(t *testing.T) {
+	tests := []struct {
+		name    string
+		key     string
+		want    string
+		wantErr bool
+	}{
+		{
+			name: "ed25519",
+			key:  testEd25519PublicKey,
+			want: "f8:af:6f:5b:75:6d:e2:b4:88:cc:0f:06:4c:d8:0f:bc",
+		},
+		{
+			name: "rsa without comment",
+			key:  testRSAPublicKey,
+			want: "3a:2a:f5:51:eb:b9:32:35:a5:41:d7:ab:ec:bf:41:06",
+		},
+		{
+			name:    "unsupported type",
+			key:     "ssh-dss AAAAB3NzaC1kc3M=",
+			wantErr: true,
+		},
+		{
+			name:    "type mismatch",
+			key:     "ssh-rsa AAAAC3NzaC1lZDI1NTE5AAAAILLqE0Sop/aOPSeZ+yT0Qo3IwAJn5hrI8USNW4D7Lpbu",
+			wantErr: true,
+		},
+		{
+			name:    "invalid base64",
+			key:     "ssh-ed25519 not-base64!",
+			wantErr: true,
+		},
+		{
+			name:    "missing key data",
+			key:     "ssh-ed25519",
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := keypairPublicKeyFingerprint(tt.key)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("keypairPublicKeyFingerprint() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("keypairPublicKeyFingerprint() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSameKeypairPublicKey(t *testing.T) {
+	withoutComment := types.StringValue("ssh-ed25519 AAAAC3NzaC1lZDI1NTE5AAAAILLqE0Sop/aOPSeZ+yT0Qo3IwAJn5hrI8USNW4D7Lpbu")
+
+	if !sameKeypairPublicKey(types.StringValue(testEd25519PublicKey), withoutComment) {
+		t.Error("keys that differ only by comment should match")
+	}
+	if sameKeypairPublicKey(types.StringValue(testEd25519PublicKey), types.StringValue(testRSAPublicKey)) {
+		t.Error("different keys should not match")
+	}
+	if sameKeypairPublicKey(types.StringNull(), withoutComment) {
+		t.Error("a null key should not match")
+	}
+}
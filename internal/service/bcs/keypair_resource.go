@@ -15,6 +15,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/kakaoenterprise/kc-sdk-go/services/bcs"
 )
 
@@ -67,15 +68,17 @@ func (r *keypairResource) Create(ctx context.Context, req resource.CreateRequest
 		Name: plan.Name.ValueString(),
 	}
 
-	if !plan.PublicKey.IsNull() && !plan.PublicKey.IsUnknown() {
-		createReq.SetPublicKey(plan.PublicKey.ValueString())
+	configuredPublicKey := plan.PublicKey
+	importPublicKey := !configuredPublicKey.IsNull() && !configuredPublicKey.IsUnknown()
+	if importPublicKey {
+		createReq.SetPublicKey(configuredPublicKey.ValueString())
 	}
 
 	body := bcs.BodyCreateKeypair{
 		Keypair: createReq,
 	}
 
-	respModel, httpResp, err := common.ExecuteWithRetryAndAuth(ctx, r.kc, &resp.Diagnostics,
+	respModel, httpResp, err := common.ExecuteWithRetryAndAuth(ctx, r.kc, &resp.Diagnostics, common.ServiceBCS,
 		func() (*bcs.BcsInstanceV1ApiCreateKeypairModelResponseKeypairModel, *http.Response, error) {
 			return r.kc.ApiClient.KeypairAPI.CreateKeypair(ctx).
 				XAuthToken(r.kc.XAuthToken).
@@ -116,7 +119,25 @@ func (r *keypairResource) Create(ctx context.Context, req resource.CreateRequest
 		return
 	}
 
-	plan.PrivateKey = ConvertNullableString(respModel.Keypair.PrivateKey)
+	if importPublicKey {
+		if !sameKeypairPublicKey(configuredPublicKey, plan.PublicKey) {
+			common.AddGeneralError(ctx, r, &resp.Diagnostics,
+				fmt.Sprintf("The public key registered for keypair '%s' does not match the configured public_key.", plan.Name.ValueString()))
+			// The keypair was created, so delete it, or keep it in state when that fails so that it is
+			// not left behind untracked.
+			var deleteDiags diag.Diagnostics
+			if !r.deleteKeypair(ctx, plan.Name.ValueString(), &deleteDiags) {
+				resp.Diagnostics.Append(deleteDiags...)
+				resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+			}
+			return
+		}
+		plan.PublicKey = configuredPublicKey
+		plan.PrivateKey = types.StringNull()
+	} else {
+		plan.PrivateKey = ConvertNullableString(respModel.Keypair.PrivateKey)
+	}
+
 	diags = resp.State.Set(ctx, plan)
 	resp.Diagnostics.Append(diags...)
 }
@@ -129,7 +150,7 @@ func (r *keypairResource) Read(ctx context.Context, req resource.ReadRequest, re
 		return
 	}
 
-	respModel, httpResp, err := common.ExecuteWithRetryAndAuth(ctx, r.kc, &resp.Diagnostics,
+	respModel, httpResp, err := common.ExecuteWithRetryAndAuth(ctx, r.kc, &resp.Diagnostics, common.ServiceBCS,
 		func() (*bcs.BcsInstanceV1ApiGetKeypairModelResponseKeypairModel, *http.Response, error) {
 			return r.kc.ApiClient.KeypairAPI.GetKeypair(ctx, state.Name.ValueString()).
 				XAuthToken(r.kc.XAuthToken).
@@ -146,12 +167,17 @@ func (r *keypairResource) Read(ctx context.Context, req resource.ReadRequest, re
 		return
 	}
 
+	priorPublicKey := state.PublicKey
 	result := respModel.Keypair
 	ok := r.mapKeypair(ctx, &state, &result, &resp.Diagnostics)
 	if !ok || resp.Diagnostics.HasError() {
 		return
 	}
 
+	if sameKeypairPublicKey(priorPublicKey, state.PublicKey) {
+		state.PublicKey = priorPublicKey
+	}
+
 	diags = resp.State.Set(ctx, &state)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
@@ -181,24 +207,29 @@ func (r *keypairResource) Delete(ctx context.Context, req resource.DeleteRequest
 	ctx, cancel := context.WithTimeout(ctx, timeout)
 	defer cancel()
 
-	_, httpResp, err := common.ExecuteWithRetryAndAuth(ctx, r.kc, &resp.Diagnostics,
+	r.deleteKeypair(ctx, state.Name.ValueString(), &resp.Diagnostics)
+}
+
+// deleteKeypair deletes the keypair and waits until it is gone.
+func (r *keypairResource) deleteKeypair(ctx context.Context, name string, respDiags *diag.Diagnostics) bool {
+	_, httpResp, err := common.ExecuteWithRetryAndAuth(ctx, r.kc, respDiags, common.ServiceBCS,
 		func() (interface{}, *http.Response, error) {
-			httpResp, err := r.kc.ApiClient.KeypairAPI.DeleteKeypair(ctx, state.Name.ValueString()).
+			httpResp, err := r.kc.ApiClient.KeypairAPI.DeleteKeypair(ctx, name).
 				XAuthToken(r.kc.XAuthToken).
 				Execute()
 			return nil, httpResp, err
 		},
 	)
 	if err != nil {
-		common.AddApiActionError(ctx, r, httpResp, "DeleteKeypair", err, &resp.Diagnostics)
-		return
+		common.AddApiActionError(ctx, r, httpResp, "DeleteKeypair", err, respDiags)
+		return false
 	}
 
-	common.PollUntilDeletion(ctx, r, 2*time.Second, &resp.Diagnostics, func(ctx context.Context) (bool, *http.Response, error) {
-		_, httpResp, err := common.ExecuteWithRetryAndAuth(ctx, r.kc, &resp.Diagnostics,
+	common.PollUntilDeletion(ctx, r, 2*time.Second, respDiags, func(ctx context.Context) (bool, *http.Response, error) {
+		_, httpResp, err := common.ExecuteWithRetryAndAuth(ctx, r.kc, respDiags, common.ServiceBCS,
 			func() (*bcs.BcsInstanceV1ApiGetKeypairModelResponseKeypairModel, *http.Response, error) {
 				_, httpResp, err := r.kc.ApiClient.KeypairAPI.
-					GetKeypair(ctx, state.Name.ValueString()).
+					GetKeypair(ctx, name).
 					XAuthToken(r.kc.XAuthToken).
 					Execute()
 				return nil, httpResp, err
@@ -206,6 +237,7 @@ func (r *keypairResource) Delete(ctx context.Context, req resource.DeleteRequest
 		)
 		return false, httpResp, err
 	})
+	return !respDiags.HasError()
 }
 
 func (r *keypairResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
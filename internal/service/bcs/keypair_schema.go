@@ -32,8 +32,9 @@ var keypairResourceSchemaAttributes = map[string]rschema.Attribute{
 		},
 	},
 	"public_key": rschema.StringAttribute{
-		Optional: true,
-		Computed: true,
+		Optional:   true,
+		Computed:   true,
+		Validators: keypairPublicKeyValidator(),
 		PlanModifiers: []planmodifier.String{
 			stringplanmodifier.RequiresReplace(),
 		},
@@ -130,9 +130,12 @@ func (d *keypairsDataSource) Read(ctx context.Context, req datasource.ReadReques
 		return
 	}
 
-	keypairResp, httpResp, err := common.ExecuteWithRetryAndAuth(ctx, d.kc, &resp.Diagnostics,
-		func() (*bcs.KeypairListModel, *http.Response, error) {
-			return keypairApi.Limit(1000).XAuthToken(d.kc.XAuthToken).Execute()
+	keypairPages, httpResp, err := common.ListAllPagesPartial(ctx, d.kc, d, &resp.Diagnostics, common.ServiceBCS,
+		func(limit int32, offset int32) (*bcs.KeypairListModel, *http.Response, error) {
+			return keypairApi.Limit(limit).Offset(offset).XAuthToken(d.kc.XAuthToken).Execute()
+		},
+		func(page *bcs.KeypairListModel) int {
+			return len(page.Keypairs)
 		},
 	)
 	if err != nil {
@@ -141,11 +144,15 @@ func (d *keypairsDataSource) Read(ctx context.Context, req datasource.ReadReques
 	}
 
 	var keypairResult []bcs.BcsInstanceV1ApiGetKeypairModelKeypairModel
-	err = copier.Copy(&keypairResult, &keypairResp.Keypairs)
-	if err != nil {
-		common.AddGeneralError(ctx, d, &resp.Diagnostics,
-			fmt.Sprintf("Failed to convert keypairResult: %v", err))
-		return
+	for _, keypairResp := range keypairPages {
+		var pageResult []bcs.BcsInstanceV1ApiGetKeypairModelKeypairModel
+		err = copier.Copy(&pageResult, &keypairResp.Keypairs)
+		if err != nil {
+			common.AddGeneralError(ctx, d, &resp.Diagnostics,
+				fmt.Sprintf("Failed to convert keypairResult: %v", err))
+			return
+		}
+		keypairResult = append(keypairResult, pageResult...)
 	}
 
 	for _, v := range keypairResult {
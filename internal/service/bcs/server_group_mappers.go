@@ -0,0 +1,34 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+package bcs
+
+import (
+	"context"
+	. "terraform-provider-kakaocloud/internal/utils"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/kakaoenterprise/kc-sdk-go/services/bcs"
+)
+
+func mapServerGroupModel(
+	ctx context.Context,
+	model *serverGroupResourceModel,
+	serverGroupResult *bcs.BcsInstanceV1ApiGetServerGroupModelServerGroupModel,
+	respDiags *diag.Diagnostics,
+) bool {
+	model.Id = types.StringValue(serverGroupResult.Id)
+	model.Name = ConvertNullableString(serverGroupResult.Name)
+	model.Policy = ConvertNullableString(serverGroupResult.Policy)
+	model.ProjectId = ConvertNullableString(serverGroupResult.ProjectId)
+	model.CreatedAt = ConvertNullableTime(serverGroupResult.CreatedAt)
+
+	members, diags := SetFromStrings(ctx, append([]string{}, serverGroupResult.Members...))
+	respDiags.Append(diags...)
+	model.Members = members
+
+	if respDiags.HasError() {
+		return false
+	}
+	return true
+}
@@ -0,0 +1,18 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+package bcs
+
+import (
+	resourceTimeouts "github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type serverGroupResourceModel struct {
+	Id        types.String           `tfsdk:"id"`
+	Name      types.String           `tfsdk:"name"`
+	Policy    types.String           `tfsdk:"policy"`
+	Members   types.Set              `tfsdk:"members"`
+	ProjectId types.String           `tfsdk:"project_id"`
+	CreatedAt types.String           `tfsdk:"created_at"`
+	Timeouts  resourceTimeouts.Value `tfsdk:"timeouts"`
+}
@@ -0,0 +1,257 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+package bcs
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"terraform-provider-kakaocloud/internal/common"
+	. "terraform-provider-kakaocloud/internal/utils"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/kakaoenterprise/kc-sdk-go/services/bcs"
+)
+
+var (
+	_ resource.Resource                = &serverGroupResource{}
+	_ resource.ResourceWithConfigure   = &serverGroupResource{}
+	_ resource.ResourceWithImportState = &serverGroupResource{}
+)
+
+func NewServerGroupResource() resource.Resource { return &serverGroupResource{} }
+
+type serverGroupResource struct {
+	kc *common.KakaoCloudClient
+}
+
+func (r *serverGroupResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_server_group"
+}
+
+func (r *serverGroupResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: MergeResourceSchemaAttributes(
+			serverGroupResourceSchemaAttributes,
+			map[string]schema.Attribute{
+				"timeouts": timeouts.AttributesAll(ctx),
+			},
+		),
+	}
+}
+
+func (r *serverGroupResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan serverGroupResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	timeout, diags := plan.Timeouts.Create(ctx, common.DefaultCreateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	body := bcs.BodyCreateServerGroup{
+		ServerGroup: bcs.CreateServerGroupModel{
+			Name:   plan.Name.ValueString(),
+			Policy: plan.Policy.ValueString(),
+		},
+	}
+
+	respModel, httpResp, err := common.ExecuteWithRetryAndAuth(ctx, r.kc, &resp.Diagnostics, common.ServiceBCS,
+		func() (*bcs.BcsInstanceV1ApiCreateServerGroupModelResponseServerGroupModel, *http.Response, error) {
+			return r.kc.ApiClient.ServerGroupAPI.CreateServerGroup(ctx).
+				XAuthToken(r.kc.XAuthToken).
+				BodyCreateServerGroup(body).
+				Execute()
+		},
+	)
+	if err != nil {
+		common.AddApiActionError(ctx, r, httpResp, "CreateServerGroup", err, &resp.Diagnostics)
+		return
+	}
+
+	result, ok := r.getServerGroup(ctx, respModel.ServerGroup.Id, &resp.Diagnostics)
+	if !ok {
+		return
+	}
+
+	ok = mapServerGroupModel(ctx, &plan, result, &resp.Diagnostics)
+	if !ok || resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *serverGroupResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state serverGroupResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	timeout, diags := state.Timeouts.Read(ctx, common.DefaultReadTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	respModel, httpResp, err := common.ExecuteWithRetryAndAuth(ctx, r.kc, &resp.Diagnostics, common.ServiceBCS,
+		func() (*bcs.BcsInstanceV1ApiGetServerGroupModelResponseServerGroupModel, *http.Response, error) {
+			return r.kc.ApiClient.ServerGroupAPI.GetServerGroup(ctx, state.Id.ValueString()).
+				XAuthToken(r.kc.XAuthToken).
+				Execute()
+		},
+	)
+	if httpResp != nil && httpResp.StatusCode == http.StatusNotFound {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		common.AddApiActionError(ctx, r, httpResp, "GetServerGroup", err, &resp.Diagnostics)
+		return
+	}
+
+	ok := mapServerGroupModel(ctx, &state, &respModel.ServerGroup, &resp.Diagnostics)
+	if !ok || resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Update only changes timeouts, as name and policy force a new server group. The group is read
+// again so that members, which change as instances join and leave, is known.
+func (r *serverGroupResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan serverGroupResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	timeout, diags := plan.Timeouts.Update(ctx, common.DefaultUpdateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	result, ok := r.getServerGroup(ctx, plan.Id.ValueString(), &resp.Diagnostics)
+	if !ok {
+		return
+	}
+
+	ok = mapServerGroupModel(ctx, &plan, result, &resp.Diagnostics)
+	if !ok || resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.State.Set(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *serverGroupResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state serverGroupResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	timeout, diags := state.Timeouts.Delete(ctx, common.DefaultDeleteTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	_, httpResp, err := common.ExecuteWithRetryAndAuth(ctx, r.kc, &resp.Diagnostics, common.ServiceBCS,
+		func() (interface{}, *http.Response, error) {
+			httpResp, err := r.kc.ApiClient.ServerGroupAPI.DeleteServerGroup(ctx, state.Id.ValueString()).
+				XAuthToken(r.kc.XAuthToken).
+				Execute()
+			return nil, httpResp, err
+		},
+	)
+	if err != nil {
+		if httpResp != nil && httpResp.StatusCode == http.StatusNotFound {
+			return
+		}
+		common.AddApiActionError(ctx, r, httpResp, "DeleteServerGroup", err, &resp.Diagnostics)
+		return
+	}
+
+	common.PollUntilDeletion(ctx, r, 2*time.Second, &resp.Diagnostics, func(ctx context.Context) (bool, *http.Response, error) {
+		_, httpResp, err := common.ExecuteWithRetryAndAuth(ctx, r.kc, &resp.Diagnostics, common.ServiceBCS,
+			func() (*bcs.BcsInstanceV1ApiGetServerGroupModelResponseServerGroupModel, *http.Response, error) {
+				_, httpResp, err := r.kc.ApiClient.ServerGroupAPI.
+					GetServerGroup(ctx, state.Id.ValueString()).
+					XAuthToken(r.kc.XAuthToken).
+					Execute()
+				return nil, httpResp, err
+			},
+		)
+		return false, httpResp, err
+	})
+}
+
+func (r *serverGroupResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	client, ok := req.ProviderData.(*common.KakaoCloudClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *common.KakaoCloudClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+	r.kc = client
+}
+
+func (r *serverGroupResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+func (r *serverGroupResource) getServerGroup(
+	ctx context.Context,
+	serverGroupId string,
+	respDiags *diag.Diagnostics,
+) (*bcs.BcsInstanceV1ApiGetServerGroupModelServerGroupModel, bool) {
+	respModel, httpResp, err := common.ExecuteWithRetryAndAuth(ctx, r.kc, respDiags, common.ServiceBCS,
+		func() (*bcs.BcsInstanceV1ApiGetServerGroupModelResponseServerGroupModel, *http.Response, error) {
+			return r.kc.ApiClient.ServerGroupAPI.GetServerGroup(ctx, serverGroupId).
+				XAuthToken(r.kc.XAuthToken).
+				Execute()
+		},
+	)
+	if err != nil {
+		common.AddApiActionError(ctx, r, httpResp, "GetServerGroup", err, respDiags)
+		return nil, false
+	}
+	return &respModel.ServerGroup, true
+}
@@ -0,0 +1,85 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+package bcs_test
+
+import (
+	"fmt"
+	"terraform-provider-kakaocloud/internal/acctest"
+	"terraform-provider-kakaocloud/internal/acctest/mockserver"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+func testAccServerGroupCollection(server *mockserver.Server) *mockserver.Collection {
+	return server.Register(&mockserver.Collection{
+		Service: "bcs",
+		Name:    "server-groups",
+		Key:     "server_group",
+		ListKey: "server_groups",
+		OnCreate: func(obj map[string]any) {
+			obj["members"] = []any{}
+		},
+	})
+}
+
+func TestAccServerGroupResource_updateTimeouts(t *testing.T) {
+	server := acctest.NewMockServer(t)
+	serverGroups := testAccServerGroupCollection(server)
+	resourceName := "kakaocloud_server_group.test"
+	memberId := "0b7d3c1e-2f4a-4c5b-8d6e-7f8091a2b3c4"
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		CheckDestroy: func(s *terraform.State) error {
+			if n := serverGroups.Len(); n != 0 {
+				return fmt.Errorf("expected all server groups to be destroyed, %d left", n)
+			}
+			return nil
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: acctest.ProviderConfig(server, "") + testAccServerGroupResourceConfig(""),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "policy", "anti-affinity"),
+					resource.TestCheckResourceAttr(resourceName, "members.#", "0"),
+				),
+			},
+			{
+				// An instance joins the group outside of Terraform, then only timeouts change.
+				PreConfig: func() {
+					for _, id := range serverGroups.SortedIds() {
+						obj, _ := serverGroups.Get(id)
+						updated := make(map[string]any, len(obj))
+						for k, v := range obj {
+							updated[k] = v
+						}
+						updated["members"] = []any{memberId}
+						serverGroups.Put(id, updated)
+					}
+				},
+				Config: acctest.ProviderConfig(server, "") + testAccServerGroupResourceConfig(`
+  timeouts = {
+    delete = "10m"
+  }
+`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "members.#", "1"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "members.*", memberId),
+					resource.TestCheckResourceAttr(resourceName, "timeouts.delete", "10m"),
+				),
+			},
+		},
+	})
+}
+
+func testAccServerGroupResourceConfig(extra string) string {
+	return fmt.Sprintf(`
+resource "kakaocloud_server_group" "test" {
+  name   = "acc-server-group"
+  policy = "anti-affinity"
+%s
+}
+`, extra)
+}
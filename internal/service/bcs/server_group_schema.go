@@ -0,0 +1,67 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+package bcs
+
+import (
+	"terraform-provider-kakaocloud/internal/common"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	rschema "github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+const (
+	ServerGroupPolicyAffinity         = "affinity"
+	ServerGroupPolicyAntiAffinity     = "anti-affinity"
+	ServerGroupPolicySoftAffinity     = "soft-affinity"
+	ServerGroupPolicySoftAntiAffinity = "soft-anti-affinity"
+)
+
+var serverGroupResourceSchemaAttributes = map[string]rschema.Attribute{
+	"id": rschema.StringAttribute{
+		Computed: true,
+		PlanModifiers: []planmodifier.String{
+			stringplanmodifier.UseStateForUnknown(),
+		},
+	},
+	"name": rschema.StringAttribute{
+		Required:   true,
+		Validators: common.NameValidator(250),
+		PlanModifiers: []planmodifier.String{
+			stringplanmodifier.RequiresReplace(),
+		},
+	},
+	"policy": rschema.StringAttribute{
+		Required: true,
+		Validators: []validator.String{
+			stringvalidator.OneOf(
+				ServerGroupPolicyAffinity,
+				ServerGroupPolicyAntiAffinity,
+				ServerGroupPolicySoftAffinity,
+				ServerGroupPolicySoftAntiAffinity,
+			),
+		},
+		PlanModifiers: []planmodifier.String{
+			stringplanmodifier.RequiresReplace(),
+		},
+	},
+	"members": rschema.SetAttribute{
+		Computed:    true,
+		ElementType: types.StringType,
+	},
+	"project_id": rschema.StringAttribute{
+		Computed: true,
+		PlanModifiers: []planmodifier.String{
+			stringplanmodifier.UseStateForUnknown(),
+		},
+	},
+	"created_at": rschema.StringAttribute{
+		Computed: true,
+		PlanModifiers: []planmodifier.String{
+			stringplanmodifier.UseStateForUnknown(),
+		},
+	},
+}
@@ -0,0 +1,17 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+package bcs
+
+import (
+	resourceTimeouts "github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type volumeAttachmentResourceModel struct {
+	Id                    types.String           `tfsdk:"id"`
+	InstanceId            types.String           `tfsdk:"instance_id"`
+	VolumeId              types.String           `tfsdk:"volume_id"`
+	IsDeleteOnTermination types.Bool             `tfsdk:"is_delete_on_termination"`
+	MountPoint            types.String           `tfsdk:"mount_point"`
+	Timeouts              resourceTimeouts.Value `tfsdk:"timeouts"`
+}
@@ -0,0 +1,275 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+package bcs
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+	"terraform-provider-kakaocloud/internal/common"
+	. "terraform-provider-kakaocloud/internal/utils"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/kakaoenterprise/kc-sdk-go/services/bcs"
+)
+
+var (
+	_ resource.ResourceWithConfigure   = &volumeAttachmentResource{}
+	_ resource.ResourceWithImportState = &volumeAttachmentResource{}
+)
+
+func NewVolumeAttachmentResource() resource.Resource { return &volumeAttachmentResource{} }
+
+type volumeAttachmentResource struct {
+	kc *common.KakaoCloudClient
+}
+
+func (r *volumeAttachmentResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_volume_attachment"
+}
+
+func (r *volumeAttachmentResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: MergeResourceSchemaAttributes(
+			volumeAttachmentResourceSchemaAttributes,
+			map[string]schema.Attribute{
+				"timeouts": timeouts.AttributesAll(ctx),
+			},
+		),
+	}
+}
+
+func (r *volumeAttachmentResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan volumeAttachmentResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	timeout, diags := plan.Timeouts.Create(ctx, common.DefaultCreateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	instanceId := plan.InstanceId.ValueString()
+	volumeId := plan.VolumeId.ValueString()
+
+	mutex := common.LockForID(instanceId)
+	mutex.Lock()
+	defer mutex.Unlock()
+
+	ok := attachInstanceVolume(ctx, r.kc, r, instanceId, volumeId, plan.IsDeleteOnTermination.ValueBool(), &resp.Diagnostics)
+	if !ok || resp.Diagnostics.HasError() {
+		return
+	}
+
+	plan.Id = types.StringValue(fmt.Sprintf("%s/%s", instanceId, volumeId))
+
+	attached, found := r.findAttachedVolume(ctx, instanceId, volumeId, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if !found {
+		common.AddGeneralError(ctx, r, &resp.Diagnostics,
+			fmt.Sprintf("Volume %s is not attached to instance %s", volumeId, instanceId))
+		return
+	}
+	r.mapVolumeAttachment(&plan, attached)
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *volumeAttachmentResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state volumeAttachmentResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	timeout, diags := state.Timeouts.Read(ctx, common.DefaultReadTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	attached, found := r.findAttachedVolume(ctx, state.InstanceId.ValueString(), state.VolumeId.ValueString(), &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if !found {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	r.mapVolumeAttachment(&state, attached)
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *volumeAttachmentResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state volumeAttachmentResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	timeout, diags := plan.Timeouts.Update(ctx, common.DefaultUpdateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	instanceId := plan.InstanceId.ValueString()
+	volumeId := plan.VolumeId.ValueString()
+
+	if !plan.IsDeleteOnTermination.Equal(state.IsDeleteOnTermination) {
+		ok := updateInstanceVolumeDeleteOnTermination(ctx, r.kc, r, instanceId, volumeId, plan.IsDeleteOnTermination.ValueBool(), &resp.Diagnostics)
+		if !ok || resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	attached, found := r.findAttachedVolume(ctx, instanceId, volumeId, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if !found {
+		common.AddGeneralError(ctx, r, &resp.Diagnostics,
+			fmt.Sprintf("Volume %s is not attached to instance %s", volumeId, instanceId))
+		return
+	}
+	r.mapVolumeAttachment(&plan, attached)
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *volumeAttachmentResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state volumeAttachmentResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	timeout, diags := state.Timeouts.Delete(ctx, common.DefaultDeleteTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	instanceId := state.InstanceId.ValueString()
+	volumeId := state.VolumeId.ValueString()
+
+	mutex := common.LockForID(instanceId)
+	mutex.Lock()
+	defer mutex.Unlock()
+
+	_, found := r.findAttachedVolume(ctx, instanceId, volumeId, &resp.Diagnostics)
+	if !found || resp.Diagnostics.HasError() {
+		return
+	}
+
+	detachInstanceVolume(ctx, r.kc, r, instanceId, volumeId, &resp.Diagnostics)
+}
+
+func (r *volumeAttachmentResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*common.KakaoCloudClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *common.KakaoCloudClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.kc = client
+}
+
+func (r *volumeAttachmentResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	parts := strings.Split(req.ID, "/")
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		common.AddImportFormatError(ctx, r, &resp.Diagnostics, "Expected import ID in the format: instance_id/volume_id")
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("instance_id"), parts[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("volume_id"), parts[1])...)
+}
+
+// findAttachedVolume returns the volume as attached to the instance. A missing instance is
+// reported the same way as a volume that is no longer attached.
+func (r *volumeAttachmentResource) findAttachedVolume(
+	ctx context.Context,
+	instanceId string,
+	volumeId string,
+	respDiags *diag.Diagnostics,
+) (*bcs.BcsInstanceV1ApiGetInstanceModelInstanceAttachedVolumeModel, bool) {
+	respModel, httpResp, err := common.ExecuteWithRetryAndAuth(ctx, r.kc, respDiags, common.ServiceBCS,
+		func() (*bcs.ResponseInstanceModel, *http.Response, error) {
+			return r.kc.ApiClient.InstanceAPI.
+				GetInstance(ctx, instanceId).
+				XAuthToken(r.kc.XAuthToken).
+				Execute()
+		},
+	)
+	if common.IsNotFound(err) {
+		return nil, false
+	}
+	if err != nil {
+		common.AddApiActionError(ctx, r, httpResp, "GetInstance", err, respDiags)
+		return nil, false
+	}
+
+	for i := range respModel.Instance.AttachedVolumes {
+		if respModel.Instance.AttachedVolumes[i].Id == volumeId {
+			return &respModel.Instance.AttachedVolumes[i], true
+		}
+	}
+	return nil, false
+}
+
+func (r *volumeAttachmentResource) mapVolumeAttachment(
+	model *volumeAttachmentResourceModel,
+	attached *bcs.BcsInstanceV1ApiGetInstanceModelInstanceAttachedVolumeModel,
+) {
+	model.Id = types.StringValue(fmt.Sprintf("%s/%s", model.InstanceId.ValueString(), model.VolumeId.ValueString()))
+	model.MountPoint = ConvertNullableString(attached.MountPoint)
+	if isDeleteOnTermination := ConvertNullableBool(attached.IsDeleteOnTermination); !isDeleteOnTermination.IsNull() {
+		model.IsDeleteOnTermination = isDeleteOnTermination
+	}
+}
@@ -0,0 +1,140 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+package bcs_test
+
+import (
+	"fmt"
+	"terraform-provider-kakaocloud/internal/acctest"
+	"terraform-provider-kakaocloud/internal/acctest/mockserver"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+const (
+	testAccVolumeAttachmentInstanceId = "6f1c2d3e-4a5b-4c6d-8e7f-9a0b1c2d3e4f"
+	testAccVolumeAttachmentVolumeId   = "1a2b3c4d-5e6f-4a7b-8c9d-0e1f2a3b4c5d"
+	testAccVolumeAttachmentVolumeId2  = "2b3c4d5e-6f7a-4b8c-9d0e-1f2a3b4c5d6e"
+)
+
+func TestAccVolumeAttachmentResource_basic(t *testing.T) {
+	server := acctest.NewMockServer(t)
+	instances := server.Collection("bcs", "instances")
+	instances.Put(testAccVolumeAttachmentInstanceId, map[string]any{
+		"id":               testAccVolumeAttachmentInstanceId,
+		"name":             "acc-instance",
+		"status":           "active",
+		"attached_volumes": []any{},
+	})
+	resourceName := "kakaocloud_volume_attachment.test"
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckVolumeAttachmentsDetached(instances),
+		Steps: []resource.TestStep{
+			{
+				Config: acctest.ProviderConfig(server, "") + testAccVolumeAttachmentResourceConfig(false),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "id",
+						testAccVolumeAttachmentInstanceId+"/"+testAccVolumeAttachmentVolumeId),
+					resource.TestCheckResourceAttr(resourceName, "mount_point", "/dev/vdb"),
+					resource.TestCheckResourceAttr(resourceName, "is_delete_on_termination", "false"),
+				),
+			},
+			{
+				Config: acctest.ProviderConfig(server, "") + testAccVolumeAttachmentResourceConfig(true),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "mount_point", "/dev/vdb"),
+					resource.TestCheckResourceAttr(resourceName, "is_delete_on_termination", "true"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"timeouts"},
+			},
+			{
+				// The volume is detached outside of Terraform, so the attachment is planned again.
+				PreConfig: func() {
+					obj, _ := instances.Get(testAccVolumeAttachmentInstanceId)
+					updated := make(map[string]any, len(obj))
+					for k, v := range obj {
+						updated[k] = v
+					}
+					updated["attached_volumes"] = []any{}
+					instances.Put(testAccVolumeAttachmentInstanceId, updated)
+				},
+				Config: acctest.ProviderConfig(server, "") + testAccVolumeAttachmentResourceConfig(true),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "mount_point", "/dev/vdb"),
+					resource.TestCheckResourceAttr(resourceName, "is_delete_on_termination", "true"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccVolumeAttachmentResource_sameInstance(t *testing.T) {
+	server := acctest.NewMockServer(t)
+	instances := server.Collection("bcs", "instances")
+	instances.Put(testAccVolumeAttachmentInstanceId, map[string]any{
+		"id":               testAccVolumeAttachmentInstanceId,
+		"name":             "acc-instance",
+		"status":           "active",
+		"attached_volumes": []any{},
+	})
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckVolumeAttachmentsDetached(instances),
+		Steps: []resource.TestStep{
+			{
+				Config: acctest.ProviderConfig(server, "") + fmt.Sprintf(`
+resource "kakaocloud_volume_attachment" "first" {
+  instance_id = %[1]q
+  volume_id   = %[2]q
+}
+
+resource "kakaocloud_volume_attachment" "second" {
+  instance_id = %[1]q
+  volume_id   = %[3]q
+}
+`, testAccVolumeAttachmentInstanceId, testAccVolumeAttachmentVolumeId, testAccVolumeAttachmentVolumeId2),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("kakaocloud_volume_attachment.first", "mount_point"),
+					resource.TestCheckResourceAttrSet("kakaocloud_volume_attachment.second", "mount_point"),
+					func(s *terraform.State) error {
+						first := s.RootModule().Resources["kakaocloud_volume_attachment.first"].Primary.Attributes["mount_point"]
+						second := s.RootModule().Resources["kakaocloud_volume_attachment.second"].Primary.Attributes["mount_point"]
+						if first == second {
+							return fmt.Errorf("both volumes were given mount point %s", first)
+						}
+						return nil
+					},
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckVolumeAttachmentsDetached(instances *mockserver.Collection) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		obj, _ := instances.Get(testAccVolumeAttachmentInstanceId)
+		if attached, _ := obj["attached_volumes"].([]any); len(attached) != 0 {
+			return fmt.Errorf("expected all volumes to be detached, %d left", len(attached))
+		}
+		return nil
+	}
+}
+
+func testAccVolumeAttachmentResourceConfig(isDeleteOnTermination bool) string {
+	return fmt.Sprintf(`
+resource "kakaocloud_volume_attachment" "test" {
+  instance_id              = %q
+  volume_id                = %q
+  is_delete_on_termination = %t
+}
+`, testAccVolumeAttachmentInstanceId, testAccVolumeAttachmentVolumeId, isDeleteOnTermination)
+}
@@ -61,7 +61,7 @@ func (d *projectDataSource) Read(ctx context.Context, req datasource.ReadRequest
 	ctx, cancel := context.WithTimeout(ctx, timeout)
 	defer cancel()
 
-	projectResp, httpResp, err := common.ExecuteWithRetryAndAuth(ctx, d.kc, &resp.Diagnostics,
+	projectResp, httpResp, err := common.ExecuteWithRetryAndAuth(ctx, d.kc, &resp.Diagnostics, common.ServiceIAM,
 		func() (*iam.TokenResponse, *http.Response, error) {
 			return d.kc.ApiClient.IdentityAPI.ValidateToken(ctx).
 				XAuthToken(d.kc.XAuthToken).
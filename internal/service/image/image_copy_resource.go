@@ -0,0 +1,309 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+package image
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+	"terraform-provider-kakaocloud/internal/common"
+	. "terraform-provider-kakaocloud/internal/utils"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/kakaoenterprise/kc-sdk-go/services/image"
+)
+
+var (
+	_ resource.ResourceWithConfigure   = &imageCopyResource{}
+	_ resource.ResourceWithImportState = &imageCopyResource{}
+)
+
+func NewImageCopyResource() resource.Resource {
+	return &imageCopyResource{}
+}
+
+type imageCopyResource struct {
+	kc *common.KakaoCloudClient
+}
+
+func (r *imageCopyResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_image_copy"
+}
+
+func (r *imageCopyResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: MergeResourceSchemaAttributes(
+			imageCopyResourceSchemaAttributes,
+			map[string]schema.Attribute{
+				"timeouts": timeouts.AttributesAll(ctx),
+			},
+		),
+	}
+}
+
+func (r *imageCopyResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan imageCopyResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	timeout, diags := plan.Timeouts.Create(ctx, common.LongCreateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	destKc, ok := r.destinationClient(ctx, plan.DestinationRegion.ValueString(), &resp.Diagnostics)
+	if !ok {
+		return
+	}
+
+	copyReq := image.CopyImageModel{
+		DestinationRegion: plan.DestinationRegion.ValueString(),
+	}
+	if !plan.Name.IsNull() && !plan.Name.IsUnknown() {
+		copyReq.SetName(plan.Name.ValueString())
+	}
+	if !plan.Description.IsNull() && !plan.Description.IsUnknown() {
+		copyReq.SetDescription(plan.Description.ValueString())
+	}
+	body := *image.NewBodyCopyImage(copyReq)
+
+	respModel, httpResp, err := common.ExecuteWithRetryAndAuth(ctx, r.kc, &resp.Diagnostics, common.ServiceImage,
+		func() (*image.BcsImageV1ApiCopyImageModelResponseImageModel, *http.Response, error) {
+			return r.kc.ApiClient.ImageAPI.
+				CopyImage(ctx, plan.SourceImageId.ValueString()).
+				XAuthToken(r.kc.XAuthToken).
+				BodyCopyImage(body).
+				Execute()
+		},
+	)
+	if err != nil {
+		common.AddApiActionError(ctx, r, httpResp, "CopyImage", err, &resp.Diagnostics)
+		return
+	}
+
+	plan.Id = types.StringValue(respModel.Image.Id)
+
+	// Save the copy right away, so that a copy which fails to become active is still destroyed.
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), plan.Id)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("source_image_id"), plan.SourceImageId)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("destination_region"), plan.DestinationRegion)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	result, ok := pollImageUntilStatus(
+		ctx,
+		destKc,
+		r,
+		plan.Id.ValueString(),
+		[]string{common.ImageStatusActive, common.ImageStatusError, common.ImageStatusKilled},
+		&resp.Diagnostics,
+	)
+	if !ok || resp.Diagnostics.HasError() {
+		return
+	}
+
+	common.CheckResourceAvailableStatus(ctx, r, result.Status.Get(), []string{common.ImageStatusActive}, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	mapImageCopyModel(&plan, result)
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *imageCopyResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state imageCopyResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	timeout, diags := state.Timeouts.Read(ctx, common.DefaultReadTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	destKc, ok := r.destinationClient(ctx, state.DestinationRegion.ValueString(), &resp.Diagnostics)
+	if !ok {
+		return
+	}
+
+	respModel, httpResp, err := common.ExecuteWithRetryAndAuth(ctx, destKc, &resp.Diagnostics, common.ServiceImage,
+		func() (*image.BcsImageV1ApiGetImageModelResponseImageModel, *http.Response, error) {
+			return destKc.ApiClient.ImageAPI.
+				GetImage(ctx, state.Id.ValueString()).
+				XAuthToken(destKc.XAuthToken).
+				Execute()
+		},
+	)
+	if httpResp != nil && httpResp.StatusCode == http.StatusNotFound {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		common.AddApiActionError(ctx, r, httpResp, "GetImage", err, &resp.Diagnostics)
+		return
+	}
+
+	mapImageCopyModel(&state, &respModel.Image)
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Update only changes timeouts, as every other argument forces a new copy. The copy is read
+// again so that no computed attribute is left unknown.
+func (r *imageCopyResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan imageCopyResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	timeout, diags := plan.Timeouts.Update(ctx, common.DefaultUpdateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	destKc, ok := r.destinationClient(ctx, plan.DestinationRegion.ValueString(), &resp.Diagnostics)
+	if !ok {
+		return
+	}
+
+	respModel, httpResp, err := common.ExecuteWithRetryAndAuth(ctx, destKc, &resp.Diagnostics, common.ServiceImage,
+		func() (*image.BcsImageV1ApiGetImageModelResponseImageModel, *http.Response, error) {
+			return destKc.ApiClient.ImageAPI.
+				GetImage(ctx, plan.Id.ValueString()).
+				XAuthToken(destKc.XAuthToken).
+				Execute()
+		},
+	)
+	if err != nil {
+		common.AddApiActionError(ctx, r, httpResp, "GetImage", err, &resp.Diagnostics)
+		return
+	}
+
+	mapImageCopyModel(&plan, &respModel.Image)
+
+	diags = resp.State.Set(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *imageCopyResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state imageCopyResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	timeout, diags := state.Timeouts.Delete(ctx, common.DefaultDeleteTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	destKc, ok := r.destinationClient(ctx, state.DestinationRegion.ValueString(), &resp.Diagnostics)
+	if !ok {
+		return
+	}
+
+	_, httpResp, err := common.ExecuteWithRetryAndAuth(ctx, destKc, &resp.Diagnostics, common.ServiceImage,
+		func() (interface{}, *http.Response, error) {
+			httpResp, err := destKc.ApiClient.ImageAPI.
+				DeleteImage(ctx, state.Id.ValueString()).
+				XAuthToken(destKc.XAuthToken).
+				Execute()
+			return nil, httpResp, err
+		},
+	)
+	if err != nil {
+		if httpResp != nil && httpResp.StatusCode == http.StatusNotFound {
+			return
+		}
+		common.AddApiActionError(ctx, r, httpResp, "DeleteImage", err, &resp.Diagnostics)
+		return
+	}
+
+	common.PollUntilDeletion(ctx, r, 2*time.Second, &resp.Diagnostics, func(ctx context.Context) (bool, *http.Response, error) {
+		_, httpResp, err := common.ExecuteWithRetryAndAuth(ctx, destKc, &resp.Diagnostics, common.ServiceImage,
+			func() (*image.BcsImageV1ApiGetImageModelResponseImageModel, *http.Response, error) {
+				_, httpResp, err := destKc.ApiClient.ImageAPI.
+					GetImage(ctx, state.Id.ValueString()).
+					XAuthToken(destKc.XAuthToken).
+					Execute()
+				return nil, httpResp, err
+			},
+		)
+		return false, httpResp, err
+	})
+}
+
+func (r *imageCopyResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*common.KakaoCloudClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *common.KakaoCloudClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.kc = client
+}
+
+func (r *imageCopyResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	parts := strings.Split(req.ID, "/")
+	if len(parts) != 3 || parts[0] == "" || parts[1] == "" || parts[2] == "" {
+		common.AddImportFormatError(ctx, r, &resp.Diagnostics,
+			"Expected import ID in the format: source_image_id/destination_region/image_id")
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("source_image_id"), parts[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("destination_region"), parts[1])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), parts[2])...)
+}
+
+func (r *imageCopyResource) destinationClient(ctx context.Context, region string, respDiags *diag.Diagnostics) (*common.KakaoCloudClient, bool) {
+	kc, err := r.kc.ForRegion(ctx, region)
+	if err != nil {
+		common.AddGeneralError(ctx, r, respDiags, err.Error())
+		return nil, false
+	}
+	return kc, true
+}
@@ -84,7 +84,7 @@ func (d *imageDataSource) Read(ctx context.Context, req datasource.ReadRequest,
 	ctx, cancel := context.WithTimeout(ctx, timeout)
 	defer cancel()
 
-	imageResp, httpResp, err := common.ExecuteWithRetryAndAuth(ctx, d.kc, &resp.Diagnostics,
+	imageResp, httpResp, err := common.ExecuteWithRetryAndAuth(ctx, d.kc, &resp.Diagnostics, common.ServiceImage,
 		func() (*image.BcsImageV1ApiGetImageModelResponseImageModel, *http.Response, error) {
 			return d.kc.ApiClient.ImageAPI.GetImage(ctx, config.Id.ValueString()).XAuthToken(d.kc.XAuthToken).Execute()
 		},
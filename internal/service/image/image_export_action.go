@@ -0,0 +1,149 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+package image
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"terraform-provider-kakaocloud/internal/common"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	actionschema "github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/kakaoenterprise/kc-sdk-go/services/image"
+)
+
+var _ action.ActionWithConfigure = &imageExportAction{}
+
+func NewImageExportAction() action.Action { return &imageExportAction{} }
+
+type imageExportAction struct {
+	kc *common.KakaoCloudClient
+}
+
+type imageExportActionModel struct {
+	ImageId    types.String `tfsdk:"image_id"`
+	Bucket     types.String `tfsdk:"bucket"`
+	Object     types.String `tfsdk:"object"`
+	DiskFormat types.String `tfsdk:"disk_format"`
+}
+
+func (a *imageExportAction) Metadata(_ context.Context, req action.MetadataRequest, resp *action.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_image_export"
+}
+
+func (a *imageExportAction) Schema(_ context.Context, _ action.SchemaRequest, resp *action.SchemaResponse) {
+	resp.Schema = actionschema.Schema{
+		Attributes: map[string]actionschema.Attribute{
+			"image_id": actionschema.StringAttribute{
+				Required:   true,
+				Validators: common.UuidValidator(),
+			},
+			"bucket": actionschema.StringAttribute{
+				Required:   true,
+				Validators: common.NotBlankValidator(),
+			},
+			"object": actionschema.StringAttribute{
+				Required:   true,
+				Validators: common.NotBlankValidator(),
+			},
+			"disk_format": actionschema.StringAttribute{
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(ImageDiskFormats...),
+				},
+			},
+		},
+	}
+}
+
+func (a *imageExportAction) Configure(_ context.Context, req action.ConfigureRequest, resp *action.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*common.KakaoCloudClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Action Configure Type",
+			fmt.Sprintf("Expected *common.KakaoCloudClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	a.kc = client
+}
+
+func (a *imageExportAction) Invoke(ctx context.Context, req action.InvokeRequest, resp *action.InvokeResponse) {
+	var config imageExportActionModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, common.LongCreateTimeout)
+	defer cancel()
+
+	imageId := config.ImageId.ValueString()
+
+	current, ok := pollImageUntilStatus(ctx, a.kc, a, imageId, []string{common.ImageStatusActive}, &resp.Diagnostics)
+	if !ok || resp.Diagnostics.HasError() {
+		return
+	}
+
+	exportReq := image.ExportImageModel{
+		Bucket: config.Bucket.ValueString(),
+		Object: config.Object.ValueString(),
+	}
+	if !config.DiskFormat.IsNull() {
+		exportReq.SetDiskFormat(config.DiskFormat.ValueString())
+	} else if diskFormat := current.DiskFormat.Get(); diskFormat != nil {
+		exportReq.SetDiskFormat(*diskFormat)
+	}
+	body := *image.NewBodyExportImage(exportReq)
+
+	tflog.Info(ctx, "invoking image export action", map[string]any{
+		"image_id": imageId,
+		"bucket":   exportReq.Bucket,
+		"object":   exportReq.Object,
+	})
+
+	exportResp, httpResp, err := common.ExecuteWithRetryAndAuth(ctx, a.kc, &resp.Diagnostics, common.ServiceImage,
+		func() (*image.BcsImageV1ApiExportImageModelResponseImageTaskModel, *http.Response, error) {
+			return a.kc.ApiClient.ImageAPI.
+				ExportImage(ctx, imageId).
+				XAuthToken(a.kc.XAuthToken).
+				BodyExportImage(body).
+				Execute()
+		},
+	)
+	if err != nil {
+		common.AddApiActionError(ctx, a, httpResp, "ExportImage", err, &resp.Diagnostics)
+		return
+	}
+
+	stopProgress := common.StartActionProgress(ctx, resp.SendProgress,
+		fmt.Sprintf("Waiting for image %s to be exported to %s/%s", imageId, exportReq.Bucket, exportReq.Object))
+	defer stopProgress()
+
+	// The image stays active while it is exported, so the export task is polled instead.
+	taskId := exportResp.Task.Id
+	task, ok := pollImageTaskUntilStatus(ctx, a.kc, a, imageId, taskId,
+		[]string{common.ImageTaskStatusSuccess, common.ImageTaskStatusFailure}, &resp.Diagnostics)
+	if !ok || resp.Diagnostics.HasError() {
+		return
+	}
+
+	if *task.Status.Get() == common.ImageTaskStatusFailure {
+		message := "no message was returned"
+		if m := task.Message.Get(); m != nil && *m != "" {
+			message = *m
+		}
+		common.AddGeneralError(ctx, a, &resp.Diagnostics,
+			fmt.Sprintf("Export task %s of image %s failed: %s", taskId, imageId, message))
+	}
+}
@@ -112,3 +112,17 @@ func mapImageBaseModel(
 	respDiags.Append(osDiags...)
 	base.OsInfo = osInfoObj
 }
+
+func mapImageCopyModel(
+	model *imageCopyResourceModel,
+	imageResult *image.BcsImageV1ApiGetImageModelImageModel,
+) {
+	model.Id = types.StringValue(imageResult.Id)
+	model.Name = ConvertNullableString(imageResult.Name)
+	model.Description = ConvertNullableString(imageResult.Description)
+	model.Size = ConvertNullableInt64(imageResult.Size)
+	model.Status = ConvertNullableString(imageResult.Status)
+	model.DiskFormat = ConvertNullableString(imageResult.DiskFormat)
+	model.MinDisk = ConvertNullableInt32(imageResult.MinDisk)
+	model.CreatedAt = ConvertNullableTime(imageResult.CreatedAt)
+}
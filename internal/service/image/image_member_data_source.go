@@ -80,7 +80,7 @@ func (d *imageMemberDataSource) Read(ctx context.Context, req datasource.ReadReq
 	ctx, cancel := context.WithTimeout(ctx, timeout)
 	defer cancel()
 
-	imageMemberResp, httpResp, err := common.ExecuteWithRetryAndAuth(ctx, d.kc, &resp.Diagnostics,
+	imageMemberResp, httpResp, err := common.ExecuteWithRetryAndAuth(ctx, d.kc, &resp.Diagnostics, common.ServiceImage,
 		func() (*image.ImageMemberListModel, *http.Response, error) {
 			return d.kc.ApiClient.ImageAPI.
 				ListImageSharedProjects(ctx, config.Id.ValueString()).
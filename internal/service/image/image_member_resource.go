@@ -73,7 +73,7 @@ func (r *imageMemberResource) Create(ctx context.Context, req resource.CreateReq
 
 	imageId := plan.Id.ValueString()
 
-	currentResp, httpResp, err := common.ExecuteWithRetryAndAuth(ctx, r.kc, &resp.Diagnostics,
+	currentResp, httpResp, err := common.ExecuteWithRetryAndAuth(ctx, r.kc, &resp.Diagnostics, common.ServiceImage,
 		func() (*image.ImageMemberListModel, *http.Response, error) {
 			return r.kc.ApiClient.ImageAPI.
 				ListImageSharedProjects(ctx, imageId).
@@ -104,7 +104,7 @@ func (r *imageMemberResource) Create(ctx context.Context, req resource.CreateReq
 	}
 
 	for _, sharedMemberId := range sharedMemberIds {
-		_, httpResp, err := common.ExecuteWithRetryAndAuth(ctx, r.kc, &resp.Diagnostics,
+		_, httpResp, err := common.ExecuteWithRetryAndAuth(ctx, r.kc, &resp.Diagnostics, common.ServiceImage,
 			func() (*image.ResponseImageMemberModel, *http.Response, error) {
 				return r.kc.ApiClient.ImageAPI.
 					AddImageShare(ctx, imageId, sharedMemberId).
@@ -143,7 +143,7 @@ func (r *imageMemberResource) Read(ctx context.Context, req resource.ReadRequest
 		return
 	}
 
-	imageMemberResp, httpResp, err := common.ExecuteWithRetryAndAuth(ctx, r.kc, &resp.Diagnostics,
+	imageMemberResp, httpResp, err := common.ExecuteWithRetryAndAuth(ctx, r.kc, &resp.Diagnostics, common.ServiceImage,
 		func() (*image.ImageMemberListModel, *http.Response, error) {
 			return r.kc.ApiClient.ImageAPI.
 				ListImageSharedProjects(ctx, state.Id.ValueString()).
@@ -225,7 +225,7 @@ func (r *imageMemberResource) Update(ctx context.Context, req resource.UpdateReq
 
 		for _, memberId := range stateSharedMemberIds {
 			if !planMap[memberId] {
-				_, httpResp, err := common.ExecuteWithRetryAndAuth(ctx, r.kc, &resp.Diagnostics,
+				_, httpResp, err := common.ExecuteWithRetryAndAuth(ctx, r.kc, &resp.Diagnostics, common.ServiceImage,
 					func() (*http.Response, *http.Response, error) {
 						httpResp, err := r.kc.ApiClient.ImageAPI.
 							RemoveImageShare(ctx, imageId, memberId).
@@ -243,7 +243,7 @@ func (r *imageMemberResource) Update(ctx context.Context, req resource.UpdateReq
 
 		for _, memberId := range planSharedMemberIds {
 			if !stateMap[memberId] {
-				_, httpResp, err := common.ExecuteWithRetryAndAuth(ctx, r.kc, &resp.Diagnostics,
+				_, httpResp, err := common.ExecuteWithRetryAndAuth(ctx, r.kc, &resp.Diagnostics, common.ServiceImage,
 					func() (*image.ResponseImageMemberModel, *http.Response, error) {
 						return r.kc.ApiClient.ImageAPI.
 							AddImageShare(ctx, imageId, memberId).
@@ -304,7 +304,7 @@ func (r *imageMemberResource) Delete(ctx context.Context, req resource.DeleteReq
 	}
 
 	for _, sharedMemberId := range sharedMemberIds {
-		_, httpResp, err := common.ExecuteWithRetryAndAuth(ctx, r.kc, &resp.Diagnostics,
+		_, httpResp, err := common.ExecuteWithRetryAndAuth(ctx, r.kc, &resp.Diagnostics, common.ServiceImage,
 			func() (interface{}, *http.Response, error) {
 				httpResp, err := r.kc.ApiClient.ImageAPI.
 					RemoveImageShare(ctx, imageId, sharedMemberId).
@@ -364,7 +364,7 @@ func (r *imageMemberResource) pollUntilAllMembers(ctx context.Context, imageId s
 	memberCount := len(memberIds)
 
 	for {
-		imageMemberResp, httpResp, err := common.ExecuteWithRetryAndAuth(ctx, r.kc, respDiags,
+		imageMemberResp, httpResp, err := common.ExecuteWithRetryAndAuth(ctx, r.kc, respDiags, common.ServiceImage,
 			func() (*image.ImageMemberListModel, *http.Response, error) {
 				return r.kc.ApiClient.ImageAPI.
 					ListImageSharedProjects(ctx, imageId).
@@ -62,6 +62,28 @@ type imagesDataSourceModel struct {
 
 type imageResourceModel struct {
 	imageBaseModel
-	VolumeId types.String           `tfsdk:"volume_id"`
-	Timeouts resourceTimeouts.Value `tfsdk:"timeouts"`
+	VolumeId     types.String           `tfsdk:"volume_id"`
+	SourceUrl    types.String           `tfsdk:"source_url"`
+	SourceObject types.Object           `tfsdk:"source_object"`
+	OsType       types.String           `tfsdk:"os_type"`
+	Timeouts     resourceTimeouts.Value `tfsdk:"timeouts"`
+}
+
+type imageSourceObjectModel struct {
+	Bucket types.String `tfsdk:"bucket"`
+	Object types.String `tfsdk:"object"`
+}
+
+type imageCopyResourceModel struct {
+	Id                types.String           `tfsdk:"id"`
+	SourceImageId     types.String           `tfsdk:"source_image_id"`
+	DestinationRegion types.String           `tfsdk:"destination_region"`
+	Name              types.String           `tfsdk:"name"`
+	Description       types.String           `tfsdk:"description"`
+	Size              types.Int64            `tfsdk:"size"`
+	Status            types.String           `tfsdk:"status"`
+	DiskFormat        types.String           `tfsdk:"disk_format"`
+	MinDisk           types.Int32            `tfsdk:"min_disk"`
+	CreatedAt         types.String           `tfsdk:"created_at"`
+	Timeouts          resourceTimeouts.Value `tfsdk:"timeouts"`
 }
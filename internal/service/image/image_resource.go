@@ -16,6 +16,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
 	"github.com/kakaoenterprise/kc-sdk-go/services/image"
 	"github.com/kakaoenterprise/kc-sdk-go/services/volume"
 )
@@ -50,14 +51,30 @@ func (r *imageResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanR
 	}
 
 	if req.State.Raw.IsNull() && !req.Plan.Raw.IsNull() {
-		if plan.VolumeId.IsNull() || plan.VolumeId.IsUnknown() {
+		if plan.VolumeId.IsNull() && plan.SourceUrl.IsNull() && plan.SourceObject.IsNull() {
 			resp.Diagnostics.AddAttributeError(
 				path.Root("volume_id"),
 				"Missing required attribute",
-				"The attribute 'volume_id' is required when creating an image. Please specify a valid volume ID.",
+				"One of 'volume_id', 'source_url' or 'source_object' is required when creating an image.",
 			)
 			return
 		}
+
+		if plan.VolumeId.IsNull() && plan.DiskFormat.IsUnknown() {
+			var config imageResourceModel
+			resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+			if resp.Diagnostics.HasError() {
+				return
+			}
+			if config.DiskFormat.IsNull() {
+				resp.Diagnostics.AddAttributeError(
+					path.Root("disk_format"),
+					"Missing required attribute",
+					"The attribute 'disk_format' is required when importing an image from 'source_url' or 'source_object'.",
+				)
+				return
+			}
+		}
 	}
 
 }
@@ -101,6 +118,51 @@ func (r *imageResource) Create(ctx context.Context, req resource.CreateRequest,
 	ctx, cancel := context.WithTimeout(ctx, timeout)
 	defer cancel()
 
+	var imageId string
+	var ok bool
+	if !plan.VolumeId.IsNull() {
+		imageId, ok = r.createImageFromVolume(ctx, &plan, &resp.Diagnostics)
+	} else {
+		imageId, ok = r.importImage(ctx, &plan, &resp.Diagnostics)
+	}
+	if !ok || resp.Diagnostics.HasError() {
+		return
+	}
+
+	plan.Id = types.StringValue(imageId)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), plan.Id)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	result, ok := r.pollImageUtilsStatus(
+		ctx,
+		imageId,
+		[]string{common.ImageStatusActive, common.ImageStatusError, common.ImageStatusKilled},
+		&resp.Diagnostics,
+	)
+	if !ok || resp.Diagnostics.HasError() {
+		return
+	}
+
+	common.CheckResourceAvailableStatus(ctx, r, result.Status.Get(), []string{common.ImageStatusActive}, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ok = r.mapImage(ctx, &plan, result, &resp.Diagnostics)
+	if !ok || resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *imageResource) createImageFromVolume(ctx context.Context, plan *imageResourceModel, respDiags *diag.Diagnostics) (string, bool) {
 	createReq := volume.CreateVolumeImageModel{
 		Name: plan.Name.ValueString(),
 	}
@@ -114,38 +176,73 @@ func (r *imageResource) Create(ctx context.Context, req resource.CreateRequest,
 
 	body := volume.BodyCreateImage{Image: createReq}
 
-	respModel, httpResp, err := common.ExecuteWithRetryAndAuth(ctx, r.kc, &resp.Diagnostics,
+	respModel, httpResp, err := common.ExecuteWithRetryAndAuth(ctx, r.kc, respDiags, common.ServiceVolume,
 		func() (*volume.ResponseVolumeImageModel, *http.Response, error) {
 			return r.kc.ApiClient.VolumeAPI.CreateImage(ctx, volumeId).XAuthToken(r.kc.XAuthToken).BodyCreateImage(body).Execute()
 		},
 	)
 	if err != nil {
-		common.AddApiActionError(ctx, r, httpResp, "CreateImage", err, &resp.Diagnostics)
-		return
+		common.AddApiActionError(ctx, r, httpResp, "CreateImage", err, respDiags)
+		return "", false
 	}
 
-	plan.Id = types.StringValue(respModel.Image.Id)
+	return respModel.Image.Id, true
+}
 
-	result, ok := r.pollImageUtilsStatus(
-		ctx,
-		plan.Id.ValueString(),
-		[]string{common.ImageStatusActive},
-		&resp.Diagnostics,
-	)
-	if !ok || resp.Diagnostics.HasError() {
-		return
+func (r *imageResource) importImage(ctx context.Context, plan *imageResourceModel, respDiags *diag.Diagnostics) (string, bool) {
+	importReq := image.ImportImageModel{
+		Name:       plan.Name.ValueString(),
+		DiskFormat: plan.DiskFormat.ValueString(),
 	}
 
-	ok = r.mapImage(ctx, &plan, result, &resp.Diagnostics)
-	if !ok || resp.Diagnostics.HasError() {
-		return
+	if plan.Description.IsNull() || plan.Description.IsUnknown() {
+		importReq.SetDescription("-")
+	} else {
+		importReq.SetDescription(plan.Description.ValueString())
+	}
+	if plan.ContainerFormat.IsNull() || plan.ContainerFormat.IsUnknown() {
+		importReq.SetContainerFormat("bare")
+	} else {
+		importReq.SetContainerFormat(plan.ContainerFormat.ValueString())
+	}
+	if !plan.MinDisk.IsNull() && !plan.MinDisk.IsUnknown() {
+		importReq.SetMinDisk(plan.MinDisk.ValueInt32())
+	}
+	if !plan.OsType.IsNull() {
+		importReq.SetOsType(plan.OsType.ValueString())
 	}
 
-	diags = resp.State.Set(ctx, plan)
-	resp.Diagnostics.Append(diags...)
-	if resp.Diagnostics.HasError() {
-		return
+	if !plan.SourceUrl.IsNull() {
+		importReq.SetSourceUrl(plan.SourceUrl.ValueString())
+	} else {
+		var sourceObject imageSourceObjectModel
+		respDiags.Append(plan.SourceObject.As(ctx, &sourceObject, basetypes.ObjectAsOptions{})...)
+		if respDiags.HasError() {
+			return "", false
+		}
+		importReq.SetSourceObject(*image.NewImageSourceObjectModel(
+			sourceObject.Bucket.ValueString(),
+			sourceObject.Object.ValueString(),
+		))
 	}
+
+	body := *image.NewBodyImportImage(importReq)
+
+	respModel, httpResp, err := common.ExecuteWithRetryAndAuth(ctx, r.kc, respDiags, common.ServiceImage,
+		func() (*image.BcsImageV1ApiImportImageModelResponseImageModel, *http.Response, error) {
+			return r.kc.ApiClient.ImageAPI.
+				ImportImage(ctx).
+				XAuthToken(r.kc.XAuthToken).
+				BodyImportImage(body).
+				Execute()
+		},
+	)
+	if err != nil {
+		common.AddApiActionError(ctx, r, httpResp, "ImportImage", err, respDiags)
+		return "", false
+	}
+
+	return respModel.Image.Id, true
 }
 
 func (r *imageResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -166,7 +263,7 @@ func (r *imageResource) Read(ctx context.Context, req resource.ReadRequest, resp
 	ctx, cancel := context.WithTimeout(ctx, timeout)
 	defer cancel()
 
-	respModel, httpResp, err := common.ExecuteWithRetryAndAuth(ctx, r.kc, &resp.Diagnostics,
+	respModel, httpResp, err := common.ExecuteWithRetryAndAuth(ctx, r.kc, &resp.Diagnostics, common.ServiceImage,
 		func() (*image.BcsImageV1ApiGetImageModelResponseImageModel, *http.Response, error) {
 			return r.kc.ApiClient.ImageAPI.
 				GetImage(ctx, state.Id.ValueString()).
@@ -239,7 +336,7 @@ func (r *imageResource) Update(ctx context.Context, req resource.UpdateRequest,
 
 	body := *image.NewBodyUpdateImage(editReq)
 
-	respModel, httpResp, err := common.ExecuteWithRetryAndAuth(ctx, r.kc, &resp.Diagnostics,
+	respModel, httpResp, err := common.ExecuteWithRetryAndAuth(ctx, r.kc, &resp.Diagnostics, common.ServiceImage,
 		func() (*image.BcsImageV1ApiUpdateImageModelResponseImageModel, *http.Response, error) {
 			return r.kc.ApiClient.ImageAPI.
 				UpdateImage(ctx, plan.Id.ValueString()).
@@ -281,7 +378,7 @@ func (r *imageResource) Delete(ctx context.Context, req resource.DeleteRequest,
 	ctx, cancel := context.WithTimeout(ctx, timeout)
 	defer cancel()
 
-	_, httpResp, err := common.ExecuteWithRetryAndAuth(ctx, r.kc, &resp.Diagnostics,
+	_, httpResp, err := common.ExecuteWithRetryAndAuth(ctx, r.kc, &resp.Diagnostics, common.ServiceImage,
 		func() (interface{}, *http.Response, error) {
 			httpResp, err := r.kc.ApiClient.ImageAPI.
 				DeleteImage(ctx, state.Id.ValueString()).
@@ -299,7 +396,7 @@ func (r *imageResource) Delete(ctx context.Context, req resource.DeleteRequest,
 	}
 
 	common.PollUntilDeletion(ctx, r, 2*time.Second, &resp.Diagnostics, func(ctx context.Context) (bool, *http.Response, error) {
-		_, httpResp, err := common.ExecuteWithRetryAndAuth(ctx, r.kc, &resp.Diagnostics,
+		_, httpResp, err := common.ExecuteWithRetryAndAuth(ctx, r.kc, &resp.Diagnostics, common.ServiceImage,
 			func() (*image.BcsImageV1ApiGetImageModelResponseImageModel, *http.Response, error) {
 				_, httpResp, err := r.kc.ApiClient.ImageAPI.
 					GetImage(ctx, state.Id.ValueString()).
@@ -3,15 +3,26 @@
 package image
 
 import (
+	"regexp"
 	"terraform-provider-kakaocloud/internal/common"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/int32validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	dschema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	rschema "github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int32planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/objectplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+var (
+	ImageDiskFormats      = []string{"qcow2", "raw", "vmdk", "vhd", "vhdx", "vdi", "iso"}
+	ImageContainerFormats = []string{"bare", "ovf", "ova"}
+	ImageOsTypes          = []string{"linux", "windows"}
 )
 
 func getImageResourceSchema() map[string]rschema.Attribute {
@@ -32,8 +43,54 @@ func getImageResourceSchema() map[string]rschema.Attribute {
 			Validators: common.DescriptionValidator(),
 		},
 		"volume_id": rschema.StringAttribute{
-			Optional:   true,
-			Validators: common.UuidValidator(),
+			Optional: true,
+			Validators: append(
+				common.UuidValidator(),
+				stringvalidator.ConflictsWith(
+					path.MatchRoot("source_url"),
+					path.MatchRoot("source_object"),
+				),
+			),
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.RequiresReplace(),
+			},
+		},
+		"source_url": rschema.StringAttribute{
+			Optional: true,
+			Validators: []validator.String{
+				stringvalidator.RegexMatches(regexp.MustCompile(`^https?://`), "must be an http or https URL"),
+				stringvalidator.ConflictsWith(path.MatchRoot("source_object")),
+			},
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.RequiresReplace(),
+			},
+		},
+		"source_object": rschema.SingleNestedAttribute{
+			Optional: true,
+			Attributes: map[string]rschema.Attribute{
+				"bucket": rschema.StringAttribute{
+					Required: true,
+					Validators: []validator.String{
+						stringvalidator.LengthAtLeast(1),
+					},
+				},
+				"object": rschema.StringAttribute{
+					Required: true,
+					Validators: []validator.String{
+						stringvalidator.LengthAtLeast(1),
+					},
+				},
+			},
+			PlanModifiers: []planmodifier.Object{
+				objectplanmodifier.RequiresReplace(),
+			},
+		},
+		"os_type": rschema.StringAttribute{
+			Optional: true,
+			Validators: []validator.String{
+				stringvalidator.OneOf(ImageOsTypes...),
+				stringvalidator.ConflictsWith(path.MatchRoot("volume_id")),
+			},
 			PlanModifiers: []planmodifier.String{
 				stringplanmodifier.RequiresReplace(),
 			},
@@ -63,21 +120,39 @@ func getImageResourceSchema() map[string]rschema.Attribute {
 			Computed: true,
 		},
 		"disk_format": rschema.StringAttribute{
+			Optional: true,
 			Computed: true,
+			Validators: []validator.String{
+				stringvalidator.OneOf(ImageDiskFormats...),
+				stringvalidator.ConflictsWith(path.MatchRoot("volume_id")),
+			},
 			PlanModifiers: []planmodifier.String{
 				stringplanmodifier.UseStateForUnknown(),
+				stringplanmodifier.RequiresReplaceIfConfigured(),
 			},
 		},
 		"container_format": rschema.StringAttribute{
+			Optional: true,
 			Computed: true,
+			Validators: []validator.String{
+				stringvalidator.OneOf(ImageContainerFormats...),
+				stringvalidator.ConflictsWith(path.MatchRoot("volume_id")),
+			},
 			PlanModifiers: []planmodifier.String{
 				stringplanmodifier.UseStateForUnknown(),
+				stringplanmodifier.RequiresReplaceIfConfigured(),
 			},
 		},
 		"min_disk": rschema.Int32Attribute{
+			Optional: true,
 			Computed: true,
+			Validators: []validator.Int32{
+				int32validator.AtLeast(1),
+				int32validator.ConflictsWith(path.MatchRoot("volume_id")),
+			},
 			PlanModifiers: []planmodifier.Int32{
 				int32planmodifier.UseStateForUnknown(),
+				int32planmodifier.RequiresReplaceIfConfigured(),
 			},
 		},
 		"min_ram": rschema.Int32Attribute{
@@ -226,5 +301,76 @@ func getImageOsInfoSchemaAttributes() map[string]dschema.Attribute {
 	}
 }
 
+func getImageCopyResourceSchema() map[string]rschema.Attribute {
+	return map[string]rschema.Attribute{
+		"id": rschema.StringAttribute{
+			Computed: true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		},
+		"source_image_id": rschema.StringAttribute{
+			Required:   true,
+			Validators: common.UuidValidator(),
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.RequiresReplace(),
+			},
+		},
+		"destination_region": rschema.StringAttribute{
+			Required:   true,
+			Validators: common.RegionValidators(),
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.RequiresReplace(),
+			},
+		},
+		"name": rschema.StringAttribute{
+			Optional:   true,
+			Computed:   true,
+			Validators: common.NameValidator(250),
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+				stringplanmodifier.RequiresReplaceIfConfigured(),
+			},
+		},
+		"description": rschema.StringAttribute{
+			Optional:   true,
+			Computed:   true,
+			Validators: common.DescriptionValidator(),
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+				stringplanmodifier.RequiresReplaceIfConfigured(),
+			},
+		},
+		"size": rschema.Int64Attribute{
+			Computed: true,
+			PlanModifiers: []planmodifier.Int64{
+				int64planmodifier.UseStateForUnknown(),
+			},
+		},
+		"status": rschema.StringAttribute{
+			Computed: true,
+		},
+		"disk_format": rschema.StringAttribute{
+			Computed: true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		},
+		"min_disk": rschema.Int32Attribute{
+			Computed: true,
+			PlanModifiers: []planmodifier.Int32{
+				int32planmodifier.UseStateForUnknown(),
+			},
+		},
+		"created_at": rschema.StringAttribute{
+			Computed: true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		},
+	}
+}
+
 var imageResourceSchemaAttributes = getImageResourceSchema()
+var imageCopyResourceSchemaAttributes = getImageCopyResourceSchema()
 var imageDataSourceSchemaAttributes = getImageDataSourceSchema()
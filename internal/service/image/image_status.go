@@ -17,21 +17,32 @@ func (r *imageResource) pollImageUtilsStatus(
 	imageId string,
 	targetStatuses []string,
 	resp *diag.Diagnostics,
+) (*image.BcsImageV1ApiGetImageModelImageModel, bool) {
+	return pollImageUntilStatus(ctx, r.kc, r, imageId, targetStatuses, resp)
+}
+
+func pollImageUntilStatus(
+	ctx context.Context,
+	kc *common.KakaoCloudClient,
+	obj interface{},
+	imageId string,
+	targetStatuses []string,
+	resp *diag.Diagnostics,
 ) (*image.BcsImageV1ApiGetImageModelImageModel, bool) {
 	return common.PollUntilResult(
 		ctx,
-		r,
+		obj,
 		2*time.Second,
 		"image",
 		imageId,
 		targetStatuses,
 		resp,
 		func(ctx context.Context) (*image.BcsImageV1ApiGetImageModelImageModel, *http.Response, error) {
-			respModel, httpResp, err := common.ExecuteWithRetryAndAuth(ctx, r.kc, resp,
+			respModel, httpResp, err := common.ExecuteWithRetryAndAuth(ctx, kc, resp, common.ServiceImage,
 				func() (*image.BcsImageV1ApiGetImageModelResponseImageModel, *http.Response, error) {
-					return r.kc.ApiClient.ImageAPI.
+					return kc.ApiClient.ImageAPI.
 						GetImage(ctx, imageId).
-						XAuthToken(r.kc.XAuthToken).
+						XAuthToken(kc.XAuthToken).
 						Execute()
 				},
 			)
@@ -43,3 +54,38 @@ func (r *imageResource) pollImageUtilsStatus(
 		func(v *image.BcsImageV1ApiGetImageModelImageModel) string { return *v.Status.Get() },
 	)
 }
+
+func pollImageTaskUntilStatus(
+	ctx context.Context,
+	kc *common.KakaoCloudClient,
+	obj interface{},
+	imageId string,
+	taskId string,
+	targetStatuses []string,
+	resp *diag.Diagnostics,
+) (*image.BcsImageV1ApiGetImageTaskModelImageTaskModel, bool) {
+	return common.PollUntilResult(
+		ctx,
+		obj,
+		5*time.Second,
+		"image task",
+		taskId,
+		targetStatuses,
+		resp,
+		func(ctx context.Context) (*image.BcsImageV1ApiGetImageTaskModelImageTaskModel, *http.Response, error) {
+			respModel, httpResp, err := common.ExecuteWithRetryAndAuth(ctx, kc, resp, common.ServiceImage,
+				func() (*image.BcsImageV1ApiGetImageTaskModelResponseImageTaskModel, *http.Response, error) {
+					return kc.ApiClient.ImageAPI.
+						GetImageTask(ctx, imageId, taskId).
+						XAuthToken(kc.XAuthToken).
+						Execute()
+				},
+			)
+			if err != nil {
+				return nil, httpResp, err
+			}
+			return &respModel.Task, httpResp, nil
+		},
+		func(v *image.BcsImageV1ApiGetImageTaskModelImageTaskModel) string { return *v.Status.Get() },
+	)
+}
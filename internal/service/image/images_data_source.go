@@ -198,9 +198,12 @@ func (d *imagesDataSource) Read(ctx context.Context, req datasource.ReadRequest,
 		return
 	}
 
-	imageResp, httpResp, err := common.ExecuteWithRetryAndAuth(ctx, d.kc, &resp.Diagnostics,
-		func() (*image.ImageListModel, *http.Response, error) {
-			return imageApi.Limit(1000).XAuthToken(d.kc.XAuthToken).Execute()
+	imagePages, httpResp, err := common.ListAllPagesPartial(ctx, d.kc, d, &resp.Diagnostics, common.ServiceImage,
+		func(limit int32, offset int32) (*image.ImageListModel, *http.Response, error) {
+			return imageApi.Limit(limit).Offset(offset).XAuthToken(d.kc.XAuthToken).Execute()
+		},
+		func(page *image.ImageListModel) int {
+			return len(page.Images)
 		},
 	)
 	if err != nil {
@@ -208,14 +211,16 @@ func (d *imagesDataSource) Read(ctx context.Context, req datasource.ReadRequest,
 		return
 	}
 
-	for _, v := range imageResp.Images {
-		var tmpImage imageBaseModel
-		ok := d.mapImages(ctx, &tmpImage, &v, &resp.Diagnostics)
-		if !ok || resp.Diagnostics.HasError() {
-			return
-		}
+	for _, imageResp := range imagePages {
+		for _, v := range imageResp.Images {
+			var tmpImage imageBaseModel
+			ok := d.mapImages(ctx, &tmpImage, &v, &resp.Diagnostics)
+			if !ok || resp.Diagnostics.HasError() {
+				return
+			}
 
-		config.Images = append(config.Images, tmpImage)
+			config.Images = append(config.Images, tmpImage)
+		}
 	}
 
 	diags = resp.State.Set(ctx, &config)
@@ -81,7 +81,7 @@ func (d *clusterDataSource) Read(ctx context.Context, req datasource.ReadRequest
 	ctx, cancel := context.WithTimeout(ctx, timeout)
 	defer cancel()
 
-	respModel, httpResp, err := common.ExecuteWithRetryAndAuth(ctx, d.kc, &resp.Diagnostics,
+	respModel, httpResp, err := common.ExecuteWithRetryAndAuth(ctx, d.kc, &resp.Diagnostics, common.ServiceKubernetesEngine,
 		func() (*kubernetesengine.GetK8sClusterResponseModel, *http.Response, error) {
 			return d.kc.ApiClient.ClustersAPI.
 				GetCluster(ctx, config.Name.ValueString()).
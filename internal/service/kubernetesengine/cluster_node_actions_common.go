@@ -0,0 +1,169 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+package kubernetesengine
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"slices"
+	"terraform-provider-kakaocloud/internal/common"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	actionschema "github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/kakaoenterprise/kc-sdk-go/services/kubernetesengine"
+)
+
+const clusterNodeActionPollInterval = 5 * time.Second
+
+type kubernetesEngineActionBase struct {
+	kc *common.KakaoCloudClient
+}
+
+func (a *kubernetesEngineActionBase) configure(req action.ConfigureRequest, resp *action.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*common.KakaoCloudClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Action Configure Type",
+			fmt.Sprintf("Expected *common.KakaoCloudClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	a.kc = client
+}
+
+func clusterNodeActionClusterNameAttribute() actionschema.StringAttribute {
+	return actionschema.StringAttribute{
+		Required:   true,
+		Validators: common.NameValidator(20),
+	}
+}
+
+func clusterNodeActionNodeNamesAttribute() actionschema.SetAttribute {
+	return actionschema.SetAttribute{
+		Required:    true,
+		ElementType: types.StringType,
+		Validators: []validator.Set{
+			setvalidator.SizeAtLeast(1),
+		},
+	}
+}
+
+func clusterNodeNamesFromSet(ctx context.Context, set types.Set, respDiags *diag.Diagnostics) ([]string, bool) {
+	var nodeNames []string
+	respDiags.Append(set.ElementsAs(ctx, &nodeNames, false)...)
+	if respDiags.HasError() {
+		return nil, false
+	}
+
+	slices.Sort(nodeNames)
+	return nodeNames, true
+}
+
+// validateClusterNodes checks that every named node exists in the cluster and returns the nodes
+// the cluster listed.
+func (a *kubernetesEngineActionBase) validateClusterNodes(
+	ctx context.Context,
+	obj action.Action,
+	clusterName string,
+	nodeNames []string,
+	respDiags *diag.Diagnostics,
+) ([]kubernetesengine.KubernetesEngineV1ApiListClusterNodesModelNodeResponseModel, bool) {
+	nodes, ok := a.listClusterNodes(ctx, obj, clusterName, respDiags)
+	if !ok {
+		return nil, false
+	}
+
+	existing := make(map[string]struct{}, len(nodes))
+	for _, node := range nodes {
+		existing[node.Name] = struct{}{}
+	}
+	for _, nodeName := range nodeNames {
+		if _, ok := existing[nodeName]; !ok {
+			common.AddGeneralError(ctx, obj, respDiags, fmt.Sprintf("node %q was not found in cluster %q", nodeName, clusterName))
+			return nil, false
+		}
+	}
+	return nodes, true
+}
+
+func (a *kubernetesEngineActionBase) listClusterNodes(
+	ctx context.Context,
+	obj action.Action,
+	clusterName string,
+	respDiags *diag.Diagnostics,
+) ([]kubernetesengine.KubernetesEngineV1ApiListClusterNodesModelNodeResponseModel, bool) {
+	result, httpResp, err := common.ExecuteWithRetryAndAuth(ctx, a.kc, respDiags, common.ServiceKubernetesEngine,
+		func() (*kubernetesengine.GetK8sClusterNodesResponseModel, *http.Response, error) {
+			return a.kc.ApiClient.ClustersAPI.
+				ListClusterNodes(ctx, clusterName).
+				XAuthToken(a.kc.XAuthToken).
+				Execute()
+		},
+	)
+	if httpResp != nil && httpResp.StatusCode == http.StatusNotFound {
+		common.AddGeneralError(ctx, obj, respDiags, fmt.Sprintf("cluster %q was not found", clusterName))
+		return nil, false
+	}
+	if err != nil {
+		common.AddApiActionError(ctx, obj, httpResp, "ListClusterNodes", err, respDiags)
+		return nil, false
+	}
+	return result.Nodes, true
+}
+
+// pollClusterNodes lists the cluster nodes until done reports true for the named nodes.
+func (a *kubernetesEngineActionBase) pollClusterNodes(
+	ctx context.Context,
+	obj action.Action,
+	clusterName string,
+	nodeNames []string,
+	respDiags *diag.Diagnostics,
+	done func(targets map[string]struct{}, nodes []kubernetesengine.KubernetesEngineV1ApiListClusterNodesModelNodeResponseModel) bool,
+) bool {
+	targets := make(map[string]struct{}, len(nodeNames))
+	for _, nodeName := range nodeNames {
+		targets[nodeName] = struct{}{}
+	}
+
+	_, ok := common.PollUntilResult(
+		ctx,
+		obj,
+		clusterNodeActionPollInterval,
+		"cluster",
+		clusterName,
+		[]string{"done"},
+		respDiags,
+		func(ctx context.Context) (bool, *http.Response, error) {
+			result, httpResp, err := common.ExecuteWithRetryAndAuth(ctx, a.kc, respDiags, common.ServiceKubernetesEngine,
+				func() (*kubernetesengine.GetK8sClusterNodesResponseModel, *http.Response, error) {
+					return a.kc.ApiClient.ClustersAPI.
+						ListClusterNodes(ctx, clusterName).
+						XAuthToken(a.kc.XAuthToken).
+						Execute()
+				},
+			)
+			if err != nil {
+				return false, httpResp, err
+			}
+			return done(targets, result.Nodes), httpResp, nil
+		},
+		func(finished bool) string {
+			if finished {
+				return "done"
+			}
+			return "pending"
+		},
+	)
+	return ok
+}
@@ -0,0 +1,123 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+package kubernetesengine
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"terraform-provider-kakaocloud/internal/common"
+
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	actionschema "github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/kakaoenterprise/kc-sdk-go/services/kubernetesengine"
+)
+
+var _ action.ActionWithConfigure = &clusterNodeCordonAction{}
+
+func NewClusterNodeCordonAction() action.Action { return &clusterNodeCordonAction{} }
+
+type clusterNodeCordonAction struct{ kubernetesEngineActionBase }
+
+type clusterNodeCordonActionModel struct {
+	ClusterName types.String `tfsdk:"cluster_name"`
+	NodeNames   types.Set    `tfsdk:"node_names"`
+	Cordon      types.Bool   `tfsdk:"cordon"`
+}
+
+func (a *clusterNodeCordonAction) Metadata(_ context.Context, req action.MetadataRequest, resp *action.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_kubernetes_engine_cluster_node_cordon"
+}
+
+func (a *clusterNodeCordonAction) Schema(_ context.Context, _ action.SchemaRequest, resp *action.SchemaResponse) {
+	resp.Schema = actionschema.Schema{
+		Attributes: map[string]actionschema.Attribute{
+			"cluster_name": clusterNodeActionClusterNameAttribute(),
+			"node_names":   clusterNodeActionNodeNamesAttribute(),
+			"cordon": actionschema.BoolAttribute{
+				Required: true,
+			},
+		},
+	}
+}
+
+func (a *clusterNodeCordonAction) Configure(_ context.Context, req action.ConfigureRequest, resp *action.ConfigureResponse) {
+	a.configure(req, resp)
+}
+
+func (a *clusterNodeCordonAction) Invoke(ctx context.Context, req action.InvokeRequest, resp *action.InvokeResponse) {
+	var config clusterNodeCordonActionModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, common.DefaultUpdateTimeout)
+	defer cancel()
+
+	clusterName := config.ClusterName.ValueString()
+	cordon := config.Cordon.ValueBool()
+	nodeNames, ok := clusterNodeNamesFromSet(ctx, config.NodeNames, &resp.Diagnostics)
+	if !ok {
+		return
+	}
+	if _, ok := a.validateClusterNodes(ctx, a, clusterName, nodeNames, &resp.Diagnostics); !ok {
+		return
+	}
+
+	tflog.Info(ctx, "invoking Kubernetes Engine cluster node cordon action", map[string]any{
+		"cluster_name": clusterName,
+		"node_names":   nodeNames,
+		"cordon":       cordon,
+	})
+	if !a.setCordon(ctx, clusterName, nodeNames, cordon, &resp.Diagnostics) {
+		return
+	}
+
+	message := fmt.Sprintf("Waiting for nodes in cluster %s to become schedulable", clusterName)
+	if cordon {
+		message = fmt.Sprintf("Waiting for nodes in cluster %s to become unschedulable", clusterName)
+	}
+	stopProgress := common.StartActionProgress(ctx, resp.SendProgress, message)
+	defer stopProgress()
+
+	a.pollClusterNodes(ctx, a, clusterName, nodeNames, &resp.Diagnostics,
+		func(targets map[string]struct{}, nodes []kubernetesengine.KubernetesEngineV1ApiListClusterNodesModelNodeResponseModel) bool {
+			matched := 0
+			for _, node := range nodes {
+				if _, ok := targets[node.Name]; ok && node.IsCordon == cordon {
+					matched++
+				}
+			}
+			return matched == len(targets)
+		},
+	)
+}
+
+func (a *clusterNodeCordonAction) setCordon(ctx context.Context, clusterName string, nodeNames []string, cordon bool, respDiags *diag.Diagnostics) bool {
+	body := kubernetesengine.UpdateK8sClusterNodesCordonRequestModel{
+		Cluster: kubernetesengine.KubernetesEngineV1ApiSetClusterNodesCordonModelClusterRequestModel{
+			IsCordon:  cordon,
+			NodeNames: nodeNames,
+		},
+	}
+
+	_, httpResp, err := common.ExecuteWithRetryAndAuth(ctx, a.kc, respDiags, common.ServiceKubernetesEngine,
+		func() (struct{}, *http.Response, error) {
+			httpResp, err := a.kc.ApiClient.ClustersAPI.
+				SetClusterNodesCordon(ctx, clusterName).
+				XAuthToken(a.kc.XAuthToken).
+				UpdateK8sClusterNodesCordonRequestModel(body).
+				Execute()
+			return struct{}{}, httpResp, err
+		},
+	)
+	if err != nil {
+		common.AddApiActionError(ctx, a, httpResp, "SetClusterNodesCordon", err, respDiags)
+		return false
+	}
+	return true
+}
@@ -101,7 +101,7 @@ func (d *clusterNodeDataSource) Read(ctx context.Context, req datasource.ReadReq
 	if !config.NodePoolName.IsNull() && !config.NodePoolName.IsUnknown() {
 		nodePoolName := config.NodePoolName.ValueString()
 
-		modelResp, httpResp, err := common.ExecuteWithRetryAndAuth(ctx, d.kc, &resp.Diagnostics,
+		modelResp, httpResp, err := common.ExecuteWithRetryAndAuth(ctx, d.kc, &resp.Diagnostics, common.ServiceKubernetesEngine,
 			func() (*kubernetesengine.GetK8sClusterNodePoolNodesResponseModel, *http.Response, error) {
 				return d.kc.ApiClient.NodePoolsAPI.
 					ListNodePoolNodes(ctx, clusterName, nodePoolName).
@@ -125,7 +125,7 @@ func (d *clusterNodeDataSource) Read(ctx context.Context, req datasource.ReadReq
 
 		config.NodePoolName = types.StringValue(nodePoolName)
 	} else {
-		modelResp, httpResp, err := common.ExecuteWithRetryAndAuth(ctx, d.kc, &resp.Diagnostics,
+		modelResp, httpResp, err := common.ExecuteWithRetryAndAuth(ctx, d.kc, &resp.Diagnostics, common.ServiceKubernetesEngine,
 			func() (*kubernetesengine.GetK8sClusterNodesResponseModel, *http.Response, error) {
 				return d.kc.ApiClient.ClustersAPI.
 					ListClusterNodes(ctx, clusterName).
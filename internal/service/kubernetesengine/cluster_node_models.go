@@ -4,7 +4,6 @@ package kubernetesengine
 
 import (
 	datasourceTimeouts "github.com/hashicorp/terraform-plugin-framework-timeouts/datasource/timeouts"
-	resourceTimeouts "github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
@@ -113,12 +112,3 @@ type clusterNodeDataSourceModel struct {
 	NodePoolName types.String             `tfsdk:"node_pool_name"`
 	Timeouts     datasourceTimeouts.Value `tfsdk:"timeouts"`
 }
-
-type clusterNodeResourceModel struct {
-	Id          types.String           `tfsdk:"id"`
-	ClusterName types.String           `tfsdk:"cluster_name"`
-	NodeNames   types.Set              `tfsdk:"node_names"`
-	IsRemove    types.Bool             `tfsdk:"is_remove"`
-	IsCordon    types.Bool             `tfsdk:"is_cordon"`
-	Timeouts    resourceTimeouts.Value `tfsdk:"timeouts"`
-}
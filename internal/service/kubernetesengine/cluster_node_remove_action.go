@@ -0,0 +1,166 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+package kubernetesengine
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"slices"
+	"terraform-provider-kakaocloud/internal/common"
+
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	actionschema "github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/kakaoenterprise/kc-sdk-go/services/kubernetesengine"
+)
+
+var _ action.ActionWithConfigure = &clusterNodeRemoveAction{}
+
+func NewClusterNodeRemoveAction() action.Action { return &clusterNodeRemoveAction{} }
+
+type clusterNodeRemoveAction struct{ kubernetesEngineActionBase }
+
+type clusterNodeRemoveActionModel struct {
+	ClusterName types.String `tfsdk:"cluster_name"`
+	NodeNames   types.Set    `tfsdk:"node_names"`
+	Replace     types.Bool   `tfsdk:"replace"`
+}
+
+func (a *clusterNodeRemoveAction) Metadata(_ context.Context, req action.MetadataRequest, resp *action.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_kubernetes_engine_cluster_node_remove"
+}
+
+func (a *clusterNodeRemoveAction) Schema(_ context.Context, _ action.SchemaRequest, resp *action.SchemaResponse) {
+	resp.Schema = actionschema.Schema{
+		Attributes: map[string]actionschema.Attribute{
+			"cluster_name": clusterNodeActionClusterNameAttribute(),
+			"node_names":   clusterNodeActionNodeNamesAttribute(),
+			"replace": actionschema.BoolAttribute{
+				Optional: true,
+			},
+		},
+	}
+}
+
+func (a *clusterNodeRemoveAction) Configure(_ context.Context, req action.ConfigureRequest, resp *action.ConfigureResponse) {
+	a.configure(req, resp)
+}
+
+func (a *clusterNodeRemoveAction) Invoke(ctx context.Context, req action.InvokeRequest, resp *action.InvokeResponse) {
+	var config clusterNodeRemoveActionModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, common.DefaultDeleteTimeout)
+	defer cancel()
+
+	clusterName := config.ClusterName.ValueString()
+	replace := config.Replace.ValueBool()
+	nodeNames, ok := clusterNodeNamesFromSet(ctx, config.NodeNames, &resp.Diagnostics)
+	if !ok {
+		return
+	}
+	nodes, ok := a.validateClusterNodes(ctx, a, clusterName, nodeNames, &resp.Diagnostics)
+	if !ok {
+		return
+	}
+	removal := newClusterNodeRemoval(nodeNames, nodes, replace)
+
+	tflog.Info(ctx, "invoking Kubernetes Engine cluster node remove action", map[string]any{
+		"cluster_name": clusterName,
+		"node_names":   nodeNames,
+		"replace":      replace,
+	})
+	if !a.deleteNodes(ctx, clusterName, nodeNames, replace, &resp.Diagnostics) {
+		return
+	}
+
+	stopProgress := common.StartActionProgress(ctx, resp.SendProgress, fmt.Sprintf("Waiting for nodes to be removed from cluster %s", clusterName))
+	defer stopProgress()
+
+	a.pollClusterNodes(ctx, a, clusterName, nodeNames, &resp.Diagnostics,
+		func(_ map[string]struct{}, nodes []kubernetesengine.KubernetesEngineV1ApiListClusterNodesModelNodeResponseModel) bool {
+			return removal.done(nodes)
+		},
+	)
+}
+
+// clusterNodeRemoval tracks removed nodes by ID, since a replacement node may be given the name of
+// the node it replaces. With replace, it also waits for each affected node pool to list as many
+// nodes as before the removal, not counting the removed ones.
+type clusterNodeRemoval struct {
+	removedIds map[string]struct{}
+	poolSizes  map[string]int
+}
+
+func newClusterNodeRemoval(
+	nodeNames []string,
+	nodes []kubernetesengine.KubernetesEngineV1ApiListClusterNodesModelNodeResponseModel,
+	replace bool,
+) clusterNodeRemoval {
+	r := clusterNodeRemoval{removedIds: make(map[string]struct{}, len(nodeNames))}
+	pools := make(map[string]struct{})
+	for _, node := range nodes {
+		if slices.Contains(nodeNames, node.Name) {
+			r.removedIds[node.Id] = struct{}{}
+			pools[node.NodePoolName] = struct{}{}
+		}
+	}
+	if !replace {
+		return r
+	}
+
+	r.poolSizes = make(map[string]int, len(pools))
+	for _, node := range nodes {
+		if _, ok := pools[node.NodePoolName]; ok {
+			r.poolSizes[node.NodePoolName]++
+		}
+	}
+	return r
+}
+
+func (r clusterNodeRemoval) done(nodes []kubernetesengine.KubernetesEngineV1ApiListClusterNodesModelNodeResponseModel) bool {
+	counts := make(map[string]int, len(r.poolSizes))
+	for _, node := range nodes {
+		if _, ok := r.removedIds[node.Id]; ok {
+			return false
+		}
+		counts[node.NodePoolName]++
+	}
+	for pool, size := range r.poolSizes {
+		if counts[pool] < size {
+			return false
+		}
+	}
+	return true
+}
+
+func (a *clusterNodeRemoveAction) deleteNodes(ctx context.Context, clusterName string, nodeNames []string, replace bool, respDiags *diag.Diagnostics) bool {
+	body := kubernetesengine.DeleteK8sClusterNodesRequestModel{
+		Cluster: kubernetesengine.KubernetesEngineV1ApiDeleteClusterNodesModelClusterRequestModel{
+			IsRemove:  !replace,
+			NodeNames: nodeNames,
+		},
+	}
+
+	_, httpResp, err := common.ExecuteWithRetryAndAuth(ctx, a.kc, respDiags, common.ServiceKubernetesEngine,
+		func() (struct{}, *http.Response, error) {
+			httpResp, err := a.kc.ApiClient.ClustersAPI.
+				DeleteClusterNodes(ctx, clusterName).
+				XAuthToken(a.kc.XAuthToken).
+				DeleteK8sClusterNodesRequestModel(body).
+				Execute()
+			return struct{}{}, httpResp, err
+		},
+	)
+	if err != nil {
+		common.AddApiActionError(ctx, a, httpResp, "DeleteClusterNodes", err, respDiags)
+		return false
+	}
+	return true
+}
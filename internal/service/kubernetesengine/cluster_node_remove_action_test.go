@@ -0,0 +1,54 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+package kubernetesengine
+
+import (
+	"testing"
+
+	"github.com/kakaoenterprise/kc-sdk-go/services/kubernetesengine"
+)
+
+func testClusterNode(id, name, pool string) kubernetesengine.KubernetesEngineV1ApiListClusterNodesModelNodeResponseModel {
+	return kubernetesengine.KubernetesEngineV1ApiListClusterNodesModelNodeResponseModel{
+		Id:           id,
+		Name:         name,
+		NodePoolName: pool,
+	}
+}
+
+func TestClusterNodeRemoval(t *testing.T) {
+	before := []kubernetesengine.KubernetesEngineV1ApiListClusterNodesModelNodeResponseModel{
+		testClusterNode("id-1", "node-a", "pool-1"),
+		testClusterNode("id-2", "node-b", "pool-1"),
+		testClusterNode("id-3", "node-c", "pool-2"),
+	}
+
+	cases := []struct {
+		name    string
+		replace bool
+		nodes   []kubernetesengine.KubernetesEngineV1ApiListClusterNodesModelNodeResponseModel
+		want    bool
+	}{
+		{"node still listed", false, before, false},
+		{"node removed", false, before[1:], true},
+		{"replacement pending", true, before[1:], false},
+		{"replacement reuses the name", true, append([]kubernetesengine.KubernetesEngineV1ApiListClusterNodesModelNodeResponseModel{
+			testClusterNode("id-4", "node-a", "pool-1"),
+		}, before[1:]...), true},
+		{"replacement in another pool", true, append([]kubernetesengine.KubernetesEngineV1ApiListClusterNodesModelNodeResponseModel{
+			testClusterNode("id-4", "node-d", "pool-2"),
+		}, before[1:]...), false},
+		{"old node listed beside replacement", true, append([]kubernetesengine.KubernetesEngineV1ApiListClusterNodesModelNodeResponseModel{
+			testClusterNode("id-4", "node-d", "pool-1"),
+		}, before...), false},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			removal := newClusterNodeRemoval([]string{"node-a"}, before, tc.replace)
+			if got := removal.done(tc.nodes); got != tc.want {
+				t.Fatalf("got %v, want %v", got, tc.want)
+			}
+		})
+	}
+}
@@ -3,15 +3,7 @@
 package kubernetesengine
 
 import (
-	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
-	rschema "github.com/hashicorp/terraform-plugin-framework/resource/schema"
-	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
-	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
-	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setplanmodifier"
-	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
-	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
-	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
 func getNodeDataSourceSchema() map[string]schema.Attribute {
@@ -144,40 +136,4 @@ func getNodeSubnetSchemaAttributes() map[string]schema.Attribute {
 	}
 }
 
-func getNodeResourceSchema() map[string]rschema.Attribute {
-	return map[string]rschema.Attribute{
-		"cluster_name": rschema.StringAttribute{
-			Required: true,
-			PlanModifiers: []planmodifier.String{
-				stringplanmodifier.RequiresReplace(),
-			},
-		},
-		"node_names": rschema.SetAttribute{
-			ElementType: types.StringType,
-			Required:    true,
-			Validators: []validator.Set{
-				setvalidator.SizeAtLeast(1),
-			},
-			PlanModifiers: []planmodifier.Set{
-				setplanmodifier.RequiresReplace(),
-			},
-		},
-		"is_remove": rschema.BoolAttribute{
-			Optional:      true,
-			PlanModifiers: []planmodifier.Bool{boolplanmodifier.RequiresReplace()},
-		},
-		"is_cordon": rschema.BoolAttribute{
-			Optional:      true,
-			PlanModifiers: []planmodifier.Bool{boolplanmodifier.RequiresReplace()},
-		},
-		"id": rschema.StringAttribute{
-			Computed: true,
-			PlanModifiers: []planmodifier.String{
-				stringplanmodifier.UseStateForUnknown(),
-			},
-		},
-	}
-}
-
 var nodeDataSourceSchemaAttributes = getNodeDataSourceSchema()
-var nodeResourceSchemaAttributes = getNodeResourceSchema()
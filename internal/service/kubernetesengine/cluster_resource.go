@@ -127,7 +127,7 @@ func (r *clusterResource) Create(ctx context.Context, req resource.CreateRequest
 
 	body := kubernetesengine.CreateK8sClusterRequestModel{Cluster: createReq}
 
-	_, httpResp, err := common.ExecuteWithRetryAndAuth(ctx, r.kc, &resp.Diagnostics,
+	_, httpResp, err := common.ExecuteWithRetryAndAuth(ctx, r.kc, &resp.Diagnostics, common.ServiceKubernetesEngine,
 		func() (interface{}, *http.Response, error) {
 			return r.kc.ApiClient.ClustersAPI.
 				CreateCluster(ctx).
@@ -185,7 +185,7 @@ func (r *clusterResource) Read(ctx context.Context, req resource.ReadRequest, re
 	ctx, cancel := context.WithTimeout(ctx, timeout)
 	defer cancel()
 
-	respModel, httpResp, err := common.ExecuteWithRetryAndAuth(ctx, r.kc, &resp.Diagnostics,
+	respModel, httpResp, err := common.ExecuteWithRetryAndAuth(ctx, r.kc, &resp.Diagnostics, common.ServiceKubernetesEngine,
 		func() (*kubernetesengine.GetK8sClusterResponseModel, *http.Response, error) {
 			return r.kc.ApiClient.ClustersAPI.
 				GetCluster(ctx, state.Name.ValueString()).
@@ -261,7 +261,7 @@ func (r *clusterResource) Update(ctx context.Context, req resource.UpdateRequest
 		editReq.SetDescription(plan.Description.ValueString())
 
 		body := *kubernetesengine.NewUpdateK8sClusterRequestModel(editReq)
-		_, httpResp, err := common.ExecuteWithRetryAndAuth(ctx, r.kc, &resp.Diagnostics,
+		_, httpResp, err := common.ExecuteWithRetryAndAuth(ctx, r.kc, &resp.Diagnostics, common.ServiceKubernetesEngine,
 			func() (interface{}, *http.Response, error) {
 				return r.kc.ApiClient.ClustersAPI.UpdateCluster(ctx, plan.Name.ValueString()).
 					XAuthToken(r.kc.XAuthToken).
@@ -291,7 +291,7 @@ func (r *clusterResource) Update(ctx context.Context, req resource.UpdateRequest
 	}
 
 	if !planVer.MinorVersion.Equal(stateVer.MinorVersion) {
-		_, httpResp, err := common.ExecuteWithRetryAndAuth(ctx, r.kc, &resp.Diagnostics,
+		_, httpResp, err := common.ExecuteWithRetryAndAuth(ctx, r.kc, &resp.Diagnostics, common.ServiceKubernetesEngine,
 			func() (interface{}, *http.Response, error) {
 				return r.kc.ApiClient.ClustersAPI.UpgradeCluster(ctx, plan.Name.ValueString()).
 					XAuthToken(r.kc.XAuthToken).
@@ -361,7 +361,7 @@ func (r *clusterResource) Delete(ctx context.Context, req resource.DeleteRequest
 	ctx, cancel := context.WithTimeout(ctx, timeout)
 	defer cancel()
 
-	_, httpResp, err := common.ExecuteWithRetryAndAuth(ctx, r.kc, &resp.Diagnostics,
+	_, httpResp, err := common.ExecuteWithRetryAndAuth(ctx, r.kc, &resp.Diagnostics, common.ServiceKubernetesEngine,
 		func() (interface{}, *http.Response, error) {
 			httpResp, err := r.kc.ApiClient.ClustersAPI.DeleteCluster(ctx, state.Name.ValueString()).
 				XAuthToken(r.kc.XAuthToken).
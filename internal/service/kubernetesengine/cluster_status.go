@@ -27,7 +27,7 @@ func (r *clusterResource) pollClusterUtilStatus(
 		targetStatuses,
 		diag,
 		func(ctx context.Context) (*kubernetesengine.KubernetesEngineV1ApiGetClusterModelClusterResponseModel, *http.Response, error) {
-			respModel, httpResp, err := common.ExecuteWithRetryAndAuth(ctx, r.kc, diag,
+			respModel, httpResp, err := common.ExecuteWithRetryAndAuth(ctx, r.kc, diag, common.ServiceKubernetesEngine,
 				func() (*kubernetesengine.GetK8sClusterResponseModel, *http.Response, error) {
 					return r.kc.ApiClient.ClustersAPI.
 						GetCluster(ctx, clusterName).
@@ -60,7 +60,7 @@ func (r *nodePoolResource) pollClusterUtilAvailableStatus(
 		[]string{common.ClusterStatusProvisioned, common.ClusterStatusFailed, common.ClusterStatusDeleting},
 		diag,
 		func(ctx context.Context) (*kubernetesengine.KubernetesEngineV1ApiGetClusterModelClusterResponseModel, *http.Response, error) {
-			respModel, httpResp, err := common.ExecuteWithRetryAndAuth(ctx, r.kc, diag,
+			respModel, httpResp, err := common.ExecuteWithRetryAndAuth(ctx, r.kc, diag, common.ServiceKubernetesEngine,
 				func() (*kubernetesengine.GetK8sClusterResponseModel, *http.Response, error) {
 					return r.kc.ApiClient.ClustersAPI.
 						GetCluster(ctx, clusterName).
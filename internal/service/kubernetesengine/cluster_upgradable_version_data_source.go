@@ -94,7 +94,7 @@ func (d *upgradableVersionDataSource) Read(ctx context.Context, req datasource.R
 	ctx, cancel := context.WithTimeout(ctx, timeout)
 	defer cancel()
 
-	respModel, httpResp, err := common.ExecuteWithRetryAndAuth(ctx, d.kc, &resp.Diagnostics,
+	respModel, httpResp, err := common.ExecuteWithRetryAndAuth(ctx, d.kc, &resp.Diagnostics, common.ServiceKubernetesEngine,
 		func() (*kubernetesengine.GetK8sClusterUpgradableVersionsResponseModel, *http.Response, error) {
 			return d.kc.ApiClient.ClustersAPI.
 				ListClusterUpgradableVersions(ctx, config.ClusterName.ValueString()).
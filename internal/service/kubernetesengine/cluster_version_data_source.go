@@ -62,7 +62,7 @@ func (d *kubernetesVersionsDataSource) Read(ctx context.Context, req datasource.
 	ctx, cancel := context.WithTimeout(ctx, timeout)
 	defer cancel()
 
-	modelResp, httpResp, err := common.ExecuteWithRetryAndAuth(ctx, d.kc, &resp.Diagnostics,
+	modelResp, httpResp, err := common.ExecuteWithRetryAndAuth(ctx, d.kc, &resp.Diagnostics, common.ServiceKubernetesEngine,
 		func() (*kubernetesengine.GetK8sUpgradeVersionsResponseModel, *http.Response, error) {
 			return d.kc.ApiClient.UpgradesAPI.
 				ListAvailableKubernetesVersions(ctx).
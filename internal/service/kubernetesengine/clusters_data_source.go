@@ -90,7 +90,7 @@ func (d *clustersDataSource) Read(ctx context.Context, req datasource.ReadReques
 	ctx, cancel := context.WithTimeout(ctx, timeout)
 	defer cancel()
 
-	clusterResp, httpResp, err := common.ExecuteWithRetryAndAuth(ctx, d.kc, &resp.Diagnostics,
+	clusterResp, httpResp, err := common.ExecuteWithRetryAndAuth(ctx, d.kc, &resp.Diagnostics, common.ServiceKubernetesEngine,
 		func() (*kubernetesengine.GetK8sClustersResponseModel, *http.Response, error) {
 			return d.kc.ApiClient.ClustersAPI.ListClusters(ctx).XAuthToken(d.kc.XAuthToken).Execute()
 		},
@@ -147,7 +147,7 @@ func (d *kubernetesImagesDataSource) Read(ctx context.Context, req datasource.Re
 		return
 	}
 
-	imageResp, httpResp, err := common.ExecuteWithRetryAndAuth(ctx, d.kc, &resp.Diagnostics,
+	imageResp, httpResp, err := common.ExecuteWithRetryAndAuth(ctx, d.kc, &resp.Diagnostics, common.ServiceKubernetesEngine,
 		func() (*kubernetesengine.GetK8sImagesResponseModel, *http.Response, error) {
 			return imageApi.XAuthToken(d.kc.XAuthToken).Execute()
 		},
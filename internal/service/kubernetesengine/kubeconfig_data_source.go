@@ -61,7 +61,7 @@ func (d *kubernetesKubeconfigDataSource) Read(ctx context.Context, req datasourc
 
 	clusterName := config.ClusterName.ValueString()
 
-	kubeYAML, httpResp, err := common.ExecuteWithRetryAndAuth(ctx, d.kc, &resp.Diagnostics,
+	kubeYAML, httpResp, err := common.ExecuteWithRetryAndAuth(ctx, d.kc, &resp.Diagnostics, common.ServiceKubernetesEngine,
 		func() (string, *http.Response, error) {
 			return d.kc.ApiClient.
 				ClustersAPI.
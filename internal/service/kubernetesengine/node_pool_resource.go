@@ -237,7 +237,7 @@ func (r *nodePoolResource) Create(ctx context.Context, req resource.CreateReques
 		}
 	}
 
-	_, httpRespCreate, errCreate := common.ExecuteWithRetryAndAuth(ctx, r.kc, &resp.Diagnostics, func() (interface{}, *http.Response, error) {
+	_, httpRespCreate, errCreate := common.ExecuteWithRetryAndAuth(ctx, r.kc, &resp.Diagnostics, common.ServiceKubernetesEngine, func() (interface{}, *http.Response, error) {
 		return r.kc.ApiClient.NodePoolsAPI.
 			CreateNodePool(ctx, plan.ClusterName.ValueString()).
 			XAuthToken(r.kc.XAuthToken).
@@ -293,7 +293,7 @@ func (r *nodePoolResource) Read(ctx context.Context, req resource.ReadRequest, r
 	ctx, cancel := context.WithTimeout(ctx, timeout)
 	defer cancel()
 
-	detail, httpResp, err := common.ExecuteWithRetryAndAuth(ctx, r.kc, &resp.Diagnostics,
+	detail, httpResp, err := common.ExecuteWithRetryAndAuth(ctx, r.kc, &resp.Diagnostics, common.ServiceKubernetesEngine,
 		func() (*kubernetesengine.GetK8sClusterNodePoolResponseModel, *http.Response, error) {
 			return r.kc.ApiClient.NodePoolsAPI.
 				GetNodePool(ctx, state.ClusterName.ValueString(), state.Name.ValueString()).
@@ -333,7 +333,7 @@ func (r *nodePoolResource) Read(ctx context.Context, req resource.ReadRequest, r
 			userSGs := make([]string, 0, len(sgIDs))
 
 			for _, sgID := range sgIDs {
-				sgResp, httpResp, e := common.ExecuteWithRetryAndAuth(ctx, r.kc, &resp.Diagnostics,
+				sgResp, httpResp, e := common.ExecuteWithRetryAndAuth(ctx, r.kc, &resp.Diagnostics, common.ServiceNetwork,
 					func() (*network.BnsNetworkV1ApiGetSecurityGroupModelResponseSecurityGroupModel, *http.Response, error) {
 						return r.kc.ApiClient.SecurityGroupAPI.
 							GetSecurityGroup(ctx, sgID).
@@ -413,7 +413,7 @@ func (r *nodePoolResource) Update(ctx context.Context, req resource.UpdateReques
 			return
 		}
 
-		_, httpResp, err := common.ExecuteWithRetryAndAuth(ctx, r.kc, &resp.Diagnostics,
+		_, httpResp, err := common.ExecuteWithRetryAndAuth(ctx, r.kc, &resp.Diagnostics, common.ServiceKubernetesEngine,
 			func() (interface{}, *http.Response, error) {
 				return r.kc.ApiClient.NodePoolsAPI.UpgradeNodePool(ctx, plan.ClusterName.ValueString(), plan.Name.ValueString()).
 					XAuthToken(r.kc.XAuthToken).
@@ -443,7 +443,7 @@ func (r *nodePoolResource) Update(ctx context.Context, req resource.UpdateReques
 		script := kubernetesengine.NewNodePoolScriptRequestModel(userData)
 		usrBody := kubernetesengine.UpdateK8sClusterNodePoolUserScriptRequestModel{NodePool: *script}
 
-		_, httpResp, err := common.ExecuteWithRetryAndAuth(ctx, r.kc, &resp.Diagnostics, func() (interface{}, *http.Response, error) {
+		_, httpResp, err := common.ExecuteWithRetryAndAuth(ctx, r.kc, &resp.Diagnostics, common.ServiceKubernetesEngine, func() (interface{}, *http.Response, error) {
 			return r.kc.ApiClient.NodePoolsAPI.
 				SetNodePoolUserScript(ctx, plan.ClusterName.ValueString(), plan.Name.ValueString()).
 				XAuthToken(r.kc.XAuthToken).
@@ -522,7 +522,7 @@ func (r *nodePoolResource) Update(ctx context.Context, req resource.UpdateReques
 
 	if needUpdate {
 		reqBody := kubernetesengine.UpdateK8sClusterNodePoolRequestModel{NodePool: *upd}
-		_, httpResp, err := common.ExecuteWithRetryAndAuth(ctx, r.kc, &resp.Diagnostics, func() (interface{}, *http.Response, error) {
+		_, httpResp, err := common.ExecuteWithRetryAndAuth(ctx, r.kc, &resp.Diagnostics, common.ServiceKubernetesEngine, func() (interface{}, *http.Response, error) {
 			return r.kc.ApiClient.NodePoolsAPI.
 				UpdateNodePool(ctx, plan.ClusterName.ValueString(), plan.Name.ValueString()).
 				XAuthToken(r.kc.XAuthToken).
@@ -598,7 +598,7 @@ func (r *nodePoolResource) Update(ctx context.Context, req resource.UpdateReques
 			labelsReq.SetRemoveLabelKeys(removeKeys)
 		}
 		body := kubernetesengine.NewUpdateK8sClusterNodePoolNodeLabelsRequestModel(*labelsReq)
-		_, httpResp, err := common.ExecuteWithRetryAndAuth(ctx, r.kc, &resp.Diagnostics, func() (interface{}, *http.Response, error) {
+		_, httpResp, err := common.ExecuteWithRetryAndAuth(ctx, r.kc, &resp.Diagnostics, common.ServiceKubernetesEngine, func() (interface{}, *http.Response, error) {
 			return r.kc.ApiClient.NodePoolsAPI.
 				SetNodePoolNodeLabel(ctx, plan.ClusterName.ValueString(), state.Name.ValueString()).
 				XAuthToken(r.kc.XAuthToken).
@@ -646,7 +646,7 @@ func (r *nodePoolResource) Delete(ctx context.Context, req resource.DeleteReques
 	ctx, cancel := context.WithTimeout(ctx, timeout)
 	defer cancel()
 
-	result, httpResp, err := common.ExecuteWithRetryAndAuth(ctx, r.kc, &resp.Diagnostics,
+	result, httpResp, err := common.ExecuteWithRetryAndAuth(ctx, r.kc, &resp.Diagnostics, common.ServiceKubernetesEngine,
 		func() (*kubernetesengine.GetK8sClusterNodePoolResponseModel, *http.Response, error) {
 			return r.kc.ApiClient.NodePoolsAPI.
 				GetNodePool(ctx, state.ClusterName.ValueString(), state.Name.ValueString()).
@@ -678,7 +678,7 @@ func (r *nodePoolResource) Delete(ctx context.Context, req resource.DeleteReques
 			}
 		}
 
-		_, httpResp, err := common.ExecuteWithRetryAndAuth(ctx, r.kc, &resp.Diagnostics,
+		_, httpResp, err := common.ExecuteWithRetryAndAuth(ctx, r.kc, &resp.Diagnostics, common.ServiceKubernetesEngine,
 			func() (interface{}, *http.Response, error) {
 				httpResp, err := r.kc.ApiClient.NodePoolsAPI.DeleteNodePool(ctx, state.ClusterName.ValueString(), state.Name.ValueString()).XAuthToken(r.kc.XAuthToken).Execute()
 				return nil, httpResp, err
@@ -694,7 +694,7 @@ func (r *nodePoolResource) Delete(ctx context.Context, req resource.DeleteReques
 	}
 
 	common.PollUntilDeletion(ctx, r, 10*time.Second, &resp.Diagnostics, func(ctx context.Context) (bool, *http.Response, error) {
-		_, httpResp, err := common.ExecuteWithRetryAndAuth(ctx, r.kc, &resp.Diagnostics,
+		_, httpResp, err := common.ExecuteWithRetryAndAuth(ctx, r.kc, &resp.Diagnostics, common.ServiceKubernetesEngine,
 			func() (interface{}, *http.Response, error) {
 				_, hr, err := r.kc.ApiClient.NodePoolsAPI.
 					GetNodePool(ctx, state.ClusterName.ValueString(), state.Name.ValueString()).
@@ -778,7 +778,7 @@ func (r *nodePoolResource) waitNodePoolSecurityGroupsContains(
 		[]string{"ok"},
 		diagnostics,
 		func(ctx context.Context) (*kubernetesengine.KubernetesEngineV1ApiGetNodePoolModelNodePoolResponseModel, *http.Response, error) {
-			model, httpResp, err := common.ExecuteWithRetryAndAuth(ctx, r.kc, diagnostics,
+			model, httpResp, err := common.ExecuteWithRetryAndAuth(ctx, r.kc, diagnostics, common.ServiceKubernetesEngine,
 				func() (struct {
 					NodePool kubernetesengine.KubernetesEngineV1ApiGetNodePoolModelNodePoolResponseModel
 				}, *http.Response, error) {
@@ -850,7 +850,7 @@ func (r *nodePoolResource) getInstanceTypeFromFlavor(
 	flavorId string,
 	respDiags *diag.Diagnostics,
 ) (*bcs.InstanceType, bool) {
-	flavorResp, httpResp, err := common.ExecuteWithRetryAndAuth(ctx, r.kc, respDiags,
+	flavorResp, httpResp, err := common.ExecuteWithRetryAndAuth(ctx, r.kc, respDiags, common.ServiceBCS,
 		func() (*bcs.ResponseFlavorModel, *http.Response, error) {
 			return r.kc.ApiClient.FlavorAPI.GetInstanceType(ctx, flavorId).XAuthToken(r.kc.XAuthToken).Execute()
 		},
@@ -881,7 +881,7 @@ func (r *nodePoolResource) updateAutoScaling(
 	}
 
 	updBody := kubernetesengine.NewUpdateKubernetesEngineClusterNodePoolScalingResourceRequestModel(*scaling)
-	_, httpResp, err := common.ExecuteWithRetryAndAuth(ctx, r.kc, diags, func() (interface{}, *http.Response, error) {
+	_, httpResp, err := common.ExecuteWithRetryAndAuth(ctx, r.kc, diags, common.ServiceKubernetesEngine, func() (interface{}, *http.Response, error) {
 		return r.kc.ApiClient.ScalingAPI.
 			SetNodePoolResourceBasedAutoScaling(ctx, plan.ClusterName.ValueString(), plan.Name.ValueString()).
 			XAuthToken(r.kc.XAuthToken).
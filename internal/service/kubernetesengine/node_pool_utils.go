@@ -73,7 +73,7 @@ func pollNodePool(
 		targetStatuses,
 		diags,
 		func(ctx context.Context) (*kubernetesengine.KubernetesEngineV1ApiGetNodePoolModelNodePoolResponseModel, *http.Response, error) {
-			model, httpResp, err := common.ExecuteWithRetryAndAuth(ctx, client, diags,
+			model, httpResp, err := common.ExecuteWithRetryAndAuth(ctx, client, diags, common.ServiceKubernetesEngine,
 				func() (*kubernetesengine.GetK8sClusterNodePoolResponseModel, *http.Response, error) {
 					return client.ApiClient.NodePoolsAPI.
 						GetNodePool(ctx, clusterName, nodePoolName).
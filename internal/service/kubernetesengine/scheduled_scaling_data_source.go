@@ -93,7 +93,7 @@ func (d *scheduledScalingDataSource) Read(ctx context.Context, req datasource.Re
 	nodePoolName := config.NodePoolName.ValueString()
 	scheduleName := config.Name.ValueString()
 
-	modelResp, httpResp, err := common.ExecuteWithRetryAndAuth(ctx, d.kc, &resp.Diagnostics,
+	modelResp, httpResp, err := common.ExecuteWithRetryAndAuth(ctx, d.kc, &resp.Diagnostics, common.ServiceKubernetesEngine,
 		func() (*kubernetesengine.GetK8sClusterNodePoolScalingScheduleResponseModel, *http.Response, error) {
 			return d.kc.ApiClient.ScalingAPI.ListNodePoolScheduledScalings(ctx, clusterName, nodePoolName).
 				XAuthToken(d.kc.XAuthToken).Execute()
@@ -119,7 +119,7 @@ func (r *scheduledScalingResource) Create(ctx context.Context, req resource.Crea
 		ScheduledScaling: createReq,
 	}
 
-	_, httpResp, err := common.ExecuteWithRetryAndAuth(ctx, r.kc, &resp.Diagnostics,
+	_, httpResp, err := common.ExecuteWithRetryAndAuth(ctx, r.kc, &resp.Diagnostics, common.ServiceKubernetesEngine,
 		func() (interface{}, *http.Response, error) {
 			return r.kc.ApiClient.ScalingAPI.
 				CreateNodePoolScheduledScaling(ctx, clusterName, nodePoolName).
@@ -142,7 +142,7 @@ func (r *scheduledScalingResource) Create(ctx context.Context, req resource.Crea
 		[]string{"found"},
 		&resp.Diagnostics,
 		func(ctx context.Context) (scheduleLookup, *http.Response, error) {
-			modelResp, httpResp, err := common.ExecuteWithRetryAndAuth(ctx, r.kc, &resp.Diagnostics,
+			modelResp, httpResp, err := common.ExecuteWithRetryAndAuth(ctx, r.kc, &resp.Diagnostics, common.ServiceKubernetesEngine,
 				func() (*kubernetesengine.GetK8sClusterNodePoolScalingScheduleResponseModel, *http.Response, error) {
 					return r.kc.ApiClient.ScalingAPI.
 						ListNodePoolScheduledScalings(ctx, clusterName, nodePoolName).
@@ -208,7 +208,7 @@ func (r *scheduledScalingResource) Read(ctx context.Context, req resource.ReadRe
 	nodePoolName := state.NodePoolName.ValueString()
 	nodeName := state.Name.ValueString()
 
-	respModel, httpResp, err := common.ExecuteWithRetryAndAuth(ctx, r.kc, &resp.Diagnostics,
+	respModel, httpResp, err := common.ExecuteWithRetryAndAuth(ctx, r.kc, &resp.Diagnostics, common.ServiceKubernetesEngine,
 		func() (*kubernetesengine.GetK8sClusterNodePoolScalingScheduleResponseModel, *http.Response, error) {
 			return r.kc.ApiClient.ScalingAPI.
 				ListNodePoolScheduledScalings(ctx, clusterName, nodePoolName).
@@ -278,7 +278,7 @@ func (r *scheduledScalingResource) Delete(ctx context.Context, req resource.Dele
 	nodePoolName := state.NodePoolName.ValueString()
 	scheduleName := state.Name.ValueString()
 
-	_, httpResp, err := common.ExecuteWithRetryAndAuth(ctx, r.kc, &resp.Diagnostics,
+	_, httpResp, err := common.ExecuteWithRetryAndAuth(ctx, r.kc, &resp.Diagnostics, common.ServiceKubernetesEngine,
 		func() (interface{}, *http.Response, error) {
 			httpResp, err := r.kc.ApiClient.ScalingAPI.
 				DeleteNodePoolScheduledScaling(ctx, clusterName, nodePoolName, scheduleName).
@@ -296,7 +296,7 @@ func (r *scheduledScalingResource) Delete(ctx context.Context, req resource.Dele
 	}
 
 	common.PollUntilDeletion(ctx, r, 2*time.Second, &resp.Diagnostics, func(ctx context.Context) (bool, *http.Response, error) {
-		modelResp, httpResp, err := common.ExecuteWithRetryAndAuth(ctx, r.kc, &resp.Diagnostics,
+		modelResp, httpResp, err := common.ExecuteWithRetryAndAuth(ctx, r.kc, &resp.Diagnostics, common.ServiceKubernetesEngine,
 			func() (*kubernetesengine.GetK8sClusterNodePoolScalingScheduleResponseModel, *http.Response, error) {
 				return r.kc.ApiClient.ScalingAPI.
 					ListNodePoolScheduledScalings(ctx, clusterName, nodePoolName).
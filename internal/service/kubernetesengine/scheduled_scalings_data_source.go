@@ -104,7 +104,7 @@ func (d *scheduledScalingsDataSource) Read(ctx context.Context, req datasource.R
 	clusterName := config.ClusterName.ValueString()
 	nodePoolName := config.NodePoolName.ValueString()
 
-	modelResp, httpResp, err := common.ExecuteWithRetryAndAuth(ctx, d.kc, &resp.Diagnostics,
+	modelResp, httpResp, err := common.ExecuteWithRetryAndAuth(ctx, d.kc, &resp.Diagnostics, common.ServiceKubernetesEngine,
 		func() (*kubernetesengine.GetK8sClusterNodePoolScalingScheduleResponseModel, *http.Response, error) {
 			return d.kc.ApiClient.ScalingAPI.ListNodePoolScheduledScalings(ctx, clusterName, nodePoolName).
 				XAuthToken(d.kc.XAuthToken).Execute()
@@ -66,7 +66,7 @@ func (d *beyondLoadBalancerDataSource) Read(ctx context.Context, req datasource.
 	ctx, cancel := context.WithTimeout(ctx, timeout)
 	defer cancel()
 
-	blbResp, httpResp, err := common.ExecuteWithRetryAndAuth(ctx, d.kc, &resp.Diagnostics,
+	blbResp, httpResp, err := common.ExecuteWithRetryAndAuth(ctx, d.kc, &resp.Diagnostics, common.ServiceLoadBalancer,
 		func() (*loadbalancer.BnsLoadBalancerV1ApiGetHaGroupModelResponseBeyondLoadBalancerModel, *http.Response, error) {
 			return d.kc.ApiClient.BeyondLoadBalancerAPI.GetHaGroup(ctx, data.Id.ValueString()).XAuthToken(d.kc.XAuthToken).Execute()
 		},
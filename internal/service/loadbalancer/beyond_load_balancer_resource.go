@@ -117,7 +117,7 @@ func (r *beyondLoadBalancerResource) Create(ctx context.Context, req resource.Cr
 
 	body := *loadbalancer.NewBodyCreateHaGroup(createReq)
 
-	respModel, httpResp, err := common.ExecuteWithRetryAndAuth(ctx, r.kc, &resp.Diagnostics,
+	respModel, httpResp, err := common.ExecuteWithRetryAndAuth(ctx, r.kc, &resp.Diagnostics, common.ServiceLoadBalancer,
 		func() (*loadbalancer.BnsLoadBalancerV1ApiCreateHaGroupModelResponseBeyondLoadBalancerModel, *http.Response, error) {
 			return r.kc.ApiClient.BeyondLoadBalancerAPI.CreateHaGroup(ctx).
 				XAuthToken(r.kc.XAuthToken).BodyCreateHaGroup(body).Execute()
@@ -175,7 +175,7 @@ func (r *beyondLoadBalancerResource) Read(ctx context.Context, req resource.Read
 	ctx, cancel := context.WithTimeout(ctx, timeout)
 	defer cancel()
 
-	respModel, httpResp, err := common.ExecuteWithRetryAndAuth(ctx, r.kc, &resp.Diagnostics,
+	respModel, httpResp, err := common.ExecuteWithRetryAndAuth(ctx, r.kc, &resp.Diagnostics, common.ServiceLoadBalancer,
 		func() (*loadbalancer.BnsLoadBalancerV1ApiGetHaGroupModelResponseBeyondLoadBalancerModel, *http.Response, error) {
 			return r.kc.ApiClient.BeyondLoadBalancerAPI.GetHaGroup(ctx, state.Id.ValueString()).
 				XAuthToken(r.kc.XAuthToken).Execute()
@@ -271,7 +271,7 @@ func (r *beyondLoadBalancerResource) Update(ctx context.Context, req resource.Up
 
 		body := *loadbalancer.NewBodyUpdateHaGroup(editReq)
 
-		_, httpResp, err := common.ExecuteWithRetryAndAuth(ctx, r.kc, &resp.Diagnostics,
+		_, httpResp, err := common.ExecuteWithRetryAndAuth(ctx, r.kc, &resp.Diagnostics, common.ServiceLoadBalancer,
 			func() (interface{}, *http.Response, error) {
 				return r.kc.ApiClient.BeyondLoadBalancerAPI.UpdateHaGroup(ctx, plan.Id.ValueString()).
 					XAuthToken(r.kc.XAuthToken).
@@ -382,7 +382,7 @@ func (r *beyondLoadBalancerResource) Delete(ctx context.Context, req resource.De
 	mutex.Lock()
 	defer mutex.Unlock()
 
-	_, httpResp, err := common.ExecuteWithRetryAndAuth(ctx, r.kc, &resp.Diagnostics,
+	_, httpResp, err := common.ExecuteWithRetryAndAuth(ctx, r.kc, &resp.Diagnostics, common.ServiceLoadBalancer,
 		func() (interface{}, *http.Response, error) {
 			httpResp, err := r.kc.ApiClient.BeyondLoadBalancerAPI.DeleteHaGroup(ctx, state.Id.ValueString()).
 				XAuthToken(r.kc.XAuthToken).
@@ -451,7 +451,7 @@ func (r *beyondLoadBalancerResource) pollBeyondLoadBalancerUntilStatus(
 		targetStatuses,
 		resp,
 		func(ctx context.Context) (*loadbalancer.BnsLoadBalancerV1ApiGetHaGroupModelBeyondLoadBalancerModel, *http.Response, error) {
-			respModel, httpResp, err := common.ExecuteWithRetryAndAuth(ctx, r.kc, resp,
+			respModel, httpResp, err := common.ExecuteWithRetryAndAuth(ctx, r.kc, resp, common.ServiceLoadBalancer,
 				func() (*loadbalancer.BnsLoadBalancerV1ApiGetHaGroupModelResponseBeyondLoadBalancerModel, *http.Response, error) {
 					return r.kc.ApiClient.BeyondLoadBalancerAPI.
 						GetHaGroup(ctx, blbId).
@@ -485,7 +485,7 @@ func (r *beyondLoadBalancerResource) pollLoadBalancerUntilStatus(
 		targetStatuses,
 		resp,
 		func(ctx context.Context) (*loadbalancer.BnsLoadBalancerV1ApiGetLoadBalancerModelLoadBalancerModel, *http.Response, error) {
-			respModel, httpResp, err := common.ExecuteWithRetryAndAuth(ctx, r.kc, resp,
+			respModel, httpResp, err := common.ExecuteWithRetryAndAuth(ctx, r.kc, resp, common.ServiceLoadBalancer,
 				func() (*loadbalancer.BnsLoadBalancerV1ApiGetLoadBalancerModelResponseLoadBalancerModel, *http.Response, error) {
 					return r.kc.ApiClient.LoadBalancerAPI.
 						GetLoadBalancer(ctx, loadBalancerId).
@@ -541,7 +541,7 @@ func (r *beyondLoadBalancerResource) updateLoadBalancers(
 		BeyondLoadBalancer: *loadbalancer.NewBnsLoadBalancerV1ApiUpdateHaGroupLoadBalancerModelCreateBeyondLoadBalancerModel(lbs),
 	}
 
-	_, httpResp, err := common.ExecuteWithRetryAndAuth(ctx, r.kc, diags,
+	_, httpResp, err := common.ExecuteWithRetryAndAuth(ctx, r.kc, diags, common.ServiceLoadBalancer,
 		func() (interface{}, *http.Response, error) {
 			return r.kc.ApiClient.BeyondLoadBalancerAPI.
 				UpdateHaGroupLoadBalancer(ctx, blbId).
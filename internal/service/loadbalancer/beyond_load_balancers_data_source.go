@@ -178,9 +178,12 @@ func (d *beyondLoadBalancersDataSource) Read(ctx context.Context, req datasource
 	if resp.Diagnostics.HasError() {
 		return
 	}
-	blbResp, httpResp, err := common.ExecuteWithRetryAndAuth(ctx, d.kc, &resp.Diagnostics,
-		func() (*loadbalancer.BeyondLoadBalancerListModel, *http.Response, error) {
-			return blbApi.Limit(1000).XAuthToken(d.kc.XAuthToken).Execute()
+	blbPages, httpResp, err := common.ListAllPagesPartial(ctx, d.kc, d, &resp.Diagnostics, common.ServiceLoadBalancer,
+		func(limit int32, offset int32) (*loadbalancer.BeyondLoadBalancerListModel, *http.Response, error) {
+			return blbApi.Limit(limit).Offset(offset).XAuthToken(d.kc.XAuthToken).Execute()
+		},
+		func(page *loadbalancer.BeyondLoadBalancerListModel) int {
+			return len(page.BeyondLoadBalancers)
 		},
 	)
 	if err != nil {
@@ -189,11 +192,15 @@ func (d *beyondLoadBalancersDataSource) Read(ctx context.Context, req datasource
 	}
 
 	var blbsResult []loadbalancer.BnsLoadBalancerV1ApiGetHaGroupModelBeyondLoadBalancerModel
-	err = copier.Copy(&blbsResult, &blbResp.BeyondLoadBalancers)
-	if err != nil {
-		common.AddGeneralError(ctx, d, &resp.Diagnostics,
-			fmt.Sprintf("Failed to convert lblsResult: %v", err))
-		return
+	for _, blbResp := range blbPages {
+		var pageResult []loadbalancer.BnsLoadBalancerV1ApiGetHaGroupModelBeyondLoadBalancerModel
+		err = copier.Copy(&pageResult, &blbResp.BeyondLoadBalancers)
+		if err != nil {
+			common.AddGeneralError(ctx, d, &resp.Diagnostics,
+				fmt.Sprintf("Failed to convert lblsResult: %v", err))
+			return
+		}
+		blbsResult = append(blbsResult, pageResult...)
 	}
 
 	for _, v := range blbsResult {
@@ -81,7 +81,7 @@ func (d *loadBalancerDataSource) Read(ctx context.Context, req datasource.ReadRe
 	ctx, cancel := context.WithTimeout(ctx, timeout)
 	defer cancel()
 
-	lb, httpResp, err := common.ExecuteWithRetryAndAuth(ctx, d.kc, &resp.Diagnostics,
+	lb, httpResp, err := common.ExecuteWithRetryAndAuth(ctx, d.kc, &resp.Diagnostics, common.ServiceLoadBalancer,
 		func() (*loadbalancer.BnsLoadBalancerV1ApiGetLoadBalancerModelResponseLoadBalancerModel, *http.Response, error) {
 			return d.kc.ApiClient.LoadBalancerAPI.GetLoadBalancer(ctx, data.Id.ValueString()).XAuthToken(d.kc.XAuthToken).Execute()
 		},
@@ -71,7 +71,7 @@ func (d *loadBalancerHealthMonitorDataSource) Read(ctx context.Context, req data
 		return
 	}
 
-	healthMonitor, httpResp, err := common.ExecuteWithRetryAndAuth(ctx, d.kc, &resp.Diagnostics,
+	healthMonitor, httpResp, err := common.ExecuteWithRetryAndAuth(ctx, d.kc, &resp.Diagnostics, common.ServiceLoadBalancer,
 		func() (*loadbalancer.BnsLoadBalancerV1ApiGetTargetGroupHealthMonitorModelResponseHealthMonitorModel, *http.Response, error) {
 			return d.kc.ApiClient.LoadBalancerTargetGroupAPI.
 				GetTargetGroupHealthMonitor(ctx, data.Id.ValueString()).
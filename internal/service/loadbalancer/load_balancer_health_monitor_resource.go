@@ -97,7 +97,7 @@ func (r *loadBalancerHealthMonitorResource) Create(ctx context.Context, req reso
 
 	body := *loadbalancer.NewBodyCreateHealthMonitor(*createReq)
 
-	respModel, httpResp, err := common.ExecuteWithRetryAndAuth(ctx, r.kc, &resp.Diagnostics,
+	respModel, httpResp, err := common.ExecuteWithRetryAndAuth(ctx, r.kc, &resp.Diagnostics, common.ServiceLoadBalancer,
 		func() (*loadbalancer.BnsLoadBalancerV1ApiCreateHealthMonitorModelResponseHealthMonitorModel, *http.Response, error) {
 			return r.kc.ApiClient.LoadBalancerTargetGroupAPI.CreateHealthMonitor(ctx).XAuthToken(r.kc.XAuthToken).BodyCreateHealthMonitor(body).Execute()
 		},
@@ -145,7 +145,7 @@ func (r *loadBalancerHealthMonitorResource) Read(ctx context.Context, req resour
 	ctx, cancel := context.WithTimeout(ctx, timeout)
 	defer cancel()
 
-	healthMonitor, httpResp, err := common.ExecuteWithRetryAndAuth(ctx, r.kc, &resp.Diagnostics,
+	healthMonitor, httpResp, err := common.ExecuteWithRetryAndAuth(ctx, r.kc, &resp.Diagnostics, common.ServiceLoadBalancer,
 		func() (*loadbalancer.BnsLoadBalancerV1ApiGetTargetGroupHealthMonitorModelResponseHealthMonitorModel, *http.Response, error) {
 			return r.kc.ApiClient.LoadBalancerTargetGroupAPI.
 				GetTargetGroupHealthMonitor(ctx, state.Id.ValueString()).
@@ -238,7 +238,7 @@ func (r *loadBalancerHealthMonitorResource) Update(ctx context.Context, req reso
 
 	body := loadbalancer.NewBodyUpdateHealthMonitor(*updateReq)
 
-	_, httpResp, err := common.ExecuteWithRetryAndAuth(ctx, r.kc, &resp.Diagnostics,
+	_, httpResp, err := common.ExecuteWithRetryAndAuth(ctx, r.kc, &resp.Diagnostics, common.ServiceLoadBalancer,
 		func() (*loadbalancer.BnsLoadBalancerV1ApiUpdateHealthMonitorModelResponseHealthMonitorModel, *http.Response, error) {
 			return r.kc.ApiClient.LoadBalancerTargetGroupAPI.
 				UpdateHealthMonitor(ctx, state.Id.ValueString()).
@@ -315,7 +315,7 @@ func (r *loadBalancerHealthMonitorResource) Delete(ctx context.Context, req reso
 		return
 	}
 
-	_, httpResp, err := common.ExecuteWithRetryAndAuth(ctx, r.kc, &resp.Diagnostics,
+	_, httpResp, err := common.ExecuteWithRetryAndAuth(ctx, r.kc, &resp.Diagnostics, common.ServiceLoadBalancer,
 		func() (struct{}, *http.Response, error) {
 			httpResp, err := r.kc.ApiClient.LoadBalancerTargetGroupAPI.
 				DeleteHealthMonitor(ctx, state.Id.ValueString()).
@@ -377,7 +377,7 @@ func (r *loadBalancerHealthMonitorResource) ImportState(ctx context.Context, req
 }
 
 func (r *loadBalancerHealthMonitorResource) getLoadBalancerIdByTargetGroupId(ctx context.Context, targetGroupId string, respDiags *diag.Diagnostics) (*string, bool) {
-	respModel, httpResp, err := common.ExecuteWithRetryAndAuth(ctx, r.kc, respDiags,
+	respModel, httpResp, err := common.ExecuteWithRetryAndAuth(ctx, r.kc, respDiags, common.ServiceLoadBalancer,
 		func() (*loadbalancer.TargetGroupResponseModel, *http.Response, error) {
 			return r.kc.ApiClient.LoadBalancerTargetGroupAPI.GetTargetGroup(ctx, targetGroupId).
 				XAuthToken(r.kc.XAuthToken).Execute()
@@ -161,9 +161,12 @@ func (d *loadBalancerL7PoliciesDataSource) Read(ctx context.Context, req datasou
 		return
 	}
 
-	lbL7PoliciesResp, httpResp, err := common.ExecuteWithRetryAndAuth(ctx, d.kc, &resp.Diagnostics,
-		func() (*loadbalancer.L7PolicyListModel, *http.Response, error) {
-			return l7PolicyApi.Limit(1000).XAuthToken(d.kc.XAuthToken).Execute()
+	lbL7PoliciesPages, httpResp, err := common.ListAllPagesPartial(ctx, d.kc, d, &resp.Diagnostics, common.ServiceLoadBalancer,
+		func(limit int32, offset int32) (*loadbalancer.L7PolicyListModel, *http.Response, error) {
+			return l7PolicyApi.Limit(limit).Offset(offset).XAuthToken(d.kc.XAuthToken).Execute()
+		},
+		func(page *loadbalancer.L7PolicyListModel) int {
+			return len(page.L7Policies)
 		},
 	)
 	if err != nil {
@@ -172,11 +175,15 @@ func (d *loadBalancerL7PoliciesDataSource) Read(ctx context.Context, req datasou
 	}
 
 	var lbL7PoliciesResult []loadbalancer.BnsLoadBalancerV1ApiGetL7PolicyModelL7PolicyModel
-	err = copier.Copy(&lbL7PoliciesResult, &lbL7PoliciesResp.L7Policies)
-	if err != nil {
-		common.AddGeneralError(ctx, d, &resp.Diagnostics,
-			fmt.Sprintf("Failed to convert lblsResult: %v", err))
-		return
+	for _, lbL7PoliciesResp := range lbL7PoliciesPages {
+		var pageResult []loadbalancer.BnsLoadBalancerV1ApiGetL7PolicyModelL7PolicyModel
+		err = copier.Copy(&pageResult, &lbL7PoliciesResp.L7Policies)
+		if err != nil {
+			common.AddGeneralError(ctx, d, &resp.Diagnostics,
+				fmt.Sprintf("Failed to convert lblsResult: %v", err))
+			return
+		}
+		lbL7PoliciesResult = append(lbL7PoliciesResult, pageResult...)
 	}
 
 	for _, v := range lbL7PoliciesResult {
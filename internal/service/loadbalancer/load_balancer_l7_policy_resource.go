@@ -132,7 +132,7 @@ func (r *loadBalancerL7PolicyResource) Create(ctx context.Context, req resource.
 		L7Policy: createReq,
 	}
 
-	createResp, httpResp, err := common.ExecuteWithRetryAndAuth(ctx, r.kc, &resp.Diagnostics,
+	createResp, httpResp, err := common.ExecuteWithRetryAndAuth(ctx, r.kc, &resp.Diagnostics, common.ServiceLoadBalancer,
 		func() (*loadbalancer.BnsLoadBalancerV1ApiCreateL7PolicyModelResponseL7PolicyModel, *http.Response, error) {
 			return r.kc.ApiClient.LoadBalancerL7PoliciesAPI.CreateL7Policy(ctx).XAuthToken(r.kc.XAuthToken).BodyCreateL7Policy(body).Execute()
 		},
@@ -184,7 +184,7 @@ func (r *loadBalancerL7PolicyResource) Read(ctx context.Context, req resource.Re
 	ctx, cancel := context.WithTimeout(ctx, timeout)
 	defer cancel()
 
-	respModel, httpResp, err := common.ExecuteWithRetryAndAuth(ctx, r.kc, &resp.Diagnostics,
+	respModel, httpResp, err := common.ExecuteWithRetryAndAuth(ctx, r.kc, &resp.Diagnostics, common.ServiceLoadBalancer,
 		func() (*loadbalancer.BnsLoadBalancerV1ApiGetL7PolicyModelResponseL7PolicyModel, *http.Response, error) {
 			return r.kc.ApiClient.LoadBalancerL7PoliciesAPI.GetL7Policy(ctx, state.Id.ValueString()).XAuthToken(r.kc.XAuthToken).Execute()
 		},
@@ -288,7 +288,7 @@ func (r *loadBalancerL7PolicyResource) Update(ctx context.Context, req resource.
 
 	body := *loadbalancer.NewBodyUpdateL7Policy(*editReq)
 
-	_, httpResp, err := common.ExecuteWithRetryAndAuth(ctx, r.kc, &resp.Diagnostics,
+	_, httpResp, err := common.ExecuteWithRetryAndAuth(ctx, r.kc, &resp.Diagnostics, common.ServiceLoadBalancer,
 		func() (*loadbalancer.BnsLoadBalancerV1ApiUpdateL7PolicyModelResponseL7PolicyModel, *http.Response, error) {
 			return r.kc.ApiClient.LoadBalancerL7PoliciesAPI.UpdateL7Policy(ctx, state.Id.ValueString()).XAuthToken(r.kc.XAuthToken).BodyUpdateL7Policy(body).Execute()
 		},
@@ -356,7 +356,7 @@ func (r *loadBalancerL7PolicyResource) Delete(ctx context.Context, req resource.
 		return
 	}
 
-	_, httpResp, err := common.ExecuteWithRetryAndAuth(ctx, r.kc, &resp.Diagnostics,
+	_, httpResp, err := common.ExecuteWithRetryAndAuth(ctx, r.kc, &resp.Diagnostics, common.ServiceLoadBalancer,
 		func() (interface{}, *http.Response, error) {
 			httpResp, err := r.kc.ApiClient.LoadBalancerL7PoliciesAPI.DeleteL7Policy(ctx, state.Id.ValueString()).XAuthToken(r.kc.XAuthToken).Execute()
 			return nil, httpResp, err
@@ -395,7 +395,7 @@ func (r *loadBalancerL7PolicyResource) pollL7PolicyUntilStatus(
 		targetStatuses,
 		resp,
 		func(ctx context.Context) (*loadbalancer.BnsLoadBalancerV1ApiGetL7PolicyModelL7PolicyModel, *http.Response, error) {
-			respModel, httpResp, err := common.ExecuteWithRetryAndAuth(ctx, r.kc, resp,
+			respModel, httpResp, err := common.ExecuteWithRetryAndAuth(ctx, r.kc, resp, common.ServiceLoadBalancer,
 				func() (*loadbalancer.BnsLoadBalancerV1ApiGetL7PolicyModelResponseL7PolicyModel, *http.Response, error) {
 					return r.kc.ApiClient.LoadBalancerL7PoliciesAPI.
 						GetL7Policy(ctx, l7PolicyId).
@@ -419,7 +419,7 @@ func (r *loadBalancerL7PolicyResource) findListenerIdByL7PolicyId(ctx context.Co
 	offset := int32(0)
 
 	for {
-		listenersResp, httpResp, err := common.ExecuteWithRetryAndAuth(ctx, r.kc, diags,
+		listenersResp, httpResp, err := common.ExecuteWithRetryAndAuth(ctx, r.kc, diags, common.ServiceLoadBalancer,
 			func() (*loadbalancer.ListenerListModel, *http.Response, error) {
 				return r.kc.ApiClient.LoadBalancerListenerAPI.ListListeners(ctx).Limit(limit).Offset(offset).
 					Protocol("HTTP").XAuthToken(r.kc.XAuthToken).Execute()
@@ -466,7 +466,7 @@ func (r *loadBalancerL7PolicyResource) getLoadBalancerIdByListenerId(ctx context
 		[]string{"ok"},
 		respDiags,
 		func(ctx context.Context) (*loadbalancer.BnsLoadBalancerV1ApiGetListenerModelListenerModel, *http.Response, error) {
-			respModel, httpResp, err := common.ExecuteWithRetryAndAuth(ctx, r.kc, respDiags,
+			respModel, httpResp, err := common.ExecuteWithRetryAndAuth(ctx, r.kc, respDiags, common.ServiceLoadBalancer,
 				func() (*loadbalancer.BnsLoadBalancerV1ApiGetListenerModelResponseListenerModel, *http.Response, error) {
 					return r.kc.ApiClient.LoadBalancerListenerAPI.GetListener(ctx, listenerId).
 						XAuthToken(r.kc.XAuthToken).Execute()
@@ -536,7 +536,7 @@ func (r *loadBalancerL7PolicyResource) ValidateConfig(ctx context.Context, req r
 }
 
 func (r *loadBalancerL7PolicyResource) validatePosition(ctx context.Context, lbId, listenerId, action string, position int32, respDiags *diag.Diagnostics) bool {
-	lbL7PoliciesResp, httpResp, err := common.ExecuteWithRetryAndAuth(ctx, r.kc, respDiags,
+	lbL7PoliciesResp, httpResp, err := common.ExecuteWithRetryAndAuth(ctx, r.kc, respDiags, common.ServiceLoadBalancer,
 		func() (*loadbalancer.L7PolicyListModel, *http.Response, error) {
 			return r.kc.ApiClient.LoadBalancerL7PoliciesAPI.ListL7Policies(
 				ctx,
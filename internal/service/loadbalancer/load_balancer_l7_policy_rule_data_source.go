@@ -80,7 +80,7 @@ func (d *loadBalancerL7PolicyRuleDataSource) Read(ctx context.Context, req datas
 	ctx, cancel := context.WithTimeout(ctx, timeout)
 	defer cancel()
 
-	ruleResp, httpResp, err := common.ExecuteWithRetryAndAuth(ctx, d.kc, &resp.Diagnostics,
+	ruleResp, httpResp, err := common.ExecuteWithRetryAndAuth(ctx, d.kc, &resp.Diagnostics, common.ServiceLoadBalancer,
 		func() (*loadbalancer.Responsel7PolicyRuleModel, *http.Response, error) {
 			return d.kc.ApiClient.LoadBalancerL7PoliciesAPI.GetL7PolicyRule(ctx, config.L7PolicyId.ValueString(), config.Id.ValueString()).XAuthToken(d.kc.XAuthToken).Execute()
 		},
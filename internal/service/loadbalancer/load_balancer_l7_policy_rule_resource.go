@@ -114,7 +114,7 @@ func (r *loadBalancerL7PolicyRuleResource) Create(ctx context.Context, req resou
 		createReq.SetIsInverted(plan.IsInverted.ValueBool())
 	}
 
-	createResp, httpResp, err := common.ExecuteWithRetryAndAuth(ctx, r.kc, &resp.Diagnostics,
+	createResp, httpResp, err := common.ExecuteWithRetryAndAuth(ctx, r.kc, &resp.Diagnostics, common.ServiceLoadBalancer,
 		func() (*loadbalancer.BnsLoadBalancerV1ApiAddL7PolicyRuleModelResponseL7PolicyRuleModel, *http.Response, error) {
 			return r.kc.ApiClient.LoadBalancerL7PoliciesAPI.AddL7PolicyRule(ctx, plan.L7PolicyId.ValueString()).BodyAddL7PolicyRule(loadbalancer.BodyAddL7PolicyRule{L7Rule: createReq}).XAuthToken(r.kc.XAuthToken).Execute()
 		},
@@ -168,7 +168,7 @@ func (r *loadBalancerL7PolicyRuleResource) Read(ctx context.Context, req resourc
 	ctx, cancel := context.WithTimeout(ctx, timeout)
 	defer cancel()
 
-	getResp, httpResp, err := common.ExecuteWithRetryAndAuth(ctx, r.kc, &resp.Diagnostics,
+	getResp, httpResp, err := common.ExecuteWithRetryAndAuth(ctx, r.kc, &resp.Diagnostics, common.ServiceLoadBalancer,
 		func() (*loadbalancer.Responsel7PolicyRuleModel, *http.Response, error) {
 			return r.kc.ApiClient.LoadBalancerL7PoliciesAPI.GetL7PolicyRule(ctx, state.L7PolicyId.ValueString(), state.Id.ValueString()).XAuthToken(r.kc.XAuthToken).Execute()
 		},
@@ -242,7 +242,7 @@ func (r *loadBalancerL7PolicyRuleResource) Update(ctx context.Context, req resou
 		updateReq.SetIsInverted(plan.IsInverted.ValueBool())
 	}
 
-	_, httpResp, err := common.ExecuteWithRetryAndAuth(ctx, r.kc, &resp.Diagnostics,
+	_, httpResp, err := common.ExecuteWithRetryAndAuth(ctx, r.kc, &resp.Diagnostics, common.ServiceLoadBalancer,
 		func() (*loadbalancer.BnsLoadBalancerV1ApiUpdateL7PolicyRuleModelResponseL7PolicyRuleModel, *http.Response, error) {
 			return r.kc.ApiClient.LoadBalancerL7PoliciesAPI.UpdateL7PolicyRule(ctx, state.L7PolicyId.ValueString(), state.Id.ValueString()).BodyUpdateL7PolicyRule(loadbalancer.BodyUpdateL7PolicyRule{L7Rule: updateReq}).XAuthToken(r.kc.XAuthToken).Execute()
 		},
@@ -312,7 +312,7 @@ func (r *loadBalancerL7PolicyRuleResource) Delete(ctx context.Context, req resou
 		return
 	}
 
-	_, httpResp, err := common.ExecuteWithRetryAndAuth(ctx, r.kc, &resp.Diagnostics,
+	_, httpResp, err := common.ExecuteWithRetryAndAuth(ctx, r.kc, &resp.Diagnostics, common.ServiceLoadBalancer,
 		func() (interface{}, *http.Response, error) {
 			httpResp, err := r.kc.ApiClient.LoadBalancerL7PoliciesAPI.DeleteL7PolicyRule(ctx, state.L7PolicyId.ValueString(), state.Id.ValueString()).
 				XAuthToken(r.kc.XAuthToken).
@@ -357,7 +357,7 @@ func (r *loadBalancerL7PolicyRuleResource) ImportState(ctx context.Context, req
 
 func (r *loadBalancerL7PolicyRuleResource) findLoadBalancerIdByL7PolicyId(ctx context.Context, l7PolicyId string, diags *diag.Diagnostics) (*string, bool) {
 
-	listenersResp, httpResp, err := common.ExecuteWithRetryAndAuth(ctx, r.kc, diags,
+	listenersResp, httpResp, err := common.ExecuteWithRetryAndAuth(ctx, r.kc, diags, common.ServiceLoadBalancer,
 		func() (*loadbalancer.ListenerListModel, *http.Response, error) {
 			return r.kc.ApiClient.LoadBalancerListenerAPI.ListListeners(ctx).Limit(1000).Protocol("HTTP").XAuthToken(r.kc.XAuthToken).Execute()
 		},
@@ -396,7 +396,7 @@ func (r *loadBalancerL7PolicyRuleResource) pollL7PolicyRuleUntilStatus(
 		targetStatuses,
 		diags,
 		func(ctx context.Context) (*loadbalancer.Responsel7PolicyRuleModel, *http.Response, error) {
-			resp, httpResp, err := common.ExecuteWithRetryAndAuth(ctx, r.kc, diags,
+			resp, httpResp, err := common.ExecuteWithRetryAndAuth(ctx, r.kc, diags, common.ServiceLoadBalancer,
 				func() (*loadbalancer.Responsel7PolicyRuleModel, *http.Response, error) {
 					return r.kc.ApiClient.LoadBalancerL7PoliciesAPI.
 						GetL7PolicyRule(ctx, l7PolicyId, ruleId).
@@ -84,7 +84,7 @@ func (d *loadBalancerL7PolicyRulesDataSource) Read(ctx context.Context, req data
 	ctx, cancel := context.WithTimeout(ctx, timeout)
 	defer cancel()
 
-	l7policyResp, httpResp, err := common.ExecuteWithRetryAndAuth(ctx, d.kc, &resp.Diagnostics,
+	l7policyResp, httpResp, err := common.ExecuteWithRetryAndAuth(ctx, d.kc, &resp.Diagnostics, common.ServiceLoadBalancer,
 		func() (*loadbalancer.BnsLoadBalancerV1ApiGetL7PolicyModelResponseL7PolicyModel, *http.Response, error) {
 			return d.kc.ApiClient.LoadBalancerL7PoliciesAPI.GetL7Policy(ctx, config.Id.ValueString()).XAuthToken(d.kc.XAuthToken).Execute()
 		},
@@ -82,7 +82,7 @@ func (d *loadBalancerListenerDataSource) Read(ctx context.Context, req datasourc
 	ctx, cancel := context.WithTimeout(ctx, timeout)
 	defer cancel()
 
-	lbl, httpResp, err := common.ExecuteWithRetryAndAuth(ctx, d.kc, &resp.Diagnostics,
+	lbl, httpResp, err := common.ExecuteWithRetryAndAuth(ctx, d.kc, &resp.Diagnostics, common.ServiceLoadBalancer,
 		func() (*loadbalancer.BnsLoadBalancerV1ApiGetListenerModelResponseListenerModel, *http.Response, error) {
 			return d.kc.ApiClient.LoadBalancerListenerAPI.GetListener(ctx, config.Id.ValueString()).XAuthToken(d.kc.XAuthToken).Execute()
 		},
@@ -3,6 +3,7 @@
 package loadbalancer
 
 import (
+	"terraform-provider-kakaocloud/internal/common"
 	"terraform-provider-kakaocloud/internal/utils"
 
 	"github.com/hashicorp/terraform-plugin-framework/attr"
@@ -22,13 +23,14 @@ func mapLoadBalancerListenerBaseModel(
 
 	secrets, secretsDiags := utils.ConvertListFromModel(ctx, src.Secrets, loadBalancerListenerSecretAttrType, func(secret loadbalancer.BnsLoadBalancerV1ApiGetListenerModelSecretModel) any {
 		return loadBalancerListenerSecretsModel{
-			Id:         types.StringValue(secret.Id),
-			Name:       utils.ConvertNullableString(secret.Name),
-			Expiration: utils.ConvertNullableString(secret.Expiration),
-			Status:     utils.ConvertNullableString(secret.Status),
-			SecretType: utils.ConvertNullableString(secret.SecretType),
-			CreatorId:  utils.ConvertNullableString(secret.CreatorId),
-			IsDefault:  utils.ConvertNullableBool(secret.IsDefault),
+			Id:              types.StringValue(secret.Id),
+			Name:            utils.ConvertNullableString(secret.Name),
+			Expiration:      utils.ConvertNullableString(secret.Expiration),
+			Status:          utils.ConvertNullableString(secret.Status),
+			SecretType:      utils.ConvertNullableString(secret.SecretType),
+			CreatorId:       utils.ConvertNullableString(secret.CreatorId),
+			IsDefault:       utils.ConvertNullableBool(secret.IsDefault),
+			DaysUntilExpiry: common.DaysUntilCertificateExpiryValue(utils.ConvertNullableString(secret.Expiration)),
 		}
 	})
 
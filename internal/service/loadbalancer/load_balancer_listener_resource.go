@@ -23,6 +23,7 @@ var (
 	_ resource.Resource                = &loadBalancerListenerResource{}
 	_ resource.ResourceWithConfigure   = &loadBalancerListenerResource{}
 	_ resource.ResourceWithImportState = &loadBalancerListenerResource{}
+	_ resource.ResourceWithModifyPlan  = &loadBalancerListenerResource{}
 )
 
 func NewLoadBalancerListenerResource() resource.Resource {
@@ -112,7 +113,7 @@ func (r *loadBalancerListenerResource) Create(ctx context.Context, req resource.
 		Listener: createReq,
 	}
 
-	lbl, httpResp, err := common.ExecuteWithRetryAndAuth(ctx, r.kc, &resp.Diagnostics,
+	lbl, httpResp, err := common.ExecuteWithRetryAndAuth(ctx, r.kc, &resp.Diagnostics, common.ServiceLoadBalancer,
 		func() (*loadbalancer.BnsLoadBalancerV1ApiCreateListenerModelResponseListenerModel, *http.Response, error) {
 			return r.kc.ApiClient.LoadBalancerListenerAPI.CreateListener(ctx).XAuthToken(r.kc.XAuthToken).BodyCreateListener(body).Execute()
 		},
@@ -177,7 +178,7 @@ func (r *loadBalancerListenerResource) Read(ctx context.Context, req resource.Re
 	ctx, cancel := context.WithTimeout(ctx, timeout)
 	defer cancel()
 
-	respModel, httpResp, err := common.ExecuteWithRetryAndAuth(ctx, r.kc, &resp.Diagnostics,
+	respModel, httpResp, err := common.ExecuteWithRetryAndAuth(ctx, r.kc, &resp.Diagnostics, common.ServiceLoadBalancer,
 		func() (*loadbalancer.BnsLoadBalancerV1ApiGetListenerModelResponseListenerModel, *http.Response, error) {
 			return r.kc.ApiClient.LoadBalancerListenerAPI.GetListener(ctx, state.Id.ValueString()).XAuthToken(r.kc.XAuthToken).Execute()
 		},
@@ -268,7 +269,7 @@ func (r *loadBalancerListenerResource) Delete(ctx context.Context, req resource.
 		return
 	}
 
-	_, httpResp, err := common.ExecuteWithRetryAndAuth(ctx, r.kc, &resp.Diagnostics,
+	_, httpResp, err := common.ExecuteWithRetryAndAuth(ctx, r.kc, &resp.Diagnostics, common.ServiceLoadBalancer,
 		func() (interface{}, *http.Response, error) {
 			httpResp, err := r.kc.ApiClient.LoadBalancerListenerAPI.DeleteListener(ctx, state.Id.ValueString()).XAuthToken(r.kc.XAuthToken).Execute()
 			return nil, httpResp, err
@@ -307,7 +308,7 @@ func (r *loadBalancerListenerResource) pollListenerUntilStatus(
 		targetStatuses,
 		resp,
 		func(ctx context.Context) (*loadbalancer.BnsLoadBalancerV1ApiGetListenerModelListenerModel, *http.Response, error) {
-			respModel, httpResp, err := common.ExecuteWithRetryAndAuth(ctx, r.kc, resp,
+			respModel, httpResp, err := common.ExecuteWithRetryAndAuth(ctx, r.kc, resp, common.ServiceLoadBalancer,
 				func() (*loadbalancer.BnsLoadBalancerV1ApiGetListenerModelResponseListenerModel, *http.Response, error) {
 					return r.kc.ApiClient.LoadBalancerListenerAPI.GetListener(ctx, listenerId).XAuthToken(r.kc.XAuthToken).Execute()
 				},
@@ -402,7 +403,7 @@ func (r *loadBalancerListenerResource) updateListenerAttributes(
 	if needsUpdate {
 		body := *loadbalancer.NewBodyUpdateListener(editReq)
 
-		_, httpResp, err := common.ExecuteWithRetryAndAuth(ctx, r.kc, diag,
+		_, httpResp, err := common.ExecuteWithRetryAndAuth(ctx, r.kc, diag, common.ServiceLoadBalancer,
 			func() (interface{}, *http.Response, error) {
 				return r.kc.ApiClient.LoadBalancerListenerAPI.UpdateListener(ctx, plan.Id.ValueString()).
 					XAuthToken(r.kc.XAuthToken).BodyUpdateListener(body).Execute()
@@ -470,3 +471,59 @@ func (r *loadBalancerListenerResource) ValidateConfig(ctx context.Context, req r
 		}
 	}
 }
+
+func (r *loadBalancerListenerResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() || r.kc == nil {
+		return
+	}
+
+	warningDays := r.kc.CertificateExpiryWarningDays()
+	if warningDays <= 0 {
+		return
+	}
+
+	var plan loadBalancerListenerResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	refPaths := make(map[string]path.Path)
+	if !plan.DefaultTlsContainerRef.IsNull() && !plan.DefaultTlsContainerRef.IsUnknown() {
+		refPaths[plan.DefaultTlsContainerRef.ValueString()] = path.Root("default_tls_container_ref")
+	}
+	if !plan.SniContainerRefs.IsNull() && !plan.SniContainerRefs.IsUnknown() {
+		for i, v := range plan.SniContainerRefs.Elements() {
+			ref, ok := v.(types.String)
+			if !ok || ref.IsNull() || ref.IsUnknown() {
+				continue
+			}
+			if _, exists := refPaths[ref.ValueString()]; !exists {
+				refPaths[ref.ValueString()] = path.Root("sni_container_refs").AtListIndex(i)
+			}
+		}
+	}
+	if len(refPaths) == 0 {
+		return
+	}
+
+	// A failed lookup only skips the expiry check, it must not block the plan.
+	var listDiags diag.Diagnostics
+	certificates, _, err := listAllTlsCertificates(ctx, r.kc, r, &listDiags)
+	if err != nil || listDiags.HasError() {
+		resp.Diagnostics.AddWarning(
+			"Unable to check certificate expiry",
+			"Listing TLS certificates failed, so the expiry of the listener certificates was not checked.",
+		)
+		return
+	}
+
+	for _, certificate := range certificates {
+		refPath, ok := refPaths[certificate.SecretRef]
+		if !ok {
+			continue
+		}
+		days := common.DaysUntilCertificateExpiryValue(types.StringValue(certificate.Expiration))
+		common.AddCertificateExpiryWarning(&resp.Diagnostics, refPath, certificate.Name, days, warningDays)
+	}
+}
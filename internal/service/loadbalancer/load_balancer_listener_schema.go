@@ -210,6 +210,9 @@ func getListenerSecretSchema() map[string]rschema.Attribute {
 		"creator_id": rschema.StringAttribute{
 			Computed: true,
 		},
+		"days_until_expiry": rschema.Int64Attribute{
+			Computed: true,
+		},
 	}
 }
 
@@ -387,6 +390,9 @@ func getListenerSecretDataSourceSchema() map[string]dschema.Attribute {
 		"creator_id": dschema.StringAttribute{
 			Computed: true,
 		},
+		"days_until_expiry": dschema.Int64Attribute{
+			Computed: true,
+		},
 	}
 }
 
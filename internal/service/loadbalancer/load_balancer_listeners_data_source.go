@@ -184,9 +184,12 @@ func (d *loadBalancerListenersDataSource) Read(ctx context.Context, req datasour
 	if resp.Diagnostics.HasError() {
 		return
 	}
-	lblResp, httpResp, err := common.ExecuteWithRetryAndAuth(ctx, d.kc, &resp.Diagnostics,
-		func() (*loadbalancer.ListenerListModel, *http.Response, error) {
-			return lblApi.Limit(1000).XAuthToken(d.kc.XAuthToken).Execute()
+	lblPages, httpResp, err := common.ListAllPagesPartial(ctx, d.kc, d, &resp.Diagnostics, common.ServiceLoadBalancer,
+		func(limit int32, offset int32) (*loadbalancer.ListenerListModel, *http.Response, error) {
+			return lblApi.Limit(limit).Offset(offset).XAuthToken(d.kc.XAuthToken).Execute()
+		},
+		func(page *loadbalancer.ListenerListModel) int {
+			return len(page.Listeners)
 		},
 	)
 
@@ -196,11 +199,15 @@ func (d *loadBalancerListenersDataSource) Read(ctx context.Context, req datasour
 	}
 
 	var lblsResult []loadbalancer.BnsLoadBalancerV1ApiGetListenerModelListenerModel
-	err = copier.Copy(&lblsResult, &lblResp.Listeners)
-	if err != nil {
-		common.AddGeneralError(ctx, d, &resp.Diagnostics,
-			fmt.Sprintf("Failed to convert lblsResult: %v", err))
-		return
+	for _, lblResp := range lblPages {
+		var pageResult []loadbalancer.BnsLoadBalancerV1ApiGetListenerModelListenerModel
+		err = copier.Copy(&pageResult, &lblResp.Listeners)
+		if err != nil {
+			common.AddGeneralError(ctx, d, &resp.Diagnostics,
+				fmt.Sprintf("Failed to convert lblsResult: %v", err))
+			return
+		}
+		lblsResult = append(lblsResult, pageResult...)
 	}
 
 	for _, v := range lblsResult {
@@ -102,7 +102,7 @@ func (r *loadBalancerResource) Create(ctx context.Context, req resource.CreateRe
 
 	body := loadbalancer.BodyCreateLoadBalancer{LoadBalancer: createReq}
 
-	lb, httpResp, err := common.ExecuteWithRetryAndAuth(ctx, r.kc, &resp.Diagnostics,
+	lb, httpResp, err := common.ExecuteWithRetryAndAuth(ctx, r.kc, &resp.Diagnostics, common.ServiceLoadBalancer,
 		func() (*loadbalancer.BnsLoadBalancerV1ApiCreateLoadBalancerModelResponseLoadBalancerModel, *http.Response, error) {
 			return r.kc.ApiClient.LoadBalancerAPI.CreateLoadBalancer(ctx).XAuthToken(r.kc.XAuthToken).BodyCreateLoadBalancer(body).Execute()
 		},
@@ -167,7 +167,7 @@ func (r *loadBalancerResource) Read(ctx context.Context, req resource.ReadReques
 	ctx, cancel := context.WithTimeout(ctx, timeout)
 	defer cancel()
 
-	respModel, httpResp, err := common.ExecuteWithRetryAndAuth(ctx, r.kc, &resp.Diagnostics,
+	respModel, httpResp, err := common.ExecuteWithRetryAndAuth(ctx, r.kc, &resp.Diagnostics, common.ServiceLoadBalancer,
 		func() (*loadbalancer.BnsLoadBalancerV1ApiGetLoadBalancerModelResponseLoadBalancerModel, *http.Response, error) {
 			return r.kc.ApiClient.LoadBalancerAPI.
 				GetLoadBalancer(ctx, state.Id.ValueString()).
@@ -193,7 +193,7 @@ func (r *loadBalancerResource) Read(ctx context.Context, req resource.ReadReques
 	}
 
 	if state.FlavorId.IsNull() {
-		lbfs, httpResp, err := common.ExecuteWithRetryAndAuth(ctx, r.kc, &resp.Diagnostics,
+		lbfs, httpResp, err := common.ExecuteWithRetryAndAuth(ctx, r.kc, &resp.Diagnostics, common.ServiceLoadBalancer,
 			func() (*loadbalancer.FlavorListModel, *http.Response, error) {
 				resp, httpResp, err := r.kc.ApiClient.LoadBalancerEtcAPI.ListLoadBalancerTypes(ctx).XAuthToken(r.kc.XAuthToken).Execute()
 				return resp, httpResp, err
@@ -276,7 +276,7 @@ func (r *loadBalancerResource) Update(ctx context.Context, req resource.UpdateRe
 
 		body := *loadbalancer.NewBodyUpdateLoadBalancer(editReq)
 
-		_, httpResp, err := common.ExecuteWithRetryAndAuth(ctx, r.kc, &resp.Diagnostics,
+		_, httpResp, err := common.ExecuteWithRetryAndAuth(ctx, r.kc, &resp.Diagnostics, common.ServiceLoadBalancer,
 			func() (*loadbalancer.BnsLoadBalancerV1ApiUpdateLoadBalancerModelResponseLoadBalancerModel, *http.Response, error) {
 				return r.kc.ApiClient.LoadBalancerAPI.
 					UpdateLoadBalancer(ctx, state.Id.ValueString()).
@@ -342,7 +342,7 @@ func (r *loadBalancerResource) Delete(ctx context.Context, req resource.DeleteRe
 		return
 	}
 
-	_, httpResp, err := common.ExecuteWithRetryAndAuth(ctx, r.kc, &resp.Diagnostics,
+	_, httpResp, err := common.ExecuteWithRetryAndAuth(ctx, r.kc, &resp.Diagnostics, common.ServiceLoadBalancer,
 		func() (struct{}, *http.Response, error) {
 			httpResp, err := r.kc.ApiClient.LoadBalancerAPI.
 				DeleteLoadBalancer(ctx, state.Id.ValueString()).
@@ -384,7 +384,7 @@ func (r *loadBalancerResource) pollLoadBalancerUntilStatus(
 		targetStatuses,
 		resp,
 		func(ctx context.Context) (*loadbalancer.BnsLoadBalancerV1ApiGetLoadBalancerModelLoadBalancerModel, *http.Response, error) {
-			respModel, httpResp, err := common.ExecuteWithRetryAndAuth(ctx, r.kc, resp,
+			respModel, httpResp, err := common.ExecuteWithRetryAndAuth(ctx, r.kc, resp, common.ServiceLoadBalancer,
 				func() (*loadbalancer.BnsLoadBalancerV1ApiGetLoadBalancerModelResponseLoadBalancerModel, *http.Response, error) {
 					return r.kc.ApiClient.LoadBalancerAPI.
 						GetLoadBalancer(ctx, loadBalancerId).
@@ -429,7 +429,7 @@ func (r *loadBalancerResource) updateLoadBalancerAccessLogs(
 		body.SetAccessLogs(accessLogReq)
 	}
 
-	_, httpResp, err := common.ExecuteWithRetryAndAuth(ctx, r.kc, diag,
+	_, httpResp, err := common.ExecuteWithRetryAndAuth(ctx, r.kc, diag, common.ServiceLoadBalancer,
 		func() (*loadbalancer.BnsLoadBalancerV1ApiUpdateAccessLogModelResponseLoadBalancerModel, *http.Response, error) {
 			return r.kc.ApiClient.LoadBalancerAPI.UpdateAccessLog(ctx, plan.Id.ValueString()).XAuthToken(r.kc.XAuthToken).BodyUpdateAccessLog(*body).Execute()
 		},
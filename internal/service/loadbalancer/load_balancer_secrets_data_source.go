@@ -14,6 +14,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework-timeouts/datasource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 )
 
 var (
@@ -127,9 +128,12 @@ func (d *loadBalancerSecretsDataSource) Read(ctx context.Context, req datasource
 		return
 	}
 
-	lbsResp, httpResp, err := common.ExecuteWithRetryAndAuth(ctx, d.kc, &resp.Diagnostics,
-		func() (*loadbalancer.SecretListModel, *http.Response, error) {
-			return lbsApi.Limit(1000).XAuthToken(d.kc.XAuthToken).Execute()
+	lbsPages, httpResp, err := common.ListAllPagesPartial(ctx, d.kc, d, &resp.Diagnostics, common.ServiceLoadBalancer,
+		func(limit int32, offset int32) (*loadbalancer.SecretListModel, *http.Response, error) {
+			return lbsApi.Limit(limit).Offset(offset).XAuthToken(d.kc.XAuthToken).Execute()
+		},
+		func(page *loadbalancer.SecretListModel) int {
+			return len(page.Secrets)
 		},
 	)
 
@@ -139,19 +143,26 @@ func (d *loadBalancerSecretsDataSource) Read(ctx context.Context, req datasource
 	}
 
 	var lbssResult []loadbalancer.BnsLoadBalancerV1ApiListTlsCertificatesModelSecretModel
-	err = copier.Copy(&lbssResult, &lbsResp.Secrets)
-	if err != nil {
-		common.AddGeneralError(ctx, d, &resp.Diagnostics,
-			fmt.Sprintf("Failed to convert lbssResult: %v", err))
-		return
+	for _, lbsResp := range lbsPages {
+		var pageResult []loadbalancer.BnsLoadBalancerV1ApiListTlsCertificatesModelSecretModel
+		err = copier.Copy(&pageResult, &lbsResp.Secrets)
+		if err != nil {
+			common.AddGeneralError(ctx, d, &resp.Diagnostics,
+				fmt.Sprintf("Failed to convert lbssResult: %v", err))
+			return
+		}
+		lbssResult = append(lbssResult, pageResult...)
 	}
 
-	for _, secret := range lbssResult {
+	warningDays := d.kc.CertificateExpiryWarningDays()
+	for i, secret := range lbssResult {
 		var lbsModel loadBalancerSecretBaseModel
 		ok := mapLoadBalancerSecretsBaseModel(ctx, &lbsModel, &secret, &resp.Diagnostics)
 		if !ok || resp.Diagnostics.HasError() {
 			return
 		}
+		common.AddCertificateExpiryWarning(&resp.Diagnostics, path.Root("secrets").AtListIndex(i),
+			lbsModel.Name.ValueString(), lbsModel.DaysUntilExpiry, warningDays)
 		data.LoadBalancerSecrets = append(data.LoadBalancerSecrets, lbsModel)
 	}
 
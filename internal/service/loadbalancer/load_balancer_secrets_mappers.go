@@ -3,6 +3,7 @@
 package loadbalancer
 
 import (
+	"terraform-provider-kakaocloud/internal/common"
 	"terraform-provider-kakaocloud/internal/utils"
 	"time"
 
@@ -35,6 +36,7 @@ func mapLoadBalancerSecretsBaseModel(
 	base.CreatorId = types.StringValue(src.CreatorId)
 	base.SecretRef = types.StringValue(src.SecretRef)
 	base.ContentTypes = contentTypes
+	base.DaysUntilExpiry = common.DaysUntilCertificateExpiryValue(base.Expiration)
 
 	return !diags.HasError()
 }
@@ -14,25 +14,27 @@ type ContentTypeModel struct {
 }
 
 type loadBalancerSecretBaseModel struct {
-	Name         types.String `tfsdk:"name"`
-	Expiration   types.String `tfsdk:"expiration"`
-	Status       types.String `tfsdk:"status"`
-	SecretType   types.String `tfsdk:"secret_type"`
-	CreatorId    types.String `tfsdk:"creator_id"`
-	CreatedAt    types.String `tfsdk:"created_at"`
-	UpdatedAt    types.String `tfsdk:"updated_at"`
-	ContentTypes types.Object `tfsdk:"content_types"`
-	SecretRef    types.String `tfsdk:"secret_ref"`
+	Name            types.String `tfsdk:"name"`
+	Expiration      types.String `tfsdk:"expiration"`
+	Status          types.String `tfsdk:"status"`
+	SecretType      types.String `tfsdk:"secret_type"`
+	CreatorId       types.String `tfsdk:"creator_id"`
+	CreatedAt       types.String `tfsdk:"created_at"`
+	UpdatedAt       types.String `tfsdk:"updated_at"`
+	ContentTypes    types.Object `tfsdk:"content_types"`
+	SecretRef       types.String `tfsdk:"secret_ref"`
+	DaysUntilExpiry types.Int64  `tfsdk:"days_until_expiry"`
 }
 
 type loadBalancerListenerSecretsModel struct {
-	Id         types.String `tfsdk:"id"`
-	Name       types.String `tfsdk:"name"`
-	Expiration types.String `tfsdk:"expiration"`
-	Status     types.String `tfsdk:"status"`
-	SecretType types.String `tfsdk:"secret_type"`
-	CreatorId  types.String `tfsdk:"creator_id"`
-	IsDefault  types.Bool   `tfsdk:"is_default"`
+	Id              types.String `tfsdk:"id"`
+	Name            types.String `tfsdk:"name"`
+	Expiration      types.String `tfsdk:"expiration"`
+	Status          types.String `tfsdk:"status"`
+	SecretType      types.String `tfsdk:"secret_type"`
+	CreatorId       types.String `tfsdk:"creator_id"`
+	IsDefault       types.Bool   `tfsdk:"is_default"`
+	DaysUntilExpiry types.Int64  `tfsdk:"days_until_expiry"`
 }
 
 type loadBalancerSecretsDataSourceModel struct {
@@ -46,11 +48,12 @@ var lbSecretsContentTypeAttrType = map[string]attr.Type{
 }
 
 var loadBalancerListenerSecretAttrType = map[string]attr.Type{
-	"id":          types.StringType,
-	"name":        types.StringType,
-	"expiration":  types.StringType,
-	"status":      types.StringType,
-	"secret_type": types.StringType,
-	"is_default":  types.BoolType,
-	"creator_id":  types.StringType,
+	"id":                types.StringType,
+	"name":              types.StringType,
+	"expiration":        types.StringType,
+	"status":            types.StringType,
+	"secret_type":       types.StringType,
+	"is_default":        types.BoolType,
+	"creator_id":        types.StringType,
+	"days_until_expiry": types.Int64Type,
 }
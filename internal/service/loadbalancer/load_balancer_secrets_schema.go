@@ -44,6 +44,9 @@ func getLoadBalancerSecretBaseSchema() map[string]dschema.Attribute {
 		"secret_ref": dschema.StringAttribute{
 			Computed: true,
 		},
+		"days_until_expiry": dschema.Int64Attribute{
+			Computed: true,
+		},
 	}
 }
 
@@ -79,7 +79,7 @@ func (d *loadBalancerTargetGroupDataSource) Read(ctx context.Context, req dataso
 	ctx, cancel := context.WithTimeout(ctx, timeout)
 	defer cancel()
 
-	respModel, httpResp, err := common.ExecuteWithRetryAndAuth(ctx, d.kc, &resp.Diagnostics,
+	respModel, httpResp, err := common.ExecuteWithRetryAndAuth(ctx, d.kc, &resp.Diagnostics, common.ServiceLoadBalancer,
 		func() (*loadbalancer.TargetGroupResponseModel, *http.Response, error) {
 			return d.kc.ApiClient.LoadBalancerTargetGroupAPI.GetTargetGroup(ctx, data.Id.ValueString()).XAuthToken(d.kc.XAuthToken).Execute()
 		},
@@ -0,0 +1,99 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+package loadbalancer
+
+import (
+	"context"
+	"fmt"
+	"terraform-provider-kakaocloud/internal/common"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+func drainTimeoutValue(v types.String) time.Duration {
+	if v.IsNull() || v.IsUnknown() {
+		return 0
+	}
+	d, _ := time.ParseDuration(v.ValueString())
+	return d
+}
+
+// targetGroupMemberKey identifies a member by address and port, since the same address can be
+// registered more than once on different ports.
+func targetGroupMemberKey(address string, protocolPort int32) string {
+	return fmt.Sprintf("%s:%d", address, protocolPort)
+}
+
+// validateDrainTimeout rejects a drain_timeout that does not fit in the delete timeout, and in
+// the update timeout as well when updates also drain members, since the members are only removed
+// once drain_timeout has passed.
+func validateDrainTimeout(
+	ctx context.Context,
+	obj interface{},
+	drainTimeout types.String,
+	t timeouts.Value,
+	drainsOnUpdate bool,
+	respDiags *diag.Diagnostics,
+) {
+	d := drainTimeoutValue(drainTimeout)
+	if d == 0 {
+		return
+	}
+
+	deleteTimeout, diags := t.Delete(ctx, common.DefaultDeleteTimeout)
+	respDiags.Append(diags...)
+	if respDiags.HasError() {
+		return
+	}
+	if d >= deleteTimeout {
+		common.AddValidationConfigError(ctx, obj, respDiags,
+			fmt.Sprintf("drain_timeout (%s) must be shorter than timeouts.delete (%s).", d, deleteTimeout))
+		return
+	}
+
+	if !drainsOnUpdate {
+		return
+	}
+	updateTimeout, diags := t.Update(ctx, common.DefaultUpdateTimeout)
+	respDiags.Append(diags...)
+	if respDiags.HasError() {
+		return
+	}
+	if d >= updateTimeout {
+		common.AddValidationConfigError(ctx, obj, respDiags,
+			fmt.Sprintf("drain_timeout (%s) must be shorter than timeouts.update (%s).", d, updateTimeout))
+	}
+}
+
+// waitForTargetGroupMembersDrained gives members whose weight was just set to 0 drainTimeout to
+// finish in-flight requests before they are removed. The API reports neither connection counts
+// nor a drained state for members: a monitored member stays ONLINE and an unmonitored one stays
+// NO_MONITOR. The wait is therefore a fixed grace period rather than a poll.
+func waitForTargetGroupMembersDrained(
+	ctx context.Context,
+	obj interface{},
+	targetGroupId string,
+	drainTimeout time.Duration,
+	respDiags *diag.Diagnostics,
+) bool {
+	tflog.Info(ctx, "draining target group members before removing them", map[string]any{
+		"target_group_id": targetGroupId,
+		"drain_timeout":   drainTimeout.String(),
+	})
+
+	timer := time.NewTimer(drainTimeout)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		common.AddGeneralError(ctx, obj, respDiags,
+			fmt.Sprintf("Timed out while draining members of target group %s.", targetGroupId))
+		return false
+	case <-timer.C:
+		return true
+	}
+}
@@ -0,0 +1,149 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+package loadbalancer
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"net/http"
+	"sort"
+	"strings"
+	"terraform-provider-kakaocloud/internal/common"
+	"terraform-provider-kakaocloud/internal/utils"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/kakaoenterprise/kc-sdk-go/services/loadbalancer"
+)
+
+const defaultWaitForHealthyTimeout = 10 * time.Minute
+
+type loadBalancerTargetGroupMemberHealthStatus struct {
+	Address         string
+	ProtocolPort    int32
+	OperatingStatus string
+}
+
+// isTargetGroupMemberHealthy reports whether a member counts as healthy. Without a health monitor
+// the target group reports members as NO_MONITOR, and they never become ONLINE.
+func isTargetGroupMemberHealthy(operatingStatus string) bool {
+	return operatingStatus == common.LoadBalancerOperatingStatusOnline ||
+		operatingStatus == common.LoadBalancerOperatingStatusNoMonitor
+}
+
+// requiredHealthyMembers returns how many of total members must be healthy. min_healthy_count
+// wins over min_healthy_percentage, and without either every member must be healthy.
+func requiredHealthyMembers(total int, minHealthyCount types.Int64, minHealthyPercentage types.Int64) int {
+	required := total
+	if !minHealthyCount.IsNull() && !minHealthyCount.IsUnknown() {
+		required = int(minHealthyCount.ValueInt64())
+	} else if !minHealthyPercentage.IsNull() && !minHealthyPercentage.IsUnknown() {
+		required = int(math.Ceil(float64(total) * float64(minHealthyPercentage.ValueInt64()) / 100))
+	}
+	if required > total {
+		return total
+	}
+	return required
+}
+
+// describeUnhealthyMembers lists the members that are not healthy as "address:port (STATUS)".
+func describeUnhealthyMembers(members []loadBalancerTargetGroupMemberHealthStatus) string {
+	var unhealthy []string
+	for _, m := range members {
+		if isTargetGroupMemberHealthy(m.OperatingStatus) {
+			continue
+		}
+		status := m.OperatingStatus
+		if status == "" {
+			status = "UNKNOWN"
+		}
+		unhealthy = append(unhealthy, fmt.Sprintf("%s:%d (%s)", m.Address, m.ProtocolPort, status))
+	}
+	sort.Strings(unhealthy)
+	return strings.Join(unhealthy, ", ")
+}
+
+// waitForTargetGroupMembersHealthy polls the target group until at least required of the members
+// matched by selected are healthy. When timeout passes first, the apply fails with the status
+// of every member that is still unhealthy.
+func waitForTargetGroupMembersHealthy(
+	ctx context.Context,
+	kc *common.KakaoCloudClient,
+	obj interface{},
+	targetGroupId string,
+	timeout time.Duration,
+	required int,
+	selected func(*loadbalancer.BnsLoadBalancerV1ApiListTargetsInTargetGroupModelTargetGroupMemberModel) bool,
+	respDiags *diag.Diagnostics,
+) bool {
+	if required <= 0 {
+		return true
+	}
+
+	waitCtx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	var last []loadBalancerTargetGroupMemberHealthStatus
+	var pollDiags diag.Diagnostics
+	_, ok := common.PollUntilResult(
+		waitCtx,
+		obj,
+		5*time.Second,
+		"target group members",
+		targetGroupId,
+		[]string{common.LoadBalancerOperatingStatusOnline},
+		&pollDiags,
+		func(ctx context.Context) (*loadbalancer.TargetGroupMemberListModel, *http.Response, error) {
+			return listAllTargetGroupMembers(ctx, kc, obj, targetGroupId, &pollDiags)
+		},
+		func(list *loadbalancer.TargetGroupMemberListModel) string {
+			last = last[:0]
+			healthy := 0
+			for i := range list.Members {
+				member := &list.Members[i]
+				if !selected(member) {
+					continue
+				}
+				status := string(member.OperatingStatus)
+				if isTargetGroupMemberHealthy(status) {
+					healthy++
+				}
+				last = append(last, loadBalancerTargetGroupMemberHealthStatus{
+					Address:         utils.ConvertNullableString(member.IpAddress).ValueString(),
+					ProtocolPort:    utils.ConvertNullableInt32(member.ProtocolPort).ValueInt32(),
+					OperatingStatus: status,
+				})
+			}
+			if healthy >= required {
+				return common.LoadBalancerOperatingStatusOnline
+			}
+			return fmt.Sprintf("%d/%d members %s", healthy, required, common.LoadBalancerOperatingStatusOnline)
+		},
+	)
+	if ok {
+		return true
+	}
+
+	if !errors.Is(waitCtx.Err(), context.DeadlineExceeded) {
+		respDiags.Append(pollDiags...)
+		return false
+	}
+	common.AddGeneralError(ctx, obj, respDiags,
+		fmt.Sprintf("Members of target group %s did not become healthy within %s: %d of them must be %s. Unhealthy members: %s.",
+			targetGroupId, timeout, required, common.LoadBalancerOperatingStatusOnline, describeUnhealthyMembers(last)))
+	return false
+}
+
+func waitForHealthyTimeoutValue(v types.String) time.Duration {
+	if v.IsNull() || v.IsUnknown() {
+		return defaultWaitForHealthyTimeout
+	}
+	d, err := time.ParseDuration(v.ValueString())
+	if err != nil {
+		return defaultWaitForHealthyTimeout
+	}
+	return d
+}
@@ -0,0 +1,49 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+package loadbalancer
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestRequiredHealthyMembers(t *testing.T) {
+	cases := []struct {
+		name       string
+		total      int
+		count      types.Int64
+		percentage types.Int64
+		want       int
+	}{
+		{"all by default", 4, types.Int64Null(), types.Int64Null(), 4},
+		{"count", 4, types.Int64Value(2), types.Int64Null(), 2},
+		{"count capped at total", 3, types.Int64Value(5), types.Int64Null(), 3},
+		{"percentage rounds up", 3, types.Int64Null(), types.Int64Value(50), 2},
+		{"full percentage", 3, types.Int64Null(), types.Int64Value(100), 3},
+		{"count wins over percentage", 10, types.Int64Value(1), types.Int64Value(100), 1},
+		{"unknown count falls back", 4, types.Int64Unknown(), types.Int64Null(), 4},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := requiredHealthyMembers(tc.total, tc.count, tc.percentage); got != tc.want {
+				t.Fatalf("got %d, want %d", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestDescribeUnhealthyMembers(t *testing.T) {
+	got := describeUnhealthyMembers([]loadBalancerTargetGroupMemberHealthStatus{
+		{Address: "10.0.1.11", ProtocolPort: 80, OperatingStatus: "OFFLINE"},
+		{Address: "10.0.1.10", ProtocolPort: 80, OperatingStatus: "ONLINE"},
+		{Address: "10.0.1.12", ProtocolPort: 8080, OperatingStatus: ""},
+		{Address: "10.0.1.10", ProtocolPort: 8080, OperatingStatus: "ERROR"},
+		{Address: "10.0.1.13", ProtocolPort: 80, OperatingStatus: "NO_MONITOR"},
+	})
+	want := "10.0.1.10:8080 (ERROR), 10.0.1.11:80 (OFFLINE), 10.0.1.12:8080 (UNKNOWN)"
+	if got != want {
+		t.Fatalf("got %q, want %q", got, want)
+	}
+}
@@ -50,7 +50,9 @@ type loadBalancerTargetGroupMemberBaseModel struct {
 
 type loadBalancerTargetGroupMemberResourceModel struct {
 	loadBalancerTargetGroupMemberBaseModel
-	Timeouts resourceTimeouts.Value `tfsdk:"timeouts"`
+	DrainTimeout   types.String           `tfsdk:"drain_timeout"`
+	WaitForHealthy types.Object           `tfsdk:"wait_for_healthy"`
+	Timeouts       resourceTimeouts.Value `tfsdk:"timeouts"`
 }
 
 type loadBalancerTargetGroupMemberListDataSourceModel struct {
@@ -61,9 +63,21 @@ type loadBalancerTargetGroupMemberListDataSourceModel struct {
 }
 
 type loadBalancerTargetGroupMemberListResourceModel struct {
-	TargetGroupId types.String                              `tfsdk:"target_group_id"`
-	Members       []loadBalancerTargetGroupMemberBatchModel `tfsdk:"members"`
-	Timeouts      resourceTimeouts.Value                    `tfsdk:"timeouts"`
+	TargetGroupId  types.String                              `tfsdk:"target_group_id"`
+	Members        []loadBalancerTargetGroupMemberBatchModel `tfsdk:"members"`
+	DrainTimeout   types.String                              `tfsdk:"drain_timeout"`
+	WaitForHealthy types.Object                              `tfsdk:"wait_for_healthy"`
+	Timeouts       resourceTimeouts.Value                    `tfsdk:"timeouts"`
+}
+
+type loadBalancerTargetGroupMemberWaitForHealthyModel struct {
+	Timeout types.String `tfsdk:"timeout"`
+}
+
+type loadBalancerTargetGroupMembersWaitForHealthyModel struct {
+	Timeout              types.String `tfsdk:"timeout"`
+	MinHealthyCount      types.Int64  `tfsdk:"min_healthy_count"`
+	MinHealthyPercentage types.Int64  `tfsdk:"min_healthy_percentage"`
 }
 
 type loadBalancerTargetGroupMemberBatchModel struct {
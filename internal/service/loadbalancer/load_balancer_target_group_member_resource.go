@@ -16,12 +16,14 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
 	"github.com/kakaoenterprise/kc-sdk-go/services/loadbalancer"
 )
 
 var (
-	_ resource.ResourceWithConfigure   = &loadBalancerTargetGroupMemberResource{}
-	_ resource.ResourceWithImportState = &loadBalancerTargetGroupMemberResource{}
+	_ resource.ResourceWithConfigure      = &loadBalancerTargetGroupMemberResource{}
+	_ resource.ResourceWithImportState    = &loadBalancerTargetGroupMemberResource{}
+	_ resource.ResourceWithValidateConfig = &loadBalancerTargetGroupMemberResource{}
 )
 
 func NewLoadBalancerTargetGroupMemberResource() resource.Resource {
@@ -98,7 +100,7 @@ func (r *loadBalancerTargetGroupMemberResource) Create(ctx context.Context, req
 
 	body := loadbalancer.NewBodyAddTarget(*createReq)
 
-	respModel, httpResp, err := common.ExecuteWithRetryAndAuth(ctx, r.kc, &resp.Diagnostics,
+	respModel, httpResp, err := common.ExecuteWithRetryAndAuth(ctx, r.kc, &resp.Diagnostics, common.ServiceLoadBalancer,
 		func() (*loadbalancer.BnsLoadBalancerV1ApiAddTargetModelResponseTargetGroupMemberModel, *http.Response, error) {
 			return r.kc.ApiClient.LoadBalancerTargetGroupAPI.
 				AddTarget(ctx, plan.TargetGroupId.ValueString()).
@@ -136,6 +138,24 @@ func (r *loadBalancerTargetGroupMemberResource) Create(ctx context.Context, req
 
 	diags = resp.State.Set(ctx, &plan)
 	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !plan.WaitForHealthy.IsNull() && !plan.WaitForHealthy.IsUnknown() {
+		var waitForHealthy loadBalancerTargetGroupMemberWaitForHealthyModel
+		resp.Diagnostics.Append(plan.WaitForHealthy.As(ctx, &waitForHealthy, basetypes.ObjectAsOptions{})...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		waitForTargetGroupMembersHealthy(ctx, r.kc, r, plan.TargetGroupId.ValueString(), waitForHealthyTimeoutValue(waitForHealthy.Timeout), 1,
+			func(member *loadbalancer.BnsLoadBalancerV1ApiListTargetsInTargetGroupModelTargetGroupMemberModel) bool {
+				return member.Id == plan.Id.ValueString()
+			},
+			&resp.Diagnostics,
+		)
+	}
 }
 
 func (r *loadBalancerTargetGroupMemberResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -155,15 +175,7 @@ func (r *loadBalancerTargetGroupMemberResource) Read(ctx context.Context, req re
 	ctx, cancel := context.WithTimeout(ctx, timeout)
 	defer cancel()
 
-	respModel, httpResp, err := common.ExecuteWithRetryAndAuth(ctx, r.kc, &resp.Diagnostics,
-		func() (*loadbalancer.TargetGroupMemberListModel, *http.Response, error) {
-			return r.kc.ApiClient.LoadBalancerTargetGroupAPI.
-				ListTargetsInTargetGroup(ctx, state.TargetGroupId.ValueString()).
-				XAuthToken(r.kc.XAuthToken).
-				Limit(1000).
-				Execute()
-		},
-	)
+	respModel, httpResp, err := listAllTargetGroupMembers(ctx, r.kc, r, state.TargetGroupId.ValueString(), &resp.Diagnostics)
 
 	if httpResp != nil && httpResp.StatusCode == 404 {
 		resp.State.RemoveResource(ctx)
@@ -211,6 +223,15 @@ func (r *loadBalancerTargetGroupMemberResource) Update(ctx context.Context, req
 		return
 	}
 
+	if plan.Name.Equal(state.Name) && plan.Weight.Equal(state.Weight) && plan.MonitorPort.Equal(state.MonitorPort) {
+		state.DrainTimeout = plan.DrainTimeout
+		state.WaitForHealthy = plan.WaitForHealthy
+		state.Timeouts = plan.Timeouts
+		diags = resp.State.Set(ctx, &state)
+		resp.Diagnostics.Append(diags...)
+		return
+	}
+
 	loadBalancerId, ok := r.getLoadBalancerIdByTargetGroupId(ctx, state.TargetGroupId.ValueString(), &resp.Diagnostics)
 	if !ok || resp.Diagnostics.HasError() {
 		return
@@ -253,7 +274,7 @@ func (r *loadBalancerTargetGroupMemberResource) Update(ctx context.Context, req
 
 	body := loadbalancer.NewBodyUpdateTarget(*updateReq)
 
-	_, httpResp, err := common.ExecuteWithRetryAndAuth(ctx, r.kc, &resp.Diagnostics,
+	_, httpResp, err := common.ExecuteWithRetryAndAuth(ctx, r.kc, &resp.Diagnostics, common.ServiceLoadBalancer,
 		func() (*loadbalancer.BnsLoadBalancerV1ApiUpdateTargetModelResponseTargetGroupMemberModel, *http.Response, error) {
 			return r.kc.ApiClient.LoadBalancerTargetGroupAPI.
 				UpdateTarget(ctx, state.TargetGroupId.ValueString(), state.Id.ValueString()).
@@ -307,9 +328,6 @@ func (r *loadBalancerTargetGroupMemberResource) Delete(ctx context.Context, req
 	if !ok || resp.Diagnostics.HasError() {
 		return
 	}
-	mutex := common.LockForID(*loadBalancerId)
-	mutex.Lock()
-	defer mutex.Unlock()
 
 	timeout, diags := state.Timeouts.Delete(ctx, common.DefaultDeleteTimeout)
 	resp.Diagnostics.Append(diags...)
@@ -320,12 +338,22 @@ func (r *loadBalancerTargetGroupMemberResource) Delete(ctx context.Context, req
 	ctx, cancel := context.WithTimeout(ctx, timeout)
 	defer cancel()
 
+	if drainTimeout := drainTimeoutValue(state.DrainTimeout); drainTimeout > 0 {
+		if !r.drainTargetGroupMember(ctx, *loadBalancerId, &state, drainTimeout, &resp.Diagnostics) {
+			return
+		}
+	}
+
+	mutex := common.LockForID(*loadBalancerId)
+	mutex.Lock()
+	defer mutex.Unlock()
+
 	ok = CheckLoadBalancerStatus(ctx, *loadBalancerId, true, r, r.kc, &resp.Diagnostics)
 	if !ok || resp.Diagnostics.HasError() {
 		return
 	}
 
-	_, httpResp, err := common.ExecuteWithRetryAndAuth(ctx, r.kc, &resp.Diagnostics,
+	_, httpResp, err := common.ExecuteWithRetryAndAuth(ctx, r.kc, &resp.Diagnostics, common.ServiceLoadBalancer,
 		func() (interface{}, *http.Response, error) {
 			httpResp, err := r.kc.ApiClient.LoadBalancerTargetGroupAPI.
 				RemoveTarget(ctx, state.TargetGroupId.ValueString(), state.Id.ValueString()).
@@ -347,11 +375,7 @@ func (r *loadBalancerTargetGroupMemberResource) Delete(ctx context.Context, req
 
 	common.PollUntilDeletion(ctx, r, 2*time.Second, &resp.Diagnostics,
 		func(ctx context.Context) (bool, *http.Response, error) {
-			respModel, httpResp, err := r.kc.ApiClient.LoadBalancerTargetGroupAPI.
-				ListTargetsInTargetGroup(ctx, state.TargetGroupId.ValueString()).
-				XAuthToken(r.kc.XAuthToken).
-				Limit(1000).
-				Execute()
+			respModel, httpResp, err := listAllTargetGroupMembers(ctx, r.kc, r, state.TargetGroupId.ValueString(), &resp.Diagnostics)
 			if err != nil {
 				if httpResp != nil && httpResp.StatusCode == 404 {
 					return true, httpResp, nil
@@ -369,6 +393,81 @@ func (r *loadBalancerTargetGroupMemberResource) Delete(ctx context.Context, req
 	)
 }
 
+// drainTargetGroupMember stops new traffic to the member by setting its weight to 0, then waits
+// drainTimeout for in-flight requests. The load balancer lock is only held while the weight is
+// updated.
+func (r *loadBalancerTargetGroupMemberResource) drainTargetGroupMember(
+	ctx context.Context,
+	loadBalancerId string,
+	state *loadBalancerTargetGroupMemberResourceModel,
+	drainTimeout time.Duration,
+	respDiags *diag.Diagnostics,
+) bool {
+	if state.Weight.IsNull() || state.Weight.ValueInt32() != 0 {
+		found, ok := r.setTargetGroupMemberWeight(ctx, loadBalancerId, state, 0, respDiags)
+		if !ok || !found {
+			return ok
+		}
+	}
+
+	return waitForTargetGroupMembersDrained(ctx, r, state.TargetGroupId.ValueString(), drainTimeout, respDiags)
+}
+
+func (r *loadBalancerTargetGroupMemberResource) setTargetGroupMemberWeight(
+	ctx context.Context,
+	loadBalancerId string,
+	state *loadBalancerTargetGroupMemberResourceModel,
+	weight int32,
+	respDiags *diag.Diagnostics,
+) (bool, bool) {
+	mutex := common.LockForID(loadBalancerId)
+	mutex.Lock()
+	defer mutex.Unlock()
+
+	ok := CheckLoadBalancerStatus(ctx, loadBalancerId, true, r, r.kc, respDiags)
+	if !ok || respDiags.HasError() {
+		return false, false
+	}
+
+	updateReq := loadbalancer.NewBnsLoadBalancerV1ApiUpdateTargetModelEditTargetGroupMember()
+	updateReq.SetWeight(weight)
+	body := loadbalancer.NewBodyUpdateTarget(*updateReq)
+
+	_, httpResp, err := common.ExecuteWithRetryAndAuth(ctx, r.kc, respDiags, common.ServiceLoadBalancer,
+		func() (*loadbalancer.BnsLoadBalancerV1ApiUpdateTargetModelResponseTargetGroupMemberModel, *http.Response, error) {
+			return r.kc.ApiClient.LoadBalancerTargetGroupAPI.
+				UpdateTarget(ctx, state.TargetGroupId.ValueString(), state.Id.ValueString()).
+				XAuthToken(r.kc.XAuthToken).
+				BodyUpdateTarget(*body).
+				Execute()
+		},
+	)
+	if httpResp != nil && httpResp.StatusCode == http.StatusNotFound {
+		return false, true
+	}
+	if err != nil {
+		common.AddApiActionError(ctx, r, httpResp, "UpdateTarget", err, respDiags)
+		return false, false
+	}
+
+	result, ok := r.pollTargetGroupMemberUntilStatus(
+		ctx,
+		state.TargetGroupId.ValueString(),
+		state.Id.ValueString(),
+		[]string{common.LoadBalancerProvisioningStatusActive, common.LoadBalancerProvisioningStatusError},
+		respDiags,
+	)
+	if !ok || respDiags.HasError() {
+		return false, false
+	}
+
+	common.CheckResourceAvailableStatus(ctx, r, (*string)(result.ProvisioningStatus.Get()), []string{common.LoadBalancerProvisioningStatusActive}, respDiags)
+	if respDiags.HasError() {
+		return false, false
+	}
+	return true, true
+}
+
 func (r *loadBalancerTargetGroupMemberResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 
 	parts := strings.Split(req.ID, "/")
@@ -383,7 +482,7 @@ func (r *loadBalancerTargetGroupMemberResource) ImportState(ctx context.Context,
 }
 
 func (r *loadBalancerTargetGroupMemberResource) getLoadBalancerIdByTargetGroupId(ctx context.Context, targetGroupId string, respDiags *diag.Diagnostics) (*string, bool) {
-	respModel, httpResp, err := common.ExecuteWithRetryAndAuth(ctx, r.kc, respDiags,
+	respModel, httpResp, err := common.ExecuteWithRetryAndAuth(ctx, r.kc, respDiags, common.ServiceLoadBalancer,
 		func() (*loadbalancer.TargetGroupResponseModel, *http.Response, error) {
 			return r.kc.ApiClient.LoadBalancerTargetGroupAPI.GetTargetGroup(ctx, targetGroupId).
 				XAuthToken(r.kc.XAuthToken).Execute()
@@ -423,11 +522,7 @@ func (r *loadBalancerTargetGroupMemberResource) pollTargetGroupMemberUntilStatus
 
 		attemptCount++
 
-		respModel, _, err := r.kc.ApiClient.LoadBalancerTargetGroupAPI.
-			ListTargetsInTargetGroup(ctx, targetGroupId).
-			XAuthToken(r.kc.XAuthToken).
-			Limit(1000).
-			Execute()
+		respModel, _, err := listAllTargetGroupMembers(ctx, r.kc, r, targetGroupId, resp)
 		if err != nil {
 			lastErr = fmt.Errorf("failed to list targets in target group: %w", err)
 			if attemptCount >= maxAttempts {
@@ -468,3 +563,14 @@ func (r *loadBalancerTargetGroupMemberResource) pollTargetGroupMemberUntilStatus
 		time.Sleep(2 * time.Second)
 	}
 }
+
+func (r *loadBalancerTargetGroupMemberResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config loadBalancerTargetGroupMemberResourceModel
+	diags := req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	validateDrainTimeout(ctx, r, config.DrainTimeout, config.Timeouts, false, &resp.Diagnostics)
+}
@@ -7,12 +7,15 @@ import (
 	"terraform-provider-kakaocloud/internal/common"
 
 	"github.com/hashicorp/terraform-plugin-framework-validators/int32validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	dschema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	rschema "github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int32default"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int32planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
@@ -147,6 +150,35 @@ func getBaseMemberAttributes() map[string]rschema.Attribute {
 	}
 }
 
+// getWaitForHealthyAttributes returns the wait_for_healthy attributes. The minimums only make
+// sense when several members are managed together.
+func getWaitForHealthyAttributes(withMinimums bool) map[string]rschema.Attribute {
+	attrs := map[string]rschema.Attribute{
+		"timeout": rschema.StringAttribute{
+			Optional:   true,
+			Computed:   true,
+			Default:    stringdefault.StaticString("10m"),
+			Validators: common.DurationValidator(),
+		},
+	}
+	if withMinimums {
+		attrs["min_healthy_count"] = rschema.Int64Attribute{
+			Optional: true,
+			Validators: []validator.Int64{
+				int64validator.AtLeast(1),
+				int64validator.ConflictsWith(path.MatchRelative().AtParent().AtName("min_healthy_percentage")),
+			},
+		}
+		attrs["min_healthy_percentage"] = rschema.Int64Attribute{
+			Optional: true,
+			Validators: []validator.Int64{
+				int64validator.Between(1, 100),
+			},
+		}
+	}
+	return attrs
+}
+
 func getLoadBalancerTargetGroupMemberResourceSchema() map[string]rschema.Attribute {
 	baseAttrs := getBaseMemberAttributes()
 
@@ -177,6 +209,14 @@ func getLoadBalancerTargetGroupMemberResourceSchema() map[string]rschema.Attribu
 		"vpc_id":               baseAttrs["vpc_id"],
 		"subnet":               baseAttrs["subnet"],
 		"security_groups":      baseAttrs["security_groups"],
+		"drain_timeout": rschema.StringAttribute{
+			Optional:   true,
+			Validators: common.DurationValidator(),
+		},
+		"wait_for_healthy": rschema.SingleNestedAttribute{
+			Optional:   true,
+			Attributes: getWaitForHealthyAttributes(false),
+		},
 	}
 }
 
@@ -423,6 +463,14 @@ func getLoadBalancerTargetGroupMemberListResourceSchema() map[string]rschema.Att
 				},
 			},
 		},
+		"drain_timeout": rschema.StringAttribute{
+			Optional:   true,
+			Validators: common.DurationValidator(),
+		},
+		"wait_for_healthy": rschema.SingleNestedAttribute{
+			Optional:   true,
+			Attributes: getWaitForHealthyAttributes(true),
+		},
 	}
 }
 
@@ -173,9 +173,12 @@ func (d *loadBalancerTargetGroupMembersDataSource) Read(ctx context.Context, req
 		return
 	}
 
-	respModel, httpResp, err := common.ExecuteWithRetryAndAuth(ctx, d.kc, &resp.Diagnostics,
-		func() (*loadbalancer.TargetGroupMemberListModel, *http.Response, error) {
-			return memberApi.Limit(1000).XAuthToken(d.kc.XAuthToken).Execute()
+	memberPages, httpResp, err := common.ListAllPagesPartial(ctx, d.kc, d, &resp.Diagnostics, common.ServiceLoadBalancer,
+		func(limit int32, offset int32) (*loadbalancer.TargetGroupMemberListModel, *http.Response, error) {
+			return memberApi.Limit(limit).Offset(offset).XAuthToken(d.kc.XAuthToken).Execute()
+		},
+		func(page *loadbalancer.TargetGroupMemberListModel) int {
+			return len(page.Members)
 		},
 	)
 
@@ -184,6 +187,11 @@ func (d *loadBalancerTargetGroupMembersDataSource) Read(ctx context.Context, req
 		return
 	}
 
+	respModel := &loadbalancer.TargetGroupMemberListModel{}
+	for _, page := range memberPages {
+		respModel.Members = append(respModel.Members, page.Members...)
+	}
+
 	ok := mapLoadBalancerTargetGroupMemberListFromGetResponse(&data, respModel, &resp.Diagnostics)
 	if !ok || resp.Diagnostics.HasError() {
 		return
@@ -19,6 +19,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
 	"github.com/kakaoenterprise/kc-sdk-go/services/loadbalancer"
 )
 
@@ -83,6 +84,11 @@ func (r *loadBalancerTargetGroupMembersResource) Create(ctx context.Context, req
 
 	diags = resp.State.Set(ctx, &plan)
 	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.waitForHealthy(ctx, &plan, &resp.Diagnostics)
 }
 
 func (r *loadBalancerTargetGroupMembersResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -102,15 +108,7 @@ func (r *loadBalancerTargetGroupMembersResource) Read(ctx context.Context, req r
 	ctx, cancel := context.WithTimeout(ctx, timeout)
 	defer cancel()
 
-	respModel, httpResp, err := common.ExecuteWithRetryAndAuth(ctx, r.kc, &resp.Diagnostics,
-		func() (*loadbalancer.TargetGroupMemberListModel, *http.Response, error) {
-			return r.kc.ApiClient.LoadBalancerTargetGroupAPI.
-				ListTargetsInTargetGroup(ctx, state.TargetGroupId.ValueString()).
-				XAuthToken(r.kc.XAuthToken).
-				Limit(1000).
-				Execute()
-		},
-	)
+	respModel, httpResp, err := listAllTargetGroupMembers(ctx, r.kc, r, state.TargetGroupId.ValueString(), &resp.Diagnostics)
 
 	if err != nil {
 		common.AddApiActionError(ctx, r, httpResp, "ListTargetsInTargetGroup", err, &resp.Diagnostics)
@@ -118,6 +116,8 @@ func (r *loadBalancerTargetGroupMembersResource) Read(ctx context.Context, req r
 	}
 
 	newState.TargetGroupId = state.TargetGroupId
+	newState.DrainTimeout = state.DrainTimeout
+	newState.WaitForHealthy = state.WaitForHealthy
 	newState.Timeouts = state.Timeouts
 
 	if state.Members != nil {
@@ -173,13 +173,49 @@ func (r *loadBalancerTargetGroupMembersResource) Read(ctx context.Context, req r
 }
 
 func (r *loadBalancerTargetGroupMembersResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
-	var plan loadBalancerTargetGroupMemberListResourceModel
+	var plan, state loadBalancerTargetGroupMemberListResourceModel
 	diags := req.Plan.Get(ctx, &plan)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	diags = req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if drainTimeout := drainTimeoutValue(plan.DrainTimeout); drainTimeout > 0 && plan.TargetGroupId.Equal(state.TargetGroupId) {
+		planned := make(map[string]struct{}, len(plan.Members))
+		for _, member := range plan.Members {
+			planned[targetGroupMemberKey(member.Address.ValueString(), member.ProtocolPort.ValueInt32())] = struct{}{}
+		}
+		var removed []loadBalancerTargetGroupMemberBatchModel
+		for _, member := range state.Members {
+			if _, ok := planned[targetGroupMemberKey(member.Address.ValueString(), member.ProtocolPort.ValueInt32())]; !ok {
+				removed = append(removed, member)
+			}
+		}
+
+		if len(removed) > 0 {
+			draining := loadBalancerTargetGroupMemberListResourceModel{
+				TargetGroupId: plan.TargetGroupId,
+				Members:       append(append([]loadBalancerTargetGroupMemberBatchModel{}, plan.Members...), removed...),
+				DrainTimeout:  plan.DrainTimeout,
+				Timeouts:      plan.Timeouts,
+			}
+			timeout, diags := plan.Timeouts.Update(ctx, common.DefaultUpdateTimeout)
+			resp.Diagnostics.Append(diags...)
+			if resp.Diagnostics.HasError() {
+				return
+			}
+			if !r.drainTargetGroupMembers(ctx, &draining, removed, drainTimeout, timeout, &resp.Diagnostics) {
+				return
+			}
+		}
+	}
+
 	r.batchRequest(ctx, &plan, &resp.Diagnostics)
 	if resp.Diagnostics.HasError() {
 		return
@@ -187,6 +223,11 @@ func (r *loadBalancerTargetGroupMembersResource) Update(ctx context.Context, req
 
 	diags = resp.State.Set(ctx, &plan)
 	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.waitForHealthy(ctx, &plan, &resp.Diagnostics)
 }
 
 func (r *loadBalancerTargetGroupMembersResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
@@ -197,10 +238,30 @@ func (r *loadBalancerTargetGroupMembersResource) Delete(ctx context.Context, req
 		return
 	}
 
+	if drainTimeout := drainTimeoutValue(state.DrainTimeout); drainTimeout > 0 && len(state.Members) > 0 {
+		draining := loadBalancerTargetGroupMemberListResourceModel{
+			TargetGroupId:  state.TargetGroupId,
+			Members:        append([]loadBalancerTargetGroupMemberBatchModel{}, state.Members...),
+			DrainTimeout:   state.DrainTimeout,
+			WaitForHealthy: state.WaitForHealthy,
+			Timeouts:       state.Timeouts,
+		}
+		timeout, diags := state.Timeouts.Delete(ctx, common.DefaultDeleteTimeout)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		if !r.drainTargetGroupMembers(ctx, &draining, state.Members, drainTimeout, timeout, &resp.Diagnostics) {
+			return
+		}
+	}
+
 	plan := loadBalancerTargetGroupMemberListResourceModel{
-		state.TargetGroupId,
-		[]loadBalancerTargetGroupMemberBatchModel{},
-		state.Timeouts,
+		TargetGroupId:  state.TargetGroupId,
+		Members:        []loadBalancerTargetGroupMemberBatchModel{},
+		DrainTimeout:   state.DrainTimeout,
+		WaitForHealthy: state.WaitForHealthy,
+		Timeouts:       state.Timeouts,
 	}
 
 	r.batchRequest(ctx, &plan, &resp.Diagnostics)
@@ -210,11 +271,7 @@ func (r *loadBalancerTargetGroupMembersResource) Delete(ctx context.Context, req
 
 	common.PollUntilDeletion(ctx, r, 2*time.Second, &resp.Diagnostics,
 		func(ctx context.Context) (bool, *http.Response, error) {
-			respModel, httpResp, err := r.kc.ApiClient.LoadBalancerTargetGroupAPI.
-				ListTargetsInTargetGroup(ctx, state.TargetGroupId.ValueString()).
-				XAuthToken(r.kc.XAuthToken).
-				Limit(1000).
-				Execute()
+			respModel, httpResp, err := listAllTargetGroupMembers(ctx, r.kc, r, state.TargetGroupId.ValueString(), &resp.Diagnostics)
 			if err != nil {
 				if httpResp != nil && httpResp.StatusCode == 404 {
 					return true, httpResp, nil
@@ -240,7 +297,7 @@ func (r *loadBalancerTargetGroupMembersResource) ImportState(ctx context.Context
 }
 
 func (r *loadBalancerTargetGroupMembersResource) getLoadBalancerIdByTargetGroupId(ctx context.Context, targetGroupId string, respDiags *diag.Diagnostics) (*string, bool) {
-	respModel, httpResp, err := common.ExecuteWithRetryAndAuth(ctx, r.kc, respDiags,
+	respModel, httpResp, err := common.ExecuteWithRetryAndAuth(ctx, r.kc, respDiags, common.ServiceLoadBalancer,
 		func() (*loadbalancer.TargetGroupResponseModel, *http.Response, error) {
 			return r.kc.ApiClient.LoadBalancerTargetGroupAPI.GetTargetGroup(ctx, targetGroupId).
 				XAuthToken(r.kc.XAuthToken).Execute()
@@ -280,7 +337,7 @@ func (r *loadBalancerTargetGroupMembersResource) batchRequest(ctx context.Contex
 
 	batchReqBody := mapLoadBalancerTargetGroupMembersToBatchRequest(plan)
 
-	_, httpResp, err := common.ExecuteWithRetryAndAuth(ctx, r.kc, resp,
+	_, httpResp, err := common.ExecuteWithRetryAndAuth(ctx, r.kc, resp, common.ServiceLoadBalancer,
 		func() (interface{}, *http.Response, error) {
 			return r.kc.ApiClient.LoadBalancerTargetGroupAPI.
 				UpdateTargets(ctx, plan.TargetGroupId.ValueString()).
@@ -326,6 +383,69 @@ func (r *loadBalancerTargetGroupMembersResource) batchRequest(ctx context.Contex
 	}
 }
 
+// waitForHealthy waits until enough of the planned members are healthy, as configured by
+// wait_for_healthy. It runs after the state is saved so that a failure keeps the members.
+func (r *loadBalancerTargetGroupMembersResource) waitForHealthy(ctx context.Context, plan *loadBalancerTargetGroupMemberListResourceModel, resp *diag.Diagnostics) {
+	if plan.WaitForHealthy.IsNull() || plan.WaitForHealthy.IsUnknown() || len(plan.Members) == 0 {
+		return
+	}
+
+	var waitForHealthy loadBalancerTargetGroupMembersWaitForHealthyModel
+	resp.Append(plan.WaitForHealthy.As(ctx, &waitForHealthy, basetypes.ObjectAsOptions{})...)
+	if resp.HasError() {
+		return
+	}
+
+	keys := make(map[string]struct{}, len(plan.Members))
+	for _, member := range plan.Members {
+		keys[targetGroupMemberKey(member.Address.ValueString(), member.ProtocolPort.ValueInt32())] = struct{}{}
+	}
+	required := requiredHealthyMembers(len(plan.Members), waitForHealthy.MinHealthyCount, waitForHealthy.MinHealthyPercentage)
+
+	waitForTargetGroupMembersHealthy(ctx, r.kc, r, plan.TargetGroupId.ValueString(), waitForHealthyTimeoutValue(waitForHealthy.Timeout), required,
+		func(member *loadbalancer.BnsLoadBalancerV1ApiListTargetsInTargetGroupModelTargetGroupMemberModel) bool {
+			_, ok := keys[targetGroupMemberKey(
+				utils.ConvertNullableString(member.IpAddress).ValueString(),
+				utils.ConvertNullableInt32(member.ProtocolPort).ValueInt32(),
+			)]
+			return ok
+		},
+		resp,
+	)
+}
+
+// drainTargetGroupMembers applies draining, in which the members being removed are still listed
+// but with a weight of 0, and then waits drainTimeout for their in-flight requests. timeout is
+// the timeout of the operation that removes the members.
+func (r *loadBalancerTargetGroupMembersResource) drainTargetGroupMembers(
+	ctx context.Context,
+	draining *loadBalancerTargetGroupMemberListResourceModel,
+	removed []loadBalancerTargetGroupMemberBatchModel,
+	drainTimeout time.Duration,
+	timeout time.Duration,
+	resp *diag.Diagnostics,
+) bool {
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	keys := make(map[string]struct{}, len(removed))
+	for _, member := range removed {
+		keys[targetGroupMemberKey(member.Address.ValueString(), member.ProtocolPort.ValueInt32())] = struct{}{}
+	}
+	for i := range draining.Members {
+		if _, ok := keys[targetGroupMemberKey(draining.Members[i].Address.ValueString(), draining.Members[i].ProtocolPort.ValueInt32())]; ok {
+			draining.Members[i].Weight = types.Int32Value(0)
+		}
+	}
+
+	r.batchRequest(ctx, draining, resp)
+	if resp.HasError() {
+		return false
+	}
+
+	return waitForTargetGroupMembersDrained(ctx, r, draining.TargetGroupId.ValueString(), drainTimeout, resp)
+}
+
 func (r *loadBalancerTargetGroupMembersResource) pollTargetGroupMembersUntilStatus(
 	ctx context.Context,
 	targetGroupId string,
@@ -373,15 +493,7 @@ func (r *loadBalancerTargetGroupMembersResource) pollTargetGroupMembersUntilStat
 
 		attemptCount++
 
-		respModel, _, err := common.ExecuteWithRetryAndAuth(ctx, r.kc, resp,
-			func() (*loadbalancer.TargetGroupMemberListModel, *http.Response, error) {
-				return r.kc.ApiClient.LoadBalancerTargetGroupAPI.
-					ListTargetsInTargetGroup(ctx, targetGroupId).
-					XAuthToken(r.kc.XAuthToken).
-					Limit(1000).
-					Execute()
-			},
-		)
+		respModel, _, err := listAllTargetGroupMembers(ctx, r.kc, r, targetGroupId, resp)
 		if err != nil {
 			lastErr = fmt.Errorf("failed to list targets in target group: %w", err)
 			if attemptCount >= maxAttempts {
@@ -451,10 +563,31 @@ func (r *loadBalancerTargetGroupMembersResource) ValidateConfig(ctx context.Cont
 		return
 	}
 
+	validateDrainTimeout(ctx, r, config.DrainTimeout, config.Timeouts, true, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	if len(config.Members) == 0 {
 		return
 	}
 
+	if !config.WaitForHealthy.IsNull() && !config.WaitForHealthy.IsUnknown() {
+		var waitForHealthy loadBalancerTargetGroupMembersWaitForHealthyModel
+		resp.Diagnostics.Append(config.WaitForHealthy.As(ctx, &waitForHealthy, basetypes.ObjectAsOptions{})...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		if !waitForHealthy.MinHealthyCount.IsNull() && !waitForHealthy.MinHealthyCount.IsUnknown() &&
+			waitForHealthy.MinHealthyCount.ValueInt64() > int64(len(config.Members)) {
+			common.AddValidationConfigError(ctx, r, &resp.Diagnostics,
+				fmt.Sprintf("wait_for_healthy.min_healthy_count (%d) cannot be greater than the number of members (%d).",
+					waitForHealthy.MinHealthyCount.ValueInt64(), len(config.Members)),
+			)
+			return
+		}
+	}
+
 	seen := make(map[string]struct{})
 
 	for _, member := range config.Members {
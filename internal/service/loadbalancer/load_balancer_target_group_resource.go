@@ -96,7 +96,7 @@ func (r *loadBalancerTargetGroupResource) Create(ctx context.Context, req resour
 
 	body := loadbalancer.BodyCreateTargetGroup{TargetGroup: *createReq}
 
-	respModel, httpResp, err := common.ExecuteWithRetryAndAuth(ctx, r.kc, &resp.Diagnostics,
+	respModel, httpResp, err := common.ExecuteWithRetryAndAuth(ctx, r.kc, &resp.Diagnostics, common.ServiceLoadBalancer,
 		func() (*loadbalancer.BnsLoadBalancerV1ApiCreateTargetGroupModelResponseTargetGroupModel, *http.Response, error) {
 			return r.kc.ApiClient.LoadBalancerTargetGroupAPI.
 				CreateTargetGroup(ctx).
@@ -158,7 +158,7 @@ func (r *loadBalancerTargetGroupResource) Read(ctx context.Context, req resource
 	ctx, cancel := context.WithTimeout(ctx, timeout)
 	defer cancel()
 
-	respModel, httpResp, err := common.ExecuteWithRetryAndAuth(ctx, r.kc, &resp.Diagnostics,
+	respModel, httpResp, err := common.ExecuteWithRetryAndAuth(ctx, r.kc, &resp.Diagnostics, common.ServiceLoadBalancer,
 		func() (*loadbalancer.TargetGroupResponseModel, *http.Response, error) {
 			return r.kc.ApiClient.LoadBalancerTargetGroupAPI.
 				GetTargetGroup(ctx, state.Id.ValueString()).
@@ -264,7 +264,7 @@ func (r *loadBalancerTargetGroupResource) Update(ctx context.Context, req resour
 
 	body := loadbalancer.NewBodyUpdateTargetGroup(*updateReq)
 
-	_, httpResp, err := common.ExecuteWithRetryAndAuth(ctx, r.kc, &resp.Diagnostics,
+	_, httpResp, err := common.ExecuteWithRetryAndAuth(ctx, r.kc, &resp.Diagnostics, common.ServiceLoadBalancer,
 		func() (*loadbalancer.BnsLoadBalancerV1ApiUpdateTargetGroupModelResponseTargetGroupModel, *http.Response, error) {
 			return r.kc.ApiClient.LoadBalancerTargetGroupAPI.
 				UpdateTargetGroup(ctx, state.Id.ValueString()).
@@ -328,7 +328,7 @@ func (r *loadBalancerTargetGroupResource) Delete(ctx context.Context, req resour
 		return
 	}
 
-	_, httpResp, err := common.ExecuteWithRetryAndAuth(ctx, r.kc, &resp.Diagnostics,
+	_, httpResp, err := common.ExecuteWithRetryAndAuth(ctx, r.kc, &resp.Diagnostics, common.ServiceLoadBalancer,
 		func() (interface{}, *http.Response, error) {
 			httpResp, err := r.kc.ApiClient.LoadBalancerTargetGroupAPI.
 				DeleteTargetGroup(ctx, state.Id.ValueString()).
@@ -382,7 +382,7 @@ func (r *loadBalancerTargetGroupResource) pollTargetGroupUntilStatus(
 		targetStatuses,
 		diags,
 		func(ctx context.Context) (*loadbalancer.BnsLoadBalancerV1ApiGetTargetGroupModelTargetGroupModel, *http.Response, error) {
-			respModel, httpResp, err := common.ExecuteWithRetryAndAuth(ctx, r.kc, diags,
+			respModel, httpResp, err := common.ExecuteWithRetryAndAuth(ctx, r.kc, diags, common.ServiceLoadBalancer,
 				func() (*loadbalancer.TargetGroupResponseModel, *http.Response, error) {
 					return r.kc.ApiClient.LoadBalancerTargetGroupAPI.
 						GetTargetGroup(ctx, targetGroupId).
@@ -173,9 +173,12 @@ func (d *loadBalancerTargetGroupsDataSource) Read(ctx context.Context, req datas
 		return
 	}
 
-	respModel, httpResp, err := common.ExecuteWithRetryAndAuth(ctx, d.kc, &resp.Diagnostics,
-		func() (*loadbalancer.TargetGroupListModel, *http.Response, error) {
-			return targetGroupApi.Limit(1000).XAuthToken(d.kc.XAuthToken).Execute()
+	targetGroupPages, httpResp, err := common.ListAllPagesPartial(ctx, d.kc, d, &resp.Diagnostics, common.ServiceLoadBalancer,
+		func(limit int32, offset int32) (*loadbalancer.TargetGroupListModel, *http.Response, error) {
+			return targetGroupApi.Limit(limit).Offset(offset).XAuthToken(d.kc.XAuthToken).Execute()
+		},
+		func(page *loadbalancer.TargetGroupListModel) int {
+			return len(page.TargetGroups)
 		},
 	)
 
@@ -185,11 +188,15 @@ func (d *loadBalancerTargetGroupsDataSource) Read(ctx context.Context, req datas
 	}
 
 	var targetGroupResult []loadbalancer.BnsLoadBalancerV1ApiGetTargetGroupModelTargetGroupModel
-	err = copier.Copy(&targetGroupResult, &respModel.TargetGroups)
-	if err != nil {
-		common.AddGeneralError(ctx, d, &resp.Diagnostics,
-			fmt.Sprintf("Failed to convert targetGroupResult: %v", err))
-		return
+	for _, respModel := range targetGroupPages {
+		var pageResult []loadbalancer.BnsLoadBalancerV1ApiGetTargetGroupModelTargetGroupModel
+		err = copier.Copy(&pageResult, &respModel.TargetGroups)
+		if err != nil {
+			common.AddGeneralError(ctx, d, &resp.Diagnostics,
+				fmt.Sprintf("Failed to convert targetGroupResult: %v", err))
+			return
+		}
+		targetGroupResult = append(targetGroupResult, pageResult...)
 	}
 
 	for _, v := range targetGroupResult {
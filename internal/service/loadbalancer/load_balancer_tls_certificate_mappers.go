@@ -0,0 +1,25 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+package loadbalancer
+
+import (
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/kakaoenterprise/kc-sdk-go/services/loadbalancer"
+)
+
+func mapLoadBalancerTlsCertificateModel(
+	model *loadBalancerTlsCertificateResourceModel,
+	src *loadbalancer.BnsLoadBalancerV1ApiGetTlsCertificateModelSecretModel,
+) {
+	model.Id = types.StringValue(src.Id)
+	model.Name = types.StringValue(src.Name)
+	model.SecretRef = types.StringValue(src.SecretRef)
+	model.SecretType = types.StringValue(src.SecretType)
+	model.Expiration = types.StringValue(src.Expiration)
+	model.Status = types.StringValue(src.Status)
+	model.CreatorId = types.StringValue(src.CreatorId)
+	model.CreatedAt = types.StringValue(src.CreatedAt.Format(time.RFC3339))
+	model.UpdatedAt = types.StringValue(src.UpdatedAt.Format(time.RFC3339))
+}
@@ -0,0 +1,25 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+package loadbalancer
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type loadBalancerTlsCertificateResourceModel struct {
+	Id               types.String   `tfsdk:"id"`
+	Name             types.String   `tfsdk:"name"`
+	NamePrefix       types.String   `tfsdk:"name_prefix"`
+	Certificate      types.String   `tfsdk:"certificate"`
+	CertificateChain types.String   `tfsdk:"certificate_chain"`
+	PrivateKey       types.String   `tfsdk:"private_key"`
+	SecretRef        types.String   `tfsdk:"secret_ref"`
+	SecretType       types.String   `tfsdk:"secret_type"`
+	Expiration       types.String   `tfsdk:"expiration"`
+	Status           types.String   `tfsdk:"status"`
+	CreatorId        types.String   `tfsdk:"creator_id"`
+	CreatedAt        types.String   `tfsdk:"created_at"`
+	UpdatedAt        types.String   `tfsdk:"updated_at"`
+	Timeouts         timeouts.Value `tfsdk:"timeouts"`
+}
@@ -0,0 +1,61 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+package loadbalancer
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"time"
+)
+
+// parsePemCertificates returns every CERTIFICATE block in value, in order.
+func parsePemCertificates(value string) ([]*x509.Certificate, error) {
+	var certs []*x509.Certificate
+	rest := []byte(value)
+	for {
+		var block *pem.Block
+		block, rest = pem.Decode(rest)
+		if block == nil {
+			break
+		}
+		if block.Type != "CERTIFICATE" {
+			return nil, fmt.Errorf("unexpected PEM block %q, only CERTIFICATE blocks are allowed", block.Type)
+		}
+		cert, err := x509.ParseCertificate(block.Bytes)
+		if err != nil {
+			return nil, fmt.Errorf("invalid certificate: %w", err)
+		}
+		certs = append(certs, cert)
+	}
+	if len(certs) == 0 {
+		return nil, errors.New("no PEM encoded certificate found")
+	}
+	return certs, nil
+}
+
+// validateTlsCertificatePem checks that certificate is a single PEM certificate, that chain only
+// holds certificates, and, when privateKey is set, that the key matches the certificate. It
+// returns the expiration of the leaf certificate.
+func validateTlsCertificatePem(certificate, chain, privateKey string) (time.Time, error) {
+	certs, err := parsePemCertificates(certificate)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("certificate: %w", err)
+	}
+	if len(certs) != 1 {
+		return time.Time{}, errors.New("certificate: must contain exactly one certificate, put intermediates in certificate_chain")
+	}
+	if chain != "" {
+		if _, err := parsePemCertificates(chain); err != nil {
+			return time.Time{}, fmt.Errorf("certificate_chain: %w", err)
+		}
+	}
+	if privateKey != "" {
+		if _, err := tls.X509KeyPair([]byte(certificate), []byte(privateKey)); err != nil {
+			return time.Time{}, fmt.Errorf("private_key: %w", err)
+		}
+	}
+	return certs[0].NotAfter, nil
+}
@@ -0,0 +1,85 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+package loadbalancer
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"strings"
+	"testing"
+	"time"
+)
+
+func testCertificatePem(t *testing.T, notAfter time.Time) (string, string) {
+	t.Helper()
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: "example.com"},
+		NotBefore:    notAfter.Add(-24 * time.Hour),
+		NotAfter:     notAfter,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	keyDer, err := x509.MarshalECPrivateKey(key)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	certPem := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})
+	keyPem := pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDer})
+	return string(certPem), string(keyPem)
+}
+
+func TestValidateTlsCertificatePem_returnsExpiration(t *testing.T) {
+	notAfter := time.Date(2030, 1, 2, 3, 4, 5, 0, time.UTC)
+	cert, key := testCertificatePem(t, notAfter)
+	chain, _ := testCertificatePem(t, notAfter)
+
+	expiration, err := validateTlsCertificatePem(cert, chain, key)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !expiration.Equal(notAfter) {
+		t.Errorf("expiration = %s, want %s", expiration, notAfter)
+	}
+}
+
+func TestValidateTlsCertificatePem_rejectsInvalidInput(t *testing.T) {
+	notAfter := time.Now().Add(time.Hour).Truncate(time.Second)
+	cert, key := testCertificatePem(t, notAfter)
+	otherCert, otherKey := testCertificatePem(t, notAfter)
+
+	tests := []struct {
+		name        string
+		certificate string
+		chain       string
+		privateKey  string
+		wantErr     string
+	}{
+		{"not PEM", "not a certificate", "", "", "certificate: no PEM encoded certificate found"},
+		{"key as certificate", key, "", "", "certificate: unexpected PEM block"},
+		{"bundled chain", cert + otherCert, "", "", "exactly one certificate"},
+		{"invalid chain", cert, otherKey, "", "certificate_chain: unexpected PEM block"},
+		{"mismatched key", cert, "", otherKey, "private_key:"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := validateTlsCertificatePem(tt.certificate, tt.chain, tt.privateKey)
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Errorf("error = %v, want it to contain %q", err, tt.wantErr)
+			}
+		})
+	}
+}
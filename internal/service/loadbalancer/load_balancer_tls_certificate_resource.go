@@ -0,0 +1,299 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+package loadbalancer
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"terraform-provider-kakaocloud/internal/common"
+	"terraform-provider-kakaocloud/internal/utils"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/kakaoenterprise/kc-sdk-go/services/loadbalancer"
+)
+
+var (
+	_ resource.Resource                   = &loadBalancerTlsCertificateResource{}
+	_ resource.ResourceWithConfigure      = &loadBalancerTlsCertificateResource{}
+	_ resource.ResourceWithValidateConfig = &loadBalancerTlsCertificateResource{}
+)
+
+func NewLoadBalancerTlsCertificateResource() resource.Resource {
+	return &loadBalancerTlsCertificateResource{}
+}
+
+type loadBalancerTlsCertificateResource struct {
+	kc *common.KakaoCloudClient
+}
+
+func (r *loadBalancerTlsCertificateResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	client, ok := req.ProviderData.(*common.KakaoCloudClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *common.KakaoCloudClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+	r.kc = client
+}
+
+func (r *loadBalancerTlsCertificateResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_load_balancer_tls_certificate"
+}
+
+func (r *loadBalancerTlsCertificateResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: utils.MergeResourceSchemaAttributes(
+			loadBalancerTlsCertificateResourceSchema,
+			map[string]schema.Attribute{
+				"timeouts": timeouts.AttributesAll(ctx),
+			},
+		),
+	}
+}
+
+func (r *loadBalancerTlsCertificateResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan, config loadBalancerTlsCertificateResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	timeout, diags := plan.Timeouts.Create(ctx, common.DefaultCreateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	name := plan.Name.ValueString()
+	if plan.Name.IsNull() || plan.Name.IsUnknown() {
+		prefix := "tls-"
+		if !plan.NamePrefix.IsNull() {
+			prefix = plan.NamePrefix.ValueString()
+		}
+		name = prefix + time.Now().UTC().Format("20060102150405")
+	}
+
+	createReq := loadbalancer.CreateTlsCertificateModel{
+		Name:        name,
+		Certificate: plan.Certificate.ValueString(),
+		PrivateKey:  config.PrivateKey.ValueString(),
+	}
+	if !plan.CertificateChain.IsNull() {
+		createReq.SetCertificateChain(plan.CertificateChain.ValueString())
+	}
+
+	body := *loadbalancer.NewBodyCreateTlsCertificate(createReq)
+
+	respModel, httpResp, err := common.ExecuteWithRetryAndAuth(ctx, r.kc, &resp.Diagnostics, common.ServiceLoadBalancer,
+		func() (*loadbalancer.BnsLoadBalancerV1ApiCreateTlsCertificateModelResponseSecretModel, *http.Response, error) {
+			return r.kc.ApiClient.LoadBalancerEtcAPI.CreateTlsCertificate(ctx).
+				XAuthToken(r.kc.XAuthToken).
+				BodyCreateTlsCertificate(body).
+				Execute()
+		},
+	)
+	if err != nil {
+		common.AddApiActionError(ctx, r, httpResp, "CreateTlsCertificate", err, &resp.Diagnostics)
+		return
+	}
+
+	plan.Id = types.StringValue(respModel.Secret.Id)
+
+	result, ok := r.getTlsCertificate(ctx, plan.Id.ValueString(), &resp.Diagnostics)
+	if !ok || resp.Diagnostics.HasError() {
+		return
+	}
+
+	mapLoadBalancerTlsCertificateModel(&plan, result)
+
+	diags = resp.State.Set(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *loadBalancerTlsCertificateResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state loadBalancerTlsCertificateResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	timeout, diags := state.Timeouts.Read(ctx, common.DefaultReadTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	respModel, httpResp, err := common.ExecuteWithRetryAndAuth(ctx, r.kc, &resp.Diagnostics, common.ServiceLoadBalancer,
+		func() (*loadbalancer.BnsLoadBalancerV1ApiGetTlsCertificateModelResponseSecretModel, *http.Response, error) {
+			return r.kc.ApiClient.LoadBalancerEtcAPI.GetTlsCertificate(ctx, state.Id.ValueString()).
+				XAuthToken(r.kc.XAuthToken).
+				Execute()
+		},
+	)
+	if httpResp != nil && httpResp.StatusCode == http.StatusNotFound {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		common.AddApiActionError(ctx, r, httpResp, "GetTlsCertificate", err, &resp.Diagnostics)
+		return
+	}
+
+	mapLoadBalancerTlsCertificateModel(&state, &respModel.Secret)
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Update only changes timeouts and the write-only private_key, as every other argument forces a
+// new certificate. The certificate is read again so that status and updated_at are known.
+func (r *loadBalancerTlsCertificateResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan loadBalancerTlsCertificateResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	timeout, diags := plan.Timeouts.Update(ctx, common.DefaultUpdateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	result, ok := r.getTlsCertificate(ctx, plan.Id.ValueString(), &resp.Diagnostics)
+	if !ok || resp.Diagnostics.HasError() {
+		return
+	}
+
+	mapLoadBalancerTlsCertificateModel(&plan, result)
+
+	diags = resp.State.Set(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *loadBalancerTlsCertificateResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state loadBalancerTlsCertificateResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	timeout, diags := state.Timeouts.Delete(ctx, common.DefaultDeleteTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	_, httpResp, err := common.ExecuteWithRetryAndAuth(ctx, r.kc, &resp.Diagnostics, common.ServiceLoadBalancer,
+		func() (struct{}, *http.Response, error) {
+			httpResp, err := r.kc.ApiClient.LoadBalancerEtcAPI.
+				DeleteTlsCertificate(ctx, state.Id.ValueString()).
+				XAuthToken(r.kc.XAuthToken).
+				Execute()
+			return struct{}{}, httpResp, err
+		},
+	)
+	if err != nil {
+		if httpResp != nil && httpResp.StatusCode == http.StatusNotFound {
+			return
+		}
+		common.AddApiActionError(ctx, r, httpResp, "DeleteTlsCertificate", err, &resp.Diagnostics)
+		return
+	}
+
+	common.PollUntilDeletion(ctx, r, 2*time.Second, &resp.Diagnostics, func(ctx context.Context) (bool, *http.Response, error) {
+		_, httpResp, err := common.ExecuteWithRetryAndAuth(ctx, r.kc, &resp.Diagnostics, common.ServiceLoadBalancer,
+			func() (*loadbalancer.BnsLoadBalancerV1ApiGetTlsCertificateModelResponseSecretModel, *http.Response, error) {
+				return r.kc.ApiClient.LoadBalancerEtcAPI.
+					GetTlsCertificate(ctx, state.Id.ValueString()).
+					XAuthToken(r.kc.XAuthToken).
+					Execute()
+			},
+		)
+		return false, httpResp, err
+	})
+}
+
+func (r *loadBalancerTlsCertificateResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config loadBalancerTlsCertificateResourceModel
+	diags := req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if config.Certificate.IsNull() || config.Certificate.IsUnknown() ||
+		config.CertificateChain.IsUnknown() || config.PrivateKey.IsUnknown() {
+		return
+	}
+
+	expiration, err := validateTlsCertificatePem(
+		config.Certificate.ValueString(),
+		config.CertificateChain.ValueString(),
+		config.PrivateKey.ValueString(),
+	)
+	if err != nil {
+		common.AddValidationConfigError(ctx, r, &resp.Diagnostics, fmt.Sprintf("Invalid TLS certificate: %v", err))
+		return
+	}
+	if !expiration.After(time.Now()) {
+		resp.Diagnostics.AddAttributeWarning(
+			path.Root("certificate"),
+			"Expired TLS certificate",
+			fmt.Sprintf("The certificate expired at %s. Clients will reject it once it is used by a listener.", expiration.UTC().Format(time.RFC3339)),
+		)
+	}
+}
+
+func (r *loadBalancerTlsCertificateResource) getTlsCertificate(
+	ctx context.Context,
+	id string,
+	respDiags *diag.Diagnostics,
+) (*loadbalancer.BnsLoadBalancerV1ApiGetTlsCertificateModelSecretModel, bool) {
+	respModel, httpResp, err := common.ExecuteWithRetryAndAuth(ctx, r.kc, respDiags, common.ServiceLoadBalancer,
+		func() (*loadbalancer.BnsLoadBalancerV1ApiGetTlsCertificateModelResponseSecretModel, *http.Response, error) {
+			return r.kc.ApiClient.LoadBalancerEtcAPI.GetTlsCertificate(ctx, id).
+				XAuthToken(r.kc.XAuthToken).
+				Execute()
+		},
+	)
+	if err != nil {
+		common.AddApiActionError(ctx, r, httpResp, "GetTlsCertificate", err, respDiags)
+		return nil, false
+	}
+	return &respModel.Secret, true
+}
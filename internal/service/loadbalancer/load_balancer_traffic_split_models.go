@@ -0,0 +1,27 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+package loadbalancer
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type loadBalancerTrafficSplitResourceModel struct {
+	Id                 types.String                           `tfsdk:"id"`
+	LoadBalancerId     types.String                           `tfsdk:"load_balancer_id"`
+	TargetGroupId      types.String                           `tfsdk:"target_group_id"`
+	Backends           []loadBalancerTrafficSplitBackendModel `tfsdk:"backends"`
+	StepPercentage     types.Int64                            `tfsdk:"step_percentage"`
+	StepInterval       types.String                           `tfsdk:"step_interval"`
+	HealthCheck        types.Bool                             `tfsdk:"health_check"`
+	HealthCheckTimeout types.String                           `tfsdk:"health_check_timeout"`
+	Timeouts           timeouts.Value                         `tfsdk:"timeouts"`
+}
+
+type loadBalancerTrafficSplitBackendModel struct {
+	Addresses    []types.String `tfsdk:"addresses"`
+	Percentage   types.Int64    `tfsdk:"percentage"`
+	MemberCount  types.Int64    `tfsdk:"member_count"`
+	MemberWeight types.Int32    `tfsdk:"member_weight"`
+}
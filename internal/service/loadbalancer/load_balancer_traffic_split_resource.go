@@ -0,0 +1,572 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+package loadbalancer
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"net/http"
+	"terraform-provider-kakaocloud/internal/common"
+	"terraform-provider-kakaocloud/internal/utils"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/kakaoenterprise/kc-sdk-go/services/loadbalancer"
+)
+
+var (
+	_ resource.ResourceWithConfigure      = &loadBalancerTrafficSplitResource{}
+	_ resource.ResourceWithValidateConfig = &loadBalancerTrafficSplitResource{}
+)
+
+func NewLoadBalancerTrafficSplitResource() resource.Resource {
+	return &loadBalancerTrafficSplitResource{}
+}
+
+type loadBalancerTrafficSplitResource struct {
+	kc *common.KakaoCloudClient
+}
+
+// trafficSplitTargetGroup holds the current members of the target group and the backend each
+// member address belongs to. Members without a backend keep their weight.
+type trafficSplitTargetGroup struct {
+	id       string
+	members  []loadbalancer.BnsLoadBalancerV1ApiListTargetsInTargetGroupModelTargetGroupMemberModel
+	backends map[string]int
+}
+
+func (r *loadBalancerTrafficSplitResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_load_balancer_traffic_split"
+}
+
+func (r *loadBalancerTrafficSplitResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: utils.MergeResourceSchemaAttributes(
+			loadBalancerTrafficSplitResourceSchema,
+			map[string]schema.Attribute{
+				"timeouts": timeouts.AttributesAll(ctx),
+			},
+		),
+	}
+}
+
+func (r *loadBalancerTrafficSplitResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	kc, ok := req.ProviderData.(*common.KakaoCloudClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *common.KakaoCloudClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.kc = kc
+}
+
+func (r *loadBalancerTrafficSplitResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan loadBalancerTrafficSplitResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	timeout, diags := plan.Timeouts.Create(ctx, common.DefaultCreateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	plan.Id = plan.TargetGroupId
+
+	if applied, _ := r.shiftTraffic(ctx, &plan, nil, &resp.Diagnostics); !applied {
+		return
+	}
+
+	diags = resp.State.Set(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *loadBalancerTrafficSplitResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state loadBalancerTrafficSplitResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	timeout, diags := state.Timeouts.Read(ctx, common.DefaultReadTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	tg, httpResp, err := r.listTrafficSplitTargetGroup(ctx, state.TargetGroupId.ValueString(), state.Backends, &resp.Diagnostics)
+	if httpResp != nil && httpResp.StatusCode == http.StatusNotFound {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		common.AddApiActionError(ctx, r, httpResp, "ListTargetsInTargetGroup", err, &resp.Diagnostics)
+		return
+	}
+
+	counts := trafficSplitMemberCounts(tg, len(state.Backends))
+	live := trafficSplitLiveWeights(tg, len(state.Backends))
+	for i := range state.Backends {
+		state.Backends[i].MemberCount = types.Int64Value(int64(counts[i]))
+		state.Backends[i].MemberWeight = types.Int32Null()
+		if len(live[i]) > 0 {
+			state.Backends[i].MemberWeight = types.Int32Value(live[i][0])
+		}
+	}
+
+	// Weights changed outside of Terraform, or members that joined a backend since the last
+	// apply, show up as a change of percentages so that the next apply restores the split.
+	expected, err := trafficSplitMemberWeights(trafficSplitPercentages(state.Backends), counts)
+	if err != nil || !trafficSplitWeightsMatch(expected, live) {
+		for i, p := range trafficSplitLivePercentages(live) {
+			state.Backends[i].Percentage = types.Int64Value(int64(math.Round(p)))
+		}
+	}
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *loadBalancerTrafficSplitResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state loadBalancerTrafficSplitResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	timeout, diags := plan.Timeouts.Update(ctx, common.DefaultUpdateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	var from []float64
+	if sameTrafficSplitBackends(state.Backends, plan.Backends) {
+		from = trafficSplitPercentages(state.Backends)
+	}
+
+	if applied, _ := r.shiftTraffic(ctx, &plan, from, &resp.Diagnostics); !applied {
+		return
+	}
+
+	diags = resp.State.Set(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Delete only forgets the split. Member weights are left as they are so that removing the
+// resource never moves traffic.
+func (r *loadBalancerTrafficSplitResource) Delete(ctx context.Context, _ resource.DeleteRequest, _ *resource.DeleteResponse) {
+	tflog.Info(ctx, "removing traffic split from state, member weights are left unchanged")
+}
+
+func (r *loadBalancerTrafficSplitResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config loadBalancerTrafficSplitResourceModel
+	diags := req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	total := int64(0)
+	totalKnown := true
+	restBackend := false
+	claimed := make(map[string]struct{})
+
+	for _, backend := range config.Backends {
+		if backend.Percentage.IsUnknown() {
+			totalKnown = false
+		} else {
+			total += backend.Percentage.ValueInt64()
+		}
+
+		if backend.Addresses == nil {
+			if restBackend {
+				common.AddValidationConfigError(ctx, r, &resp.Diagnostics,
+					"only one backend may omit addresses.",
+				)
+				return
+			}
+			restBackend = true
+			continue
+		}
+
+		for _, address := range backend.Addresses {
+			if address.IsUnknown() {
+				continue
+			}
+			if _, exists := claimed[address.ValueString()]; exists {
+				common.AddValidationConfigError(ctx, r, &resp.Diagnostics,
+					fmt.Sprintf("address '%s' is assigned to more than one backend.", address.ValueString()),
+				)
+				return
+			}
+			claimed[address.ValueString()] = struct{}{}
+		}
+	}
+
+	if totalKnown && total != 100 {
+		common.AddValidationConfigError(ctx, r, &resp.Diagnostics,
+			fmt.Sprintf("backend percentages must add up to 100, got %d.", total),
+		)
+	}
+}
+
+// shiftTraffic moves the backends from the `from` percentages to the planned ones, one step at a
+// time. Each step updates the member weights under the load balancer lock, then optionally waits
+// for the weighted members to report ONLINE and pauses before the next step. If a later step
+// fails, plan is left at the last applied step so that it can be saved and resumed.
+func (r *loadBalancerTrafficSplitResource) shiftTraffic(
+	ctx context.Context,
+	plan *loadBalancerTrafficSplitResourceModel,
+	from []float64,
+	respDiags *diag.Diagnostics,
+) (applied bool, ok bool) {
+	loadBalancerId, ok := r.getLoadBalancerId(ctx, plan.TargetGroupId.ValueString(), respDiags)
+	if !ok || respDiags.HasError() {
+		return false, false
+	}
+	plan.LoadBalancerId = types.StringValue(loadBalancerId)
+
+	stepPercentage := 0.0
+	if !plan.StepPercentage.IsNull() {
+		stepPercentage = float64(plan.StepPercentage.ValueInt64())
+	}
+	stepInterval, _ := time.ParseDuration(plan.StepInterval.ValueString())
+	healthCheckTimeout, _ := time.ParseDuration(plan.HealthCheckTimeout.ValueString())
+
+	steps := trafficSplitSteps(from, trafficSplitPercentages(plan.Backends), stepPercentage)
+	for i, step := range steps {
+		tflog.Info(ctx, "shifting load balancer traffic", map[string]any{
+			"load_balancer_id": loadBalancerId,
+			"step":             i + 1,
+			"steps":            len(steps),
+			"percentages":      step,
+		})
+
+		tg, ok := r.applyTrafficSplitStep(ctx, plan, loadBalancerId, step, respDiags)
+		if !ok || respDiags.HasError() {
+			return applied, false
+		}
+		applied = true
+		for j := range plan.Backends {
+			plan.Backends[j].Percentage = types.Int64Value(int64(math.Round(step[j])))
+		}
+
+		if plan.HealthCheck.ValueBool() && !r.waitForTrafficSplitHealthy(ctx, tg, healthCheckTimeout, respDiags) {
+			return applied, false
+		}
+
+		if i < len(steps)-1 {
+			select {
+			case <-ctx.Done():
+				common.AddGeneralError(ctx, r, respDiags,
+					fmt.Sprintf("Timed out while shifting traffic at %v.", step))
+				return applied, false
+			case <-time.After(stepInterval):
+			}
+		}
+	}
+	return applied, true
+}
+
+func (r *loadBalancerTrafficSplitResource) applyTrafficSplitStep(
+	ctx context.Context,
+	plan *loadBalancerTrafficSplitResourceModel,
+	loadBalancerId string,
+	percentages []float64,
+	respDiags *diag.Diagnostics,
+) (*trafficSplitTargetGroup, bool) {
+	mutex := common.LockForID(loadBalancerId)
+	mutex.Lock()
+	defer mutex.Unlock()
+
+	ok := CheckLoadBalancerStatus(ctx, loadBalancerId, true, r, r.kc, respDiags)
+	if !ok || respDiags.HasError() {
+		return nil, false
+	}
+
+	tg, httpResp, err := r.listTrafficSplitTargetGroup(ctx, plan.TargetGroupId.ValueString(), plan.Backends, respDiags)
+	if err != nil {
+		common.AddApiActionError(ctx, r, httpResp, "ListTargetsInTargetGroup", err, respDiags)
+		return nil, false
+	}
+
+	counts := trafficSplitMemberCounts(tg, len(plan.Backends))
+	weights, err := trafficSplitMemberWeights(percentages, counts)
+	if err != nil {
+		common.AddGeneralError(ctx, r, respDiags, err.Error())
+		return nil, false
+	}
+
+	membersReq := make([]loadbalancer.BnsLoadBalancerV1ApiUpdateTargetsModelEditTargetGroupMember, 0, len(tg.members))
+	for i, member := range tg.members {
+		memberReq := loadbalancer.NewBnsLoadBalancerV1ApiUpdateTargetsModelEditTargetGroupMember(
+			utils.ConvertNullableString(member.IpAddress).ValueString(),
+			utils.ConvertNullableInt32(member.ProtocolPort).ValueInt32(),
+			member.Subnet.Id,
+		)
+		if name := member.Name.Get(); name != nil {
+			memberReq.SetName(*name)
+		}
+		if monitorPort := member.MonitorPort.Get(); monitorPort != nil {
+			memberReq.SetMonitorPort(*monitorPort)
+		}
+		if backend, ok := tg.backends[utils.ConvertNullableString(member.IpAddress).ValueString()]; ok {
+			memberReq.SetWeight(weights[backend])
+			tg.members[i].Weight.Set(&weights[backend])
+		} else if weight := member.Weight.Get(); weight != nil {
+			memberReq.SetWeight(*weight)
+		}
+		membersReq = append(membersReq, *memberReq)
+	}
+
+	_, httpResp, err = common.ExecuteWithRetryAndAuth(ctx, r.kc, respDiags, common.ServiceLoadBalancer,
+		func() (interface{}, *http.Response, error) {
+			return r.kc.ApiClient.LoadBalancerTargetGroupAPI.
+				UpdateTargets(ctx, tg.id).
+				XAuthToken(r.kc.XAuthToken).
+				BodyUpdateTargets(*loadbalancer.NewBodyUpdateTargets(membersReq)).
+				Execute()
+		},
+	)
+	if err != nil {
+		common.AddApiActionError(ctx, r, httpResp, "UpdateTargets", err, respDiags)
+		return nil, false
+	}
+
+	result, ok := common.PollUntilResult(
+		ctx,
+		r,
+		2*time.Second,
+		"target group members",
+		tg.id,
+		[]string{common.LoadBalancerProvisioningStatusActive, common.LoadBalancerProvisioningStatusError},
+		respDiags,
+		func(ctx context.Context) (*loadbalancer.TargetGroupMemberListModel, *http.Response, error) {
+			return listAllTargetGroupMembers(ctx, r.kc, r, tg.id, respDiags)
+		},
+		func(list *loadbalancer.TargetGroupMemberListModel) string {
+			status := common.LoadBalancerProvisioningStatusActive
+			for _, member := range list.Members {
+				switch s := utils.ConvertNullableString(member.ProvisioningStatus).ValueString(); s {
+				case common.LoadBalancerProvisioningStatusActive:
+				case common.LoadBalancerProvisioningStatusError:
+					status = s
+				default:
+					return s
+				}
+			}
+			return status
+		},
+	)
+	if !ok || respDiags.HasError() {
+		return nil, false
+	}
+	for _, member := range result.Members {
+		common.CheckResourceAvailableStatus(ctx, r, (*string)(member.ProvisioningStatus.Get()), []string{common.LoadBalancerProvisioningStatusActive}, respDiags)
+		if respDiags.HasError() {
+			return nil, false
+		}
+	}
+
+	for i := range plan.Backends {
+		plan.Backends[i].MemberCount = types.Int64Value(int64(counts[i]))
+		plan.Backends[i].MemberWeight = types.Int32Value(weights[i])
+	}
+	return tg, true
+}
+
+func (r *loadBalancerTrafficSplitResource) waitForTrafficSplitHealthy(
+	ctx context.Context,
+	tg *trafficSplitTargetGroup,
+	timeout time.Duration,
+	respDiags *diag.Diagnostics,
+) bool {
+	weighted := make(map[string]struct{})
+	for _, member := range tg.members {
+		address := utils.ConvertNullableString(member.IpAddress).ValueString()
+		if _, ok := tg.backends[address]; !ok {
+			continue
+		}
+		if weight := member.Weight.Get(); weight != nil && *weight > 0 {
+			weighted[address] = struct{}{}
+		}
+	}
+	if len(weighted) == 0 {
+		return true
+	}
+
+	_, ok := common.PollUntilResultWithTimeout(
+		ctx,
+		r,
+		5*time.Second,
+		&timeout,
+		"target group members",
+		tg.id,
+		[]string{common.LoadBalancerOperatingStatusOnline},
+		respDiags,
+		func(ctx context.Context) (*loadbalancer.TargetGroupMemberListModel, *http.Response, error) {
+			return listAllTargetGroupMembers(ctx, r.kc, r, tg.id, respDiags)
+		},
+		func(list *loadbalancer.TargetGroupMemberListModel) string {
+			for _, member := range list.Members {
+				if _, ok := weighted[utils.ConvertNullableString(member.IpAddress).ValueString()]; !ok {
+					continue
+				}
+				if string(member.OperatingStatus) != common.LoadBalancerOperatingStatusOnline {
+					return string(member.OperatingStatus)
+				}
+			}
+			return common.LoadBalancerOperatingStatusOnline
+		},
+	)
+	if !ok {
+		common.AddGeneralError(ctx, r, respDiags,
+			fmt.Sprintf("Weighted members of target group %s did not become %s within %s. Traffic was left at the last applied step.",
+				tg.id, common.LoadBalancerOperatingStatusOnline, timeout))
+		return false
+	}
+	return true
+}
+
+func (r *loadBalancerTrafficSplitResource) getLoadBalancerId(
+	ctx context.Context,
+	targetGroupId string,
+	respDiags *diag.Diagnostics,
+) (string, bool) {
+	respModel, httpResp, err := common.ExecuteWithRetryAndAuth(ctx, r.kc, respDiags, common.ServiceLoadBalancer,
+		func() (*loadbalancer.TargetGroupResponseModel, *http.Response, error) {
+			return r.kc.ApiClient.LoadBalancerTargetGroupAPI.GetTargetGroup(ctx, targetGroupId).
+				XAuthToken(r.kc.XAuthToken).Execute()
+		},
+	)
+	if err != nil {
+		common.AddApiActionError(ctx, r, httpResp, "GetTargetGroup", err, respDiags)
+		return "", false
+	}
+
+	loadBalancerId := utils.ConvertNullableString(respModel.TargetGroup.LoadBalancerId).ValueString()
+	if loadBalancerId == "" {
+		common.AddGeneralError(ctx, r, respDiags,
+			fmt.Sprintf("Target group %s is not attached to a load balancer.", targetGroupId))
+		return "", false
+	}
+	return loadBalancerId, true
+}
+
+// listTrafficSplitTargetGroup lists the members of the target group. Backends with addresses
+// claim those members first, and a backend without addresses takes the rest of the target group.
+func (r *loadBalancerTrafficSplitResource) listTrafficSplitTargetGroup(
+	ctx context.Context,
+	targetGroupId string,
+	backends []loadBalancerTrafficSplitBackendModel,
+	respDiags *diag.Diagnostics,
+) (*trafficSplitTargetGroup, *http.Response, error) {
+	respModel, httpResp, err := listAllTargetGroupMembers(ctx, r.kc, r, targetGroupId, respDiags)
+	if err != nil {
+		return nil, httpResp, err
+	}
+
+	tg := &trafficSplitTargetGroup{
+		id:       targetGroupId,
+		members:  respModel.Members,
+		backends: make(map[string]int),
+	}
+	for i, backend := range backends {
+		for _, address := range backend.Addresses {
+			tg.backends[address.ValueString()] = i
+		}
+	}
+	for i, backend := range backends {
+		if backend.Addresses != nil {
+			continue
+		}
+		for _, member := range tg.members {
+			address := utils.ConvertNullableString(member.IpAddress).ValueString()
+			if _, ok := tg.backends[address]; !ok {
+				tg.backends[address] = i
+			}
+		}
+	}
+
+	return tg, nil, nil
+}
+
+func trafficSplitMemberCounts(tg *trafficSplitTargetGroup, backendCount int) []int {
+	counts := make([]int, backendCount)
+	for _, member := range tg.members {
+		if i, ok := tg.backends[utils.ConvertNullableString(member.IpAddress).ValueString()]; ok {
+			counts[i]++
+		}
+	}
+	return counts
+}
+
+// trafficSplitLiveWeights returns the current weight of every member of each backend.
+func trafficSplitLiveWeights(tg *trafficSplitTargetGroup, backendCount int) [][]int32 {
+	weights := make([][]int32, backendCount)
+	for _, member := range tg.members {
+		if i, ok := tg.backends[utils.ConvertNullableString(member.IpAddress).ValueString()]; ok {
+			weights[i] = append(weights[i], utils.ConvertNullableInt32(member.Weight).ValueInt32())
+		}
+	}
+	return weights
+}
+
+func trafficSplitPercentages(backends []loadBalancerTrafficSplitBackendModel) []float64 {
+	percentages := make([]float64, len(backends))
+	for i, backend := range backends {
+		percentages[i] = float64(backend.Percentage.ValueInt64())
+	}
+	return percentages
+}
+
+// sameTrafficSplitBackends reports whether both lists select the same members, so that a change
+// between them only moves percentages and can be stepped through.
+func sameTrafficSplitBackends(a, b []loadBalancerTrafficSplitBackendModel) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if len(a[i].Addresses) != len(b[i].Addresses) {
+			return false
+		}
+		addresses := make(map[string]struct{}, len(a[i].Addresses))
+		for _, address := range a[i].Addresses {
+			addresses[address.ValueString()] = struct{}{}
+		}
+		for _, address := range b[i].Addresses {
+			if _, ok := addresses[address.ValueString()]; !ok {
+				return false
+			}
+		}
+	}
+	return true
+}
@@ -0,0 +1,93 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+package loadbalancer
+
+import (
+	"terraform-provider-kakaocloud/internal/common"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	rschema "github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var loadBalancerTrafficSplitResourceSchema = map[string]rschema.Attribute{
+	"id": rschema.StringAttribute{
+		Computed: true,
+		PlanModifiers: []planmodifier.String{
+			stringplanmodifier.UseStateForUnknown(),
+		},
+	},
+	"load_balancer_id": rschema.StringAttribute{
+		Computed: true,
+		PlanModifiers: []planmodifier.String{
+			stringplanmodifier.UseStateForUnknown(),
+		},
+	},
+	"target_group_id": rschema.StringAttribute{
+		Required:   true,
+		Validators: common.UuidValidator(),
+		PlanModifiers: []planmodifier.String{
+			stringplanmodifier.RequiresReplace(),
+		},
+	},
+	"backends": rschema.ListNestedAttribute{
+		Required: true,
+		Validators: []validator.List{
+			listvalidator.SizeAtLeast(2),
+		},
+		NestedObject: rschema.NestedAttributeObject{
+			Attributes: map[string]rschema.Attribute{
+				"addresses": rschema.SetAttribute{
+					Optional:    true,
+					ElementType: types.StringType,
+					Validators: []validator.Set{
+						setvalidator.SizeAtLeast(1),
+						setvalidator.ValueStringsAre(ipv4Validator),
+					},
+				},
+				"percentage": rschema.Int64Attribute{
+					Required: true,
+					Validators: []validator.Int64{
+						int64validator.Between(0, 100),
+					},
+				},
+				"member_count": rschema.Int64Attribute{
+					Computed: true,
+				},
+				"member_weight": rschema.Int32Attribute{
+					Computed: true,
+				},
+			},
+		},
+	},
+	"step_percentage": rschema.Int64Attribute{
+		Optional: true,
+		Validators: []validator.Int64{
+			int64validator.Between(1, 100),
+		},
+	},
+	"step_interval": rschema.StringAttribute{
+		Optional:   true,
+		Computed:   true,
+		Default:    stringdefault.StaticString("30s"),
+		Validators: common.DurationValidator(),
+	},
+	"health_check": rschema.BoolAttribute{
+		Optional: true,
+		Computed: true,
+		Default:  booldefault.StaticBool(true),
+	},
+	"health_check_timeout": rschema.StringAttribute{
+		Optional:   true,
+		Computed:   true,
+		Default:    stringdefault.StaticString("5m"),
+		Validators: common.DurationValidator(),
+	},
+}
@@ -0,0 +1,111 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+package loadbalancer
+
+import (
+	"fmt"
+	"math"
+)
+
+// trafficSplitMaxWeight is the largest member weight accepted by the target group API.
+const trafficSplitMaxWeight = 256
+
+// trafficSplitMemberWeights returns the weight to give every member of each backend so that
+// backend i receives percentages[i] of the traffic shared between all of them. Weights are
+// scaled so that the busiest member gets trafficSplitMaxWeight, which keeps rounding error low.
+func trafficSplitMemberWeights(percentages []float64, memberCounts []int) ([]int32, error) {
+	if len(percentages) != len(memberCounts) {
+		return nil, fmt.Errorf("got %d percentages for %d backends", len(percentages), len(memberCounts))
+	}
+
+	shares := make([]float64, len(percentages))
+	maxShare := 0.0
+	for i, p := range percentages {
+		if p <= 0 {
+			continue
+		}
+		if memberCounts[i] == 0 {
+			return nil, fmt.Errorf("backend %d has no members to receive %g%% of the traffic", i, p)
+		}
+		shares[i] = p / float64(memberCounts[i])
+		maxShare = math.Max(maxShare, shares[i])
+	}
+
+	weights := make([]int32, len(percentages))
+	if maxShare == 0 {
+		return weights, nil
+	}
+	for i, share := range shares {
+		if share == 0 {
+			continue
+		}
+		w := int32(math.Round(share / maxShare * trafficSplitMaxWeight))
+		if w < 1 {
+			w = 1
+		}
+		weights[i] = w
+	}
+	return weights, nil
+}
+
+// trafficSplitSteps returns the percentages to apply one after another to move from `from` to
+// `to`, changing no backend by more than stepPercentage at a time. The last step is always `to`.
+func trafficSplitSteps(from, to []float64, stepPercentage float64) [][]float64 {
+	if stepPercentage <= 0 || len(from) != len(to) {
+		return [][]float64{to}
+	}
+
+	maxDelta := 0.0
+	for i := range to {
+		maxDelta = math.Max(maxDelta, math.Abs(to[i]-from[i]))
+	}
+	count := int(math.Ceil(maxDelta / stepPercentage))
+	if count <= 1 {
+		return [][]float64{to}
+	}
+
+	steps := make([][]float64, 0, count)
+	for k := 1; k < count; k++ {
+		step := make([]float64, len(to))
+		for i := range to {
+			step[i] = from[i] + (to[i]-from[i])*float64(k)/float64(count)
+		}
+		steps = append(steps, step)
+	}
+	return append(steps, to)
+}
+
+// trafficSplitLivePercentages returns the share of the traffic that each backend receives, where
+// weights[i] holds the current weight of every member of backend i.
+func trafficSplitLivePercentages(weights [][]int32) []float64 {
+	percentages := make([]float64, len(weights))
+	total := 0.0
+	for i, members := range weights {
+		for _, w := range members {
+			percentages[i] += float64(w)
+		}
+		total += percentages[i]
+	}
+	if total == 0 {
+		return percentages
+	}
+	for i := range percentages {
+		percentages[i] = percentages[i] / total * 100
+	}
+	return percentages
+}
+
+// trafficSplitWeightsMatch reports whether every member of backend i has the weight expected[i].
+func trafficSplitWeightsMatch(expected []int32, weights [][]int32) bool {
+	if len(expected) != len(weights) {
+		return false
+	}
+	for i, members := range weights {
+		for _, w := range members {
+			if w != expected[i] {
+				return false
+			}
+		}
+	}
+	return true
+}
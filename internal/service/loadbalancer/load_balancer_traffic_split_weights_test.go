@@ -0,0 +1,110 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+package loadbalancer
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestTrafficSplitMemberWeights(t *testing.T) {
+	cases := []struct {
+		name        string
+		percentages []float64
+		counts      []int
+		want        []int32
+	}{
+		{"all blue", []float64{100, 0}, []int{3, 3}, []int32{256, 0}},
+		{"even split", []float64{50, 50}, []int{2, 2}, []int32{256, 256}},
+		{"uneven member counts", []float64{50, 50}, []int{1, 4}, []int32{256, 64}},
+		{"ninety ten", []float64{90, 10}, []int{2, 2}, []int32{256, 28}},
+		{"tiny share keeps a weight", []float64{99.9, 0.1}, []int{1, 10}, []int32{256, 1}},
+		{"empty backend at zero", []float64{100, 0}, []int{2, 0}, []int32{256, 0}},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			got, err := trafficSplitMemberWeights(tc.percentages, tc.counts)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if !reflect.DeepEqual(got, tc.want) {
+				t.Fatalf("got %v, want %v", got, tc.want)
+			}
+		})
+	}
+
+	if _, err := trafficSplitMemberWeights([]float64{50, 50}, []int{2, 0}); err == nil {
+		t.Fatal("expected an error for a weighted backend without members")
+	}
+}
+
+func TestTrafficSplitSteps(t *testing.T) {
+	cases := []struct {
+		name string
+		from []float64
+		to   []float64
+		step float64
+		want [][]float64
+	}{
+		{"no stepping", []float64{100, 0}, []float64{0, 100}, 0, [][]float64{{0, 100}}},
+		{"single step", []float64{100, 0}, []float64{90, 10}, 25, [][]float64{{90, 10}}},
+		{"quarters", []float64{100, 0}, []float64{0, 100}, 25, [][]float64{{75, 25}, {50, 50}, {25, 75}, {0, 100}}},
+		{"uneven delta", []float64{100, 0}, []float64{70, 30}, 20, [][]float64{{85, 15}, {70, 30}}},
+		{"backends changed", []float64{100, 0}, []float64{50, 25, 25}, 10, [][]float64{{50, 25, 25}}},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			got := trafficSplitSteps(tc.from, tc.to, tc.step)
+			if !reflect.DeepEqual(got, tc.want) {
+				t.Fatalf("got %v, want %v", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestTrafficSplitLivePercentages(t *testing.T) {
+	cases := []struct {
+		name    string
+		weights [][]int32
+		want    []float64
+	}{
+		{"even", [][]int32{{256, 256}, {256, 256}}, []float64{50, 50}},
+		{"uneven member counts", [][]int32{{256}, {64, 64, 64, 64}}, []float64{50, 50}},
+		{"all blue", [][]int32{{256, 256}, {0, 0}}, []float64{100, 0}},
+		{"no weight", [][]int32{{0}, {0}}, []float64{0, 0}},
+		{"no members", [][]int32{{100}, nil}, []float64{100, 0}},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			got := trafficSplitLivePercentages(tc.weights)
+			if !reflect.DeepEqual(got, tc.want) {
+				t.Fatalf("got %v, want %v", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestTrafficSplitWeightsMatch(t *testing.T) {
+	cases := []struct {
+		name     string
+		expected []int32
+		weights  [][]int32
+		want     bool
+	}{
+		{"match", []int32{256, 28}, [][]int32{{256, 256}, {28, 28}}, true},
+		{"changed weight", []int32{256, 28}, [][]int32{{256, 256}, {28, 100}}, false},
+		{"empty backend", []int32{256, 0}, [][]int32{{256}, nil}, true},
+		{"backend count", []int32{256}, [][]int32{{256}, {256}}, false},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := trafficSplitWeightsMatch(tc.expected, tc.weights); got != tc.want {
+				t.Fatalf("got %v, want %v", got, tc.want)
+			}
+		})
+	}
+}
@@ -12,6 +12,7 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/jinzhu/copier"
 	"github.com/kakaoenterprise/kc-sdk-go/services/loadbalancer"
 	"golang.org/x/net/context"
 )
@@ -140,7 +141,7 @@ func CheckLoadBalancerStatus(
 		[]string{common.LoadBalancerProvisioningStatusActive, common.LoadBalancerProvisioningStatusError, common.LoadBalancerProvisioningStatusDeleting},
 		diags,
 		func(ctx context.Context) (*loadbalancer.BnsLoadBalancerV1ApiGetLoadBalancerModelLoadBalancerModel, *http.Response, error) {
-			respModel, httpResp, err := common.ExecuteWithRetryAndAuth(ctx, kc, diags,
+			respModel, httpResp, err := common.ExecuteWithRetryAndAuth(ctx, kc, diags, common.ServiceLoadBalancer,
 				func() (*loadbalancer.BnsLoadBalancerV1ApiGetLoadBalancerModelResponseLoadBalancerModel, *http.Response, error) {
 					return kc.ApiClient.LoadBalancerAPI.
 						GetLoadBalancer(ctx, loadBalancerId).
@@ -177,3 +178,68 @@ func CheckLoadBalancerStatus(
 	}
 	return true
 }
+
+func listAllTargetGroupMembers(
+	ctx context.Context,
+	kc *common.KakaoCloudClient,
+	obj interface{},
+	targetGroupId string,
+	diags *diag.Diagnostics,
+) (*loadbalancer.TargetGroupMemberListModel, *http.Response, error) {
+	pages, httpResp, err := common.ListAllPages(ctx, kc, obj, diags, common.ServiceLoadBalancer,
+		func(limit int32, offset int32) (*loadbalancer.TargetGroupMemberListModel, *http.Response, error) {
+			return kc.ApiClient.LoadBalancerTargetGroupAPI.
+				ListTargetsInTargetGroup(ctx, targetGroupId).
+				XAuthToken(kc.XAuthToken).
+				Limit(limit).
+				Offset(offset).
+				Execute()
+		},
+		func(page *loadbalancer.TargetGroupMemberListModel) int {
+			return len(page.Members)
+		},
+	)
+	if err != nil {
+		return nil, httpResp, err
+	}
+
+	result := &loadbalancer.TargetGroupMemberListModel{}
+	for _, page := range pages {
+		result.Members = append(result.Members, page.Members...)
+	}
+	return result, httpResp, nil
+}
+
+func listAllTlsCertificates(
+	ctx context.Context,
+	kc *common.KakaoCloudClient,
+	obj interface{},
+	diags *diag.Diagnostics,
+) ([]loadbalancer.BnsLoadBalancerV1ApiListTlsCertificatesModelSecretModel, *http.Response, error) {
+	pages, httpResp, err := common.ListAllPages(ctx, kc, obj, diags, common.ServiceLoadBalancer,
+		func(limit int32, offset int32) (*loadbalancer.SecretListModel, *http.Response, error) {
+			return kc.ApiClient.LoadBalancerEtcAPI.
+				ListTlsCertificates(ctx).
+				XAuthToken(kc.XAuthToken).
+				Limit(limit).
+				Offset(offset).
+				Execute()
+		},
+		func(page *loadbalancer.SecretListModel) int {
+			return len(page.Secrets)
+		},
+	)
+	if err != nil {
+		return nil, httpResp, err
+	}
+
+	var result []loadbalancer.BnsLoadBalancerV1ApiListTlsCertificatesModelSecretModel
+	for _, page := range pages {
+		var pageResult []loadbalancer.BnsLoadBalancerV1ApiListTlsCertificatesModelSecretModel
+		if err := copier.Copy(&pageResult, &page.Secrets); err != nil {
+			return nil, httpResp, fmt.Errorf("failed to convert TLS certificates: %w", err)
+		}
+		result = append(result, pageResult...)
+	}
+	return result, httpResp, nil
+}
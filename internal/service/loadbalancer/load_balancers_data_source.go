@@ -186,10 +186,12 @@ func (d *loadBalancersDataSource) Read(ctx context.Context, req datasource.ReadR
 	if resp.Diagnostics.HasError() {
 		return
 	}
-	lbs, httpResp, err := common.ExecuteWithRetryAndAuth(ctx, d.kc, &resp.Diagnostics,
-		func() (interface{}, *http.Response, error) {
-			apiResp, httpResp, err := lbApi.Limit(1000).XAuthToken(d.kc.XAuthToken).Execute()
-			return apiResp, httpResp, err
+	lbPages, httpResp, err := common.ListAllPagesPartial(ctx, d.kc, d, &resp.Diagnostics, common.ServiceLoadBalancer,
+		func(limit int32, offset int32) (*loadbalancer.LoadBalancerListModel, *http.Response, error) {
+			return lbApi.Limit(limit).Offset(offset).XAuthToken(d.kc.XAuthToken).Execute()
+		},
+		func(page *loadbalancer.LoadBalancerListModel) int {
+			return len(page.LoadBalancers)
 		},
 	)
 	if err != nil {
@@ -197,18 +199,16 @@ func (d *loadBalancersDataSource) Read(ctx context.Context, req datasource.ReadR
 		return
 	}
 
-	lbsTyped, ok := lbs.(*loadbalancer.LoadBalancerListModel)
-	if !ok {
-		resp.Diagnostics.AddError("Type assertion failed", "Failed to cast API response to expected type")
-		return
-	}
-
 	var loadBalancersResult []loadbalancer.BnsLoadBalancerV1ApiGetLoadBalancerModelLoadBalancerModel
-	err = copier.Copy(&loadBalancersResult, &lbsTyped.LoadBalancers)
-	if err != nil {
-		common.AddGeneralError(ctx, d, &resp.Diagnostics,
-			fmt.Sprintf("Failed to convert loadBalancersResult: %v", err))
-		return
+	for _, lbsTyped := range lbPages {
+		var pageResult []loadbalancer.BnsLoadBalancerV1ApiGetLoadBalancerModelLoadBalancerModel
+		err = copier.Copy(&pageResult, &lbsTyped.LoadBalancers)
+		if err != nil {
+			common.AddGeneralError(ctx, d, &resp.Diagnostics,
+				fmt.Sprintf("Failed to convert loadBalancersResult: %v", err))
+			return
+		}
+		loadBalancersResult = append(loadBalancersResult, pageResult...)
 	}
 
 	for _, lb := range loadBalancersResult {
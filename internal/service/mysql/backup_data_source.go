@@ -59,7 +59,7 @@ func (d *backupDataSource) Read(ctx context.Context, req datasource.ReadRequest,
 	ctx, cancel := context.WithTimeout(ctx, timeout)
 	defer cancel()
 
-	modelResp, httpResp, err := common.ExecuteWithRetryAndAuth(ctx, d.kc, &resp.Diagnostics,
+	modelResp, httpResp, err := common.ExecuteWithRetryAndAuth(ctx, d.kc, &resp.Diagnostics, common.ServiceMySQL,
 		func() (*mysqlsdk.GetMySQLBackupResponseModel, *http.Response, error) {
 			return d.kc.ApiClient.MySQLBackupsAPI.
 				GetMysqlBackup(ctx, data.Id.ValueString()).
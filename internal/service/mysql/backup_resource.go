@@ -174,7 +174,7 @@ func (r *backupResource) createBackup(
 		),
 	)
 
-	modelResp, httpResp, err := common.ExecuteWithRetryAndAuth(ctx, r.kc, respDiags,
+	modelResp, httpResp, err := common.ExecuteWithRetryAndAuth(ctx, r.kc, respDiags, common.ServiceMySQL,
 		func() (*mysqlsdk.CreateMySQLBackupResponseModel, *http.Response, error) {
 			return r.kc.ApiClient.MySQLBackupsAPI.
 				CreateMysqlBackup(ctx).
@@ -206,7 +206,7 @@ func (r *backupResource) readBackupState(
 	current backupResourceModel,
 	respDiags *diag.Diagnostics,
 ) (backupResourceModel, bool, bool) {
-	result, httpResp, err := common.ExecuteWithRetryAndAuth(ctx, r.kc, respDiags,
+	result, httpResp, err := common.ExecuteWithRetryAndAuth(ctx, r.kc, respDiags, common.ServiceMySQL,
 		func() (*mysqlsdk.GetMySQLBackupResponseModel, *http.Response, error) {
 			return r.kc.ApiClient.MySQLBackupsAPI.
 				GetMysqlBackup(ctx, current.Id.ValueString()).
@@ -286,7 +286,7 @@ func (r *backupResource) pollBackupUntilStable(
 }
 
 func (r *backupResource) deleteBackup(ctx context.Context, current backupResourceModel, respDiags *diag.Diagnostics) bool {
-	_, httpResp, err := common.ExecuteWithRetryAndAuth[struct{}](ctx, r.kc, respDiags,
+	_, httpResp, err := common.ExecuteWithRetryAndAuth[struct{}](ctx, r.kc, respDiags, common.ServiceMySQL,
 		func() (struct{}, *http.Response, error) {
 			httpResp, err := r.kc.ApiClient.MySQLBackupsAPI.
 				DeleteMysqlBackup(ctx, current.Id.ValueString()).
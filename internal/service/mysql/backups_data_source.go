@@ -65,7 +65,7 @@ func (d *backupsDataSource) Read(ctx context.Context, req datasource.ReadRequest
 	ctx, cancel := context.WithTimeout(ctx, timeout)
 	defer cancel()
 
-	modelResp, httpResp, err := common.ExecuteWithRetryAndAuth(ctx, d.kc, &resp.Diagnostics,
+	modelResp, httpResp, err := common.ExecuteWithRetryAndAuth(ctx, d.kc, &resp.Diagnostics, common.ServiceMySQL,
 		func() (*mysqlsdk.GetMySQLBackupsResponseModel, *http.Response, error) {
 			request := d.kc.ApiClient.MySQLBackupsAPI.
 				ListMysqlBackups(ctx).
@@ -53,7 +53,7 @@ func (d *customParameterGroupDataSource) Read(ctx context.Context, req datasourc
 	ctx, cancel := context.WithTimeout(ctx, timeout)
 	defer cancel()
 
-	modelResp, httpResp, err := common.ExecuteWithRetryAndAuth(ctx, d.kc, &resp.Diagnostics,
+	modelResp, httpResp, err := common.ExecuteWithRetryAndAuth(ctx, d.kc, &resp.Diagnostics, common.ServiceMySQL,
 		func() (*mysqlsdk.GetMySQLCustomParameterGroupResponseModel, *http.Response, error) {
 			return d.kc.ApiClient.MySQLCustomParameterGroupsAPI.
 				GetMysqlCustomParameterGroup(ctx, data.Id.ValueString()).
@@ -59,7 +59,7 @@ func (d *customParameterGroupEventsDataSource) Read(ctx context.Context, req dat
 	ctx, cancel := context.WithTimeout(ctx, timeout)
 	defer cancel()
 
-	modelResp, httpResp, err := common.ExecuteWithRetryAndAuth(ctx, d.kc, &resp.Diagnostics,
+	modelResp, httpResp, err := common.ExecuteWithRetryAndAuth(ctx, d.kc, &resp.Diagnostics, common.ServiceMySQL,
 		func() (*mysqlsdk.GetMySQLCustomParameterGroupEventsResponseModel, *http.Response, error) {
 			return d.kc.ApiClient.MySQLCustomParameterGroupsAPI.
 				ListMysqlCustomParameterGroupEvents(ctx, data.CustomParameterGroupId.ValueString()).
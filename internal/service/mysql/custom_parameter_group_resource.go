@@ -207,7 +207,7 @@ func (r *customParameterGroupResource) createCustomParameterGroup(
 	}
 
 	request := mysqlsdk.NewBodyCreateMysqlCustomParameterGroup(*group)
-	modelResp, httpResp, err := common.ExecuteWithRetryAndAuth(ctx, r.kc, respDiags,
+	modelResp, httpResp, err := common.ExecuteWithRetryAndAuth(ctx, r.kc, respDiags, common.ServiceMySQL,
 		func() (*mysqlsdk.CreateMySQLCustomParameterGroupResponseModel, *http.Response, error) {
 			return r.kc.ApiClient.MySQLCustomParameterGroupsAPI.
 				CreateMysqlCustomParameterGroup(ctx).
@@ -253,7 +253,7 @@ func (r *customParameterGroupResource) readCustomParameterGroupState(
 	current customParameterGroupResourceModel,
 	respDiags *diag.Diagnostics,
 ) (customParameterGroupResourceModel, bool, bool) {
-	modelResp, httpResp, err := common.ExecuteWithRetryAndAuth(ctx, r.kc, respDiags,
+	modelResp, httpResp, err := common.ExecuteWithRetryAndAuth(ctx, r.kc, respDiags, common.ServiceMySQL,
 		func() (*mysqlsdk.GetMySQLCustomParameterGroupResponseModel, *http.Response, error) {
 			return r.kc.ApiClient.MySQLCustomParameterGroupsAPI.
 				GetMysqlCustomParameterGroup(ctx, current.Id.ValueString()).
@@ -310,7 +310,7 @@ func (r *customParameterGroupResource) readCustomParameterGroupParameterMap(
 	id string,
 	respDiags *diag.Diagnostics,
 ) (map[string]mysqlParameterModel, bool) {
-	modelResp, httpResp, err := common.ExecuteWithRetryAndAuth(ctx, r.kc, respDiags,
+	modelResp, httpResp, err := common.ExecuteWithRetryAndAuth(ctx, r.kc, respDiags, common.ServiceMySQL,
 		func() (*mysqlsdk.GetMySQLCustomParameterGroupResponseModel, *http.Response, error) {
 			return r.kc.ApiClient.MySQLCustomParameterGroupsAPI.
 				GetMysqlCustomParameterGroup(ctx, id).
@@ -360,7 +360,7 @@ func (r *customParameterGroupResource) updateCustomParameterGroup(
 	}
 
 	request := mysqlsdk.NewBodyUpdateMysqlCustomParameterGroup(*group)
-	_, httpResp, err := common.ExecuteWithRetryAndAuth[struct{}](ctx, r.kc, respDiags,
+	_, httpResp, err := common.ExecuteWithRetryAndAuth[struct{}](ctx, r.kc, respDiags, common.ServiceMySQL,
 		func() (struct{}, *http.Response, error) {
 			httpResp, err := r.kc.ApiClient.MySQLCustomParameterGroupsAPI.
 				UpdateMysqlCustomParameterGroup(ctx, plan.Id.ValueString()).
@@ -442,7 +442,7 @@ func (r *customParameterGroupResource) hasConfiguredParameterOverrides(
 }
 
 func (r *customParameterGroupResource) deleteCustomParameterGroup(ctx context.Context, id string, respDiags *diag.Diagnostics) bool {
-	_, httpResp, err := common.ExecuteWithRetryAndAuth[struct{}](ctx, r.kc, respDiags,
+	_, httpResp, err := common.ExecuteWithRetryAndAuth[struct{}](ctx, r.kc, respDiags, common.ServiceMySQL,
 		func() (struct{}, *http.Response, error) {
 			httpResp, err := r.kc.ApiClient.MySQLCustomParameterGroupsAPI.
 				DeleteMysqlCustomParameterGroup(ctx, id).
@@ -467,7 +467,7 @@ func (r *customParameterGroupResource) pollCustomParameterGroupUntilDeleted(ctx
 	defer ticker.Stop()
 
 	for {
-		_, httpResp, err := common.ExecuteWithRetryAndAuth(ctx, r.kc, respDiags,
+		_, httpResp, err := common.ExecuteWithRetryAndAuth(ctx, r.kc, respDiags, common.ServiceMySQL,
 			func() (*mysqlsdk.GetMySQLCustomParameterGroupResponseModel, *http.Response, error) {
 				return r.kc.ApiClient.MySQLCustomParameterGroupsAPI.
 					GetMysqlCustomParameterGroup(ctx, id).
@@ -58,7 +58,7 @@ func (d *customParameterGroupsDataSource) Read(ctx context.Context, req datasour
 	ctx, cancel := context.WithTimeout(ctx, timeout)
 	defer cancel()
 
-	modelResp, httpResp, err := common.ExecuteWithRetryAndAuth(ctx, d.kc, &resp.Diagnostics,
+	modelResp, httpResp, err := common.ExecuteWithRetryAndAuth(ctx, d.kc, &resp.Diagnostics, common.ServiceMySQL,
 		func() (*mysqlsdk.GetMySQLCustomParameterGroupsResponseModel, *http.Response, error) {
 			request := d.kc.ApiClient.MySQLCustomParameterGroupsAPI.
 				ListMysqlCustomParameterGroups(ctx).
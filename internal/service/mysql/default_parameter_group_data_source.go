@@ -53,7 +53,7 @@ func (d *defaultParameterGroupDataSource) Read(ctx context.Context, req datasour
 	ctx, cancel := context.WithTimeout(ctx, timeout)
 	defer cancel()
 
-	modelResp, httpResp, err := common.ExecuteWithRetryAndAuth(ctx, d.kc, &resp.Diagnostics,
+	modelResp, httpResp, err := common.ExecuteWithRetryAndAuth(ctx, d.kc, &resp.Diagnostics, common.ServiceMySQL,
 		func() (*mysqlsdk.GetMySQLDefaultParameterGroupResponseModel, *http.Response, error) {
 			return d.kc.ApiClient.MySQLDefaultParameterGroupsAPI.
 				GetMysqlDefaultParameterGroup(ctx, data.Id.ValueString()).
@@ -59,7 +59,7 @@ func (d *defaultParameterGroupEventsDataSource) Read(ctx context.Context, req da
 	ctx, cancel := context.WithTimeout(ctx, timeout)
 	defer cancel()
 
-	modelResp, httpResp, err := common.ExecuteWithRetryAndAuth(ctx, d.kc, &resp.Diagnostics,
+	modelResp, httpResp, err := common.ExecuteWithRetryAndAuth(ctx, d.kc, &resp.Diagnostics, common.ServiceMySQL,
 		func() (*mysqlsdk.GetMySQLDefaultParameterGroupEventsResponseModel, *http.Response, error) {
 			return d.kc.ApiClient.MySQLDefaultParameterGroupsAPI.
 				ListMysqlDefaultParameterGroupEvents(ctx, data.DefaultParameterGroupId.ValueString()).
@@ -58,7 +58,7 @@ func (d *defaultParameterGroupsDataSource) Read(ctx context.Context, req datasou
 	ctx, cancel := context.WithTimeout(ctx, timeout)
 	defer cancel()
 
-	modelResp, httpResp, err := common.ExecuteWithRetryAndAuth(ctx, d.kc, &resp.Diagnostics,
+	modelResp, httpResp, err := common.ExecuteWithRetryAndAuth(ctx, d.kc, &resp.Diagnostics, common.ServiceMySQL,
 		func() (*mysqlsdk.GetMySQLDefaultParameterGroupsResponseModel, *http.Response, error) {
 			request := d.kc.ApiClient.MySQLDefaultParameterGroupsAPI.
 				ListMysqlDefaultParameterGroups(ctx).
@@ -62,7 +62,7 @@ func (d *engineVersionsDataSource) Read(ctx context.Context, req datasource.Read
 	ctx, cancel := context.WithTimeout(ctx, timeout)
 	defer cancel()
 
-	modelResp, httpResp, err := common.ExecuteWithRetryAndAuth(ctx, d.kc, &resp.Diagnostics,
+	modelResp, httpResp, err := common.ExecuteWithRetryAndAuth(ctx, d.kc, &resp.Diagnostics, common.ServiceMySQL,
 		func() (*mysqlsdk.GetMySQLEngineVersionsResponseModel, *http.Response, error) {
 			return d.kc.ApiClient.MySQLEngineVersionsAPI.
 				ListAvailableMysqlEngineVersions(ctx).
@@ -67,7 +67,7 @@ func (d *flavorsDataSource) Read(ctx context.Context, req datasource.ReadRequest
 	ctx, cancel := context.WithTimeout(ctx, timeout)
 	defer cancel()
 
-	modelResp, httpResp, err := common.ExecuteWithRetryAndAuth(ctx, d.kc, &resp.Diagnostics,
+	modelResp, httpResp, err := common.ExecuteWithRetryAndAuth(ctx, d.kc, &resp.Diagnostics, common.ServiceMySQL,
 		func() (*mysqlsdk.GetMySQLFlavorsResponseModel, *http.Response, error) {
 			req := d.kc.ApiClient.MySQLFlavorsAPI.
 				ListMysqlInstanceTypesFlavors(ctx).
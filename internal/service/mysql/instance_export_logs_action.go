@@ -145,7 +145,7 @@ func (a *instanceExportLogsAction) Invoke(ctx context.Context, req action.Invoke
 	)
 	request := mysqlsdk.NewBodyExportMysqlInstanceLogs(*instance)
 
-	_, httpResp, err := common.ExecuteWithRetryAndAuth[struct{}](ctx, a.kc, &resp.Diagnostics,
+	_, httpResp, err := common.ExecuteWithRetryAndAuth[struct{}](ctx, a.kc, &resp.Diagnostics, common.ServiceMySQL,
 		func() (struct{}, *http.Response, error) {
 			httpResp, err := a.kc.ApiClient.MySQLInstanceGroupsInstancesAPI.
 				ExportMysqlInstanceLogs(ctx, config.InstanceGroupId.ValueString(), config.InstanceId.ValueString()).
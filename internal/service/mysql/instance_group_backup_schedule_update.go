@@ -29,7 +29,7 @@ func (r *instanceGroupResource) applyBackupSchedule(
 
 	request := mysqlsdk.NewBodyUpdateMysqlInstanceGroupBackupSchedule(*backupSchedule)
 
-	_, httpResp, err := common.ExecuteWithRetryAndAuth[struct{}](ctx, r.kc, respDiags,
+	_, httpResp, err := common.ExecuteWithRetryAndAuth[struct{}](ctx, r.kc, respDiags, common.ServiceMySQL,
 		func() (struct{}, *http.Response, error) {
 			httpResp, err := r.kc.ApiClient.MySQLInstanceGroupsBackupSchedulesAPI.
 				UpdateMysqlInstanceGroupBackupSchedule(ctx, plan.InstanceGroupId.ValueString(), plan.BackupScheduleId.ValueString()).
@@ -60,7 +60,7 @@ func (d *instanceGroupDataSource) Read(ctx context.Context, req datasource.ReadR
 	ctx, cancel := context.WithTimeout(ctx, timeout)
 	defer cancel()
 
-	modelResp, httpResp, err := common.ExecuteWithRetryAndAuth(ctx, d.kc, &resp.Diagnostics,
+	modelResp, httpResp, err := common.ExecuteWithRetryAndAuth(ctx, d.kc, &resp.Diagnostics, common.ServiceMySQL,
 		func() (*mysqlsdk.GetMySQLInstanceGroupResponseModel, *http.Response, error) {
 			return d.kc.ApiClient.MySQLInstanceGroupsAPI.
 				GetMysqlInstanceGroup(ctx, data.Id.ValueString()).
@@ -26,7 +26,7 @@ func (r *instanceGroupResource) extendVolume(
 		),
 	)
 
-	_, httpResp, err := common.ExecuteWithRetryAndAuth[struct{}](ctx, r.kc, respDiags,
+	_, httpResp, err := common.ExecuteWithRetryAndAuth[struct{}](ctx, r.kc, respDiags, common.ServiceMySQL,
 		func() (struct{}, *http.Response, error) {
 			httpResp, err := r.kc.ApiClient.MySQLInstanceGroupsAPI.
 				ExtendMysqlInstanceGroupVolume(ctx, plan.InstanceGroupId.ValueString()).
@@ -49,7 +49,7 @@ func (r *instanceGroupResource) readExtendVolumeState(
 	current instanceGroupExtendVolumeModel,
 	respDiags *diag.Diagnostics,
 ) (instanceGroupExtendVolumeModel, bool, bool) {
-	result, httpResp, err := common.ExecuteWithRetryAndAuth(ctx, r.kc, respDiags,
+	result, httpResp, err := common.ExecuteWithRetryAndAuth(ctx, r.kc, respDiags, common.ServiceMySQL,
 		func() (*mysqlsdk.GetMySQLInstanceGroupResponseModel, *http.Response, error) {
 			return r.kc.ApiClient.MySQLInstanceGroupsAPI.
 				GetMysqlInstanceGroup(ctx, current.InstanceGroupId.ValueString()).
@@ -76,7 +76,7 @@ func (a *instanceGroupParameterGroupRetryAction) Invoke(ctx context.Context, req
 }
 
 func (a *instanceGroupParameterGroupRetryAction) retryParameterGroupSync(ctx context.Context, instanceGroupID string, respDiags *diag.Diagnostics) bool {
-	_, httpResp, err := common.ExecuteWithRetryAndAuth[struct{}](ctx, a.kc, respDiags,
+	_, httpResp, err := common.ExecuteWithRetryAndAuth[struct{}](ctx, a.kc, respDiags, common.ServiceMySQL,
 		func() (struct{}, *http.Response, error) {
 			httpResp, err := a.kc.ApiClient.MySQLInstanceGroupsParameterGroupsAPI.
 				RetryMysqlParameterGroupSync(ctx, instanceGroupID).
@@ -94,7 +94,7 @@ func (a *instanceGroupParameterGroupRetryAction) retryParameterGroupSync(ctx con
 }
 
 func (a *instanceGroupParameterGroupRetryAction) readParameterGroupRetryState(ctx context.Context, instanceGroupID string, timeouts resourceTimeouts.Value, respDiags *diag.Diagnostics) (instanceGroupParameterGroupRetryModel, bool, bool) {
-	result, httpResp, err := common.ExecuteWithRetryAndAuth(ctx, a.kc, respDiags,
+	result, httpResp, err := common.ExecuteWithRetryAndAuth(ctx, a.kc, respDiags, common.ServiceMySQL,
 		func() (*mysqlsdk.GetMySQLInstanceGroupResponseModel, *http.Response, error) {
 			return a.kc.ApiClient.MySQLInstanceGroupsAPI.
 				GetMysqlInstanceGroup(ctx, instanceGroupID).
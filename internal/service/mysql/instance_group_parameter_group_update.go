@@ -25,7 +25,7 @@ func (r *instanceGroupResource) applyParameterGroup(
 	)
 	request := mysqlsdk.NewBodyApplyMysqlParameterGroup(*requestGroup)
 
-	_, httpResp, err := common.ExecuteWithRetryAndAuth[struct{}](ctx, r.kc, respDiags,
+	_, httpResp, err := common.ExecuteWithRetryAndAuth[struct{}](ctx, r.kc, respDiags, common.ServiceMySQL,
 		func() (struct{}, *http.Response, error) {
 			httpResp, err := r.kc.ApiClient.MySQLInstanceGroupsAPI.
 				ApplyMysqlParameterGroup(ctx, plan.InstanceGroupId.ValueString()).
@@ -48,7 +48,7 @@ func (r *instanceGroupResource) retryParameterGroupSync(
 	instanceGroupID string,
 	respDiags *diag.Diagnostics,
 ) bool {
-	_, httpResp, err := common.ExecuteWithRetryAndAuth[struct{}](ctx, r.kc, respDiags,
+	_, httpResp, err := common.ExecuteWithRetryAndAuth[struct{}](ctx, r.kc, respDiags, common.ServiceMySQL,
 		func() (struct{}, *http.Response, error) {
 			httpResp, err := r.kc.ApiClient.MySQLInstanceGroupsParameterGroupsAPI.
 				RetryMysqlParameterGroupSync(ctx, instanceGroupID).
@@ -70,7 +70,7 @@ func (r *instanceGroupResource) readParameterGroupState(
 	instanceGroupId string,
 	respDiags *diag.Diagnostics,
 ) (instanceGroupParameterGroupModel, bool, bool) {
-	result, httpResp, err := common.ExecuteWithRetryAndAuth(ctx, r.kc, respDiags,
+	result, httpResp, err := common.ExecuteWithRetryAndAuth(ctx, r.kc, respDiags, common.ServiceMySQL,
 		func() (*mysqlsdk.GetMySQLInstanceGroupResponseModel, *http.Response, error) {
 			return r.kc.ApiClient.MySQLInstanceGroupsAPI.
 				GetMysqlInstanceGroup(ctx, instanceGroupId).
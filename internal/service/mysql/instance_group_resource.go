@@ -4,6 +4,7 @@ package mysql
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"net/http"
 	"strings"
@@ -165,7 +166,7 @@ func (r *instanceGroupResource) Create(ctx context.Context, req resource.CreateR
 		return
 	}
 
-	createResp, httpResp, err := common.ExecuteWithRetryAndAuth(ctx, r.kc, &resp.Diagnostics,
+	createResp, httpResp, err := common.ExecuteWithRetryAndAuth(ctx, r.kc, &resp.Diagnostics, common.ServiceMySQL,
 		func() (*mysqlsdk.CreateMySQLInstanceGroupResponseModel, *http.Response, error) {
 			return r.kc.ApiClient.MySQLInstanceGroupsAPI.
 				CreateMysqlInstanceGroup(ctx).
@@ -327,7 +328,7 @@ func (r *instanceGroupResource) Delete(ctx context.Context, req resource.DeleteR
 }
 
 func (r *instanceGroupResource) deleteInstanceGroup(ctx context.Context, id string, respDiags *diag.Diagnostics) bool {
-	_, httpResp, err := common.ExecuteWithRetryAndAuth[struct{}](ctx, r.kc, respDiags,
+	_, httpResp, err := common.ExecuteWithRetryAndAuth[struct{}](ctx, r.kc, respDiags, common.ServiceMySQL,
 		func() (struct{}, *http.Response, error) {
 			httpResp, err := r.kc.ApiClient.MySQLInstanceGroupsAPI.
 				DeleteMysqlInstanceGroup(ctx, id).
@@ -341,7 +342,8 @@ func (r *instanceGroupResource) deleteInstanceGroup(ctx context.Context, id stri
 		if httpResp != nil && httpResp.StatusCode == http.StatusNotFound {
 			return true
 		}
-		if httpResp != nil && httpResp.StatusCode == http.StatusConflict && strings.Contains(err.Error(), "already been deleted") {
+		var apiErr *common.APIError
+		if errors.As(err, &apiErr) && common.IsConflict(err) && strings.Contains(apiErr.Message, "already been deleted") {
 			return true
 		}
 		common.AddApiActionError(ctx, r, httpResp, "DeleteMysqlInstanceGroup", err, respDiags)
@@ -366,7 +368,7 @@ func (r *instanceGroupResource) readInstanceGroupState(
 	prev instanceGroupResourceModel,
 	respDiags *diag.Diagnostics,
 ) (instanceGroupResourceModel, bool, bool) {
-	result, httpResp, err := common.ExecuteWithRetryAndAuth(ctx, r.kc, respDiags,
+	result, httpResp, err := common.ExecuteWithRetryAndAuth(ctx, r.kc, respDiags, common.ServiceMySQL,
 		func() (*mysqlsdk.GetMySQLInstanceGroupResponseModel, *http.Response, error) {
 			return r.kc.ApiClient.MySQLInstanceGroupsAPI.
 				GetMysqlInstanceGroup(ctx, id).
@@ -1432,7 +1434,7 @@ func (r *instanceGroupResource) pollInstanceGroupUntilReady(
 	start := time.Now()
 
 	for {
-		resp, httpResp, err := common.ExecuteWithRetryAndAuth(ctx, r.kc, respDiags,
+		resp, httpResp, err := common.ExecuteWithRetryAndAuth(ctx, r.kc, respDiags, common.ServiceMySQL,
 			func() (*mysqlsdk.GetMySQLInstanceGroupResponseModel, *http.Response, error) {
 				return r.kc.ApiClient.MySQLInstanceGroupsAPI.
 					GetMysqlInstanceGroup(ctx, id).
@@ -1472,7 +1474,7 @@ func (r *instanceGroupResource) failIfAllInstancesInError(
 	id string,
 	respDiags *diag.Diagnostics,
 ) bool {
-	instancesResp, _, err := common.ExecuteWithRetryAndAuth(ctx, r.kc, respDiags,
+	instancesResp, _, err := common.ExecuteWithRetryAndAuth(ctx, r.kc, respDiags, common.ServiceMySQL,
 		func() (*mysqlsdk.GetMySQLInstanceGroupInstancesResponseModel, *http.Response, error) {
 			return r.kc.ApiClient.MySQLInstanceGroupsAPI.
 				ListMysqlInstances(ctx, id).
@@ -1501,7 +1503,7 @@ func (r *instanceGroupResource) failIfStandbyInstancesInTerminalStatus(
 	id string,
 	respDiags *diag.Diagnostics,
 ) bool {
-	instancesResp, _, err := common.ExecuteWithRetryAndAuth(ctx, r.kc, respDiags,
+	instancesResp, _, err := common.ExecuteWithRetryAndAuth(ctx, r.kc, respDiags, common.ServiceMySQL,
 		func() (*mysqlsdk.GetMySQLInstanceGroupInstancesResponseModel, *http.Response, error) {
 			return r.kc.ApiClient.MySQLInstanceGroupsAPI.
 				ListMysqlInstances(ctx, id).
@@ -1587,7 +1589,7 @@ func (r *instanceGroupResource) pollInstanceGroupUntilDeleted(
 	defer ticker.Stop()
 
 	for {
-		_, httpResp, err := common.ExecuteWithRetryAndAuth(ctx, r.kc, respDiags,
+		_, httpResp, err := common.ExecuteWithRetryAndAuth(ctx, r.kc, respDiags, common.ServiceMySQL,
 			func() (*mysqlsdk.GetMySQLInstanceGroupResponseModel, *http.Response, error) {
 				return r.kc.ApiClient.MySQLInstanceGroupsAPI.
 					GetMysqlInstanceGroup(ctx, id).
@@ -1598,7 +1600,7 @@ func (r *instanceGroupResource) pollInstanceGroupUntilDeleted(
 		if err != nil {
 			logDeletionPollFailure(ctx, httpResp, err)
 
-			if commonErrIsTransient(err) {
+			if common.IsTransientNetworkError(err) {
 				tflog.Warn(ctx, fmt.Sprintf("Transient polling error (%v). Retrying...", err))
 			} else if isTransientDeletePollingDecodeError(err) {
 				tflog.Warn(ctx, "MySQL instance group deletion polling got a transient decode error. Retrying until timeout...")
@@ -1644,18 +1646,6 @@ func logDeletionPollFailure(ctx context.Context, httpResp *http.Response, err er
 	tflog.Warn(ctx, "MySQL instance group deletion polling failed", fields)
 }
 
-func commonErrIsTransient(err error) bool {
-	if err == nil {
-		return false
-	}
-
-	msg := err.Error()
-	return strings.Contains(msg, "GOAWAY") ||
-		strings.Contains(msg, "connection reset") ||
-		strings.Contains(msg, "broken pipe") ||
-		strings.Contains(msg, "EOF")
-}
-
 func isTransientDeletePollingDecodeError(err error) bool {
 	if err == nil {
 		return false
@@ -1667,9 +1657,16 @@ func isTransientDeletePollingDecodeError(err error) bool {
 }
 
 func isTransientInstanceGroupPollingError(err error, resp *http.Response) bool {
-	if resp != nil && resp.StatusCode == http.StatusInternalServerError {
-		return true
+	var apiErr *common.APIError
+	if !errors.As(common.NewAPIError(resp, err), &apiErr) {
+		return false
 	}
 
-	return resp != nil && resp.StatusCode == http.StatusBadRequest && strings.Contains(err.Error(), "haStatus")
+	switch apiErr.StatusCode {
+	case http.StatusInternalServerError:
+		return true
+	case http.StatusBadRequest:
+		return strings.Contains(apiErr.Message, "haStatus")
+	}
+	return false
 }
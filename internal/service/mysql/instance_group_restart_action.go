@@ -95,7 +95,7 @@ func (a *instanceGroupRestartAction) restartInstances(ctx context.Context, insta
 	requestGroup := mysqlsdk.NewMysqlV1ApiRestartMysqlInstancesModelInstanceGroupRequestModel(instanceIDs)
 	request := mysqlsdk.NewBodyRestartMysqlInstances(*requestGroup)
 
-	_, httpResp, err := common.ExecuteWithRetryAndAuth[struct{}](ctx, a.kc, respDiags,
+	_, httpResp, err := common.ExecuteWithRetryAndAuth[struct{}](ctx, a.kc, respDiags, common.ServiceMySQL,
 		func() (struct{}, *http.Response, error) {
 			httpResp, err := a.kc.ApiClient.MySQLInstanceGroupsAPI.
 				RestartMysqlInstances(ctx, instanceGroupID).
@@ -114,7 +114,7 @@ func (a *instanceGroupRestartAction) restartInstances(ctx context.Context, insta
 }
 
 func (a *instanceGroupRestartAction) readRestartState(ctx context.Context, instanceGroupID string, instanceIDs []string, timeouts resourceTimeouts.Value, respDiags *diag.Diagnostics) (instanceGroupRestartModel, bool, bool) {
-	groupResp, httpResp, err := common.ExecuteWithRetryAndAuth(ctx, a.kc, respDiags,
+	groupResp, httpResp, err := common.ExecuteWithRetryAndAuth(ctx, a.kc, respDiags, common.ServiceMySQL,
 		func() (*mysqlsdk.GetMySQLInstanceGroupResponseModel, *http.Response, error) {
 			return a.kc.ApiClient.MySQLInstanceGroupsAPI.
 				GetMysqlInstanceGroup(ctx, instanceGroupID).
@@ -65,7 +65,7 @@ func (d *instanceGroupRestorableTimeDataSource) Read(ctx context.Context, req da
 	ctx, cancel := context.WithTimeout(ctx, timeout)
 	defer cancel()
 
-	modelResp, httpResp, err := common.ExecuteWithRetryAndAuth(ctx, d.kc, &resp.Diagnostics,
+	modelResp, httpResp, err := common.ExecuteWithRetryAndAuth(ctx, d.kc, &resp.Diagnostics, common.ServiceMySQL,
 		func() (*mysqlsdk.GetMySQLInstanceGroupRestorableTimeResponseModel, *http.Response, error) {
 			return d.kc.ApiClient.MySQLInstanceGroupsAPI.
 				GetMysqlRestorableTime(ctx, data.InstanceGroupId.ValueString()).
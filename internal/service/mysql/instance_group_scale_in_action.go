@@ -88,7 +88,7 @@ func (a *instanceGroupScaleInAction) Invoke(ctx context.Context, req action.Invo
 func (a *instanceGroupScaleInAction) scaleIn(ctx context.Context, instanceGroupID string, instanceIDs []string, respDiags *diag.Diagnostics) bool {
 	request := mysqlsdk.NewBodyScaleInMysqlInstanceGroup(*mysqlsdk.NewMysqlV1ApiScaleInMysqlInstanceGroupModelInstanceGroupRequestModel(instanceIDs))
 
-	_, httpResp, err := common.ExecuteWithRetryAndAuth[struct{}](ctx, a.kc, respDiags,
+	_, httpResp, err := common.ExecuteWithRetryAndAuth[struct{}](ctx, a.kc, respDiags, common.ServiceMySQL,
 		func() (struct{}, *http.Response, error) {
 			httpResp, err := a.kc.ApiClient.MySQLInstanceGroupsAPI.
 				ScaleInMysqlInstanceGroup(ctx, instanceGroupID).
@@ -106,7 +106,7 @@ func (a *instanceGroupScaleInAction) scaleIn(ctx context.Context, instanceGroupI
 }
 
 func (a *instanceGroupScaleInAction) validateScaleInTargets(ctx context.Context, instanceGroupID string, instanceIDs []string, respDiags *diag.Diagnostics) bool {
-	result, httpResp, err := common.ExecuteWithRetryAndAuth(ctx, a.kc, respDiags,
+	result, httpResp, err := common.ExecuteWithRetryAndAuth(ctx, a.kc, respDiags, common.ServiceMySQL,
 		func() (*mysqlsdk.GetMySQLInstanceGroupResponseModel, *http.Response, error) {
 			return a.kc.ApiClient.MySQLInstanceGroupsAPI.
 				GetMysqlInstanceGroup(ctx, instanceGroupID).
@@ -199,7 +199,7 @@ func (a *instanceGroupScaleInAction) readScaleInStatus(
 	targets map[string]struct{},
 	respDiags *diag.Diagnostics,
 ) (string, bool, bool) {
-	result, httpResp, err := common.ExecuteWithRetryAndAuth(ctx, a.kc, respDiags,
+	result, httpResp, err := common.ExecuteWithRetryAndAuth(ctx, a.kc, respDiags, common.ServiceMySQL,
 		func() (*mysqlsdk.GetMySQLInstanceGroupResponseModel, *http.Response, error) {
 			return a.kc.ApiClient.MySQLInstanceGroupsAPI.
 				GetMysqlInstanceGroup(ctx, instanceGroupID).
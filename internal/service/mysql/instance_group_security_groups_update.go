@@ -29,7 +29,7 @@ func (r *instanceGroupResource) applySecurityGroups(
 	requestGroup := mysqlsdk.NewMysqlV1ApiUpdateMysqlSecurityGroupsModelInstanceGroupRequestModel(securityGroupIds)
 	request := mysqlsdk.NewBodyUpdateMysqlSecurityGroups(*requestGroup)
 
-	_, httpResp, err := common.ExecuteWithRetryAndAuth[struct{}](ctx, r.kc, respDiags,
+	_, httpResp, err := common.ExecuteWithRetryAndAuth[struct{}](ctx, r.kc, respDiags, common.ServiceMySQL,
 		func() (struct{}, *http.Response, error) {
 			httpResp, err := r.kc.ApiClient.MySQLInstanceGroupsAPI.
 				UpdateMysqlSecurityGroups(ctx, plan.InstanceGroupId.ValueString()).
@@ -52,7 +52,7 @@ func (r *instanceGroupResource) readSecurityGroupsState(
 	instanceGroupId string,
 	respDiags *diag.Diagnostics,
 ) (instanceGroupSecurityGroupsModel, bool, bool) {
-	result, httpResp, err := common.ExecuteWithRetryAndAuth(ctx, r.kc, respDiags,
+	result, httpResp, err := common.ExecuteWithRetryAndAuth(ctx, r.kc, respDiags, common.ServiceMySQL,
 		func() (*mysqlsdk.GetMySQLInstanceGroupResponseModel, *http.Response, error) {
 			return r.kc.ApiClient.MySQLInstanceGroupsAPI.
 				GetMysqlInstanceGroup(ctx, instanceGroupId).
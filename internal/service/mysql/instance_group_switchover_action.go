@@ -90,7 +90,7 @@ func (a *instanceGroupSwitchoverAction) Invoke(ctx context.Context, req action.I
 }
 
 func (a *instanceGroupSwitchoverAction) switchover(ctx context.Context, instanceGroupID string, respDiags *diag.Diagnostics) bool {
-	_, httpResp, err := common.ExecuteWithRetryAndAuth[struct{}](ctx, a.kc, respDiags,
+	_, httpResp, err := common.ExecuteWithRetryAndAuth[struct{}](ctx, a.kc, respDiags, common.ServiceMySQL,
 		func() (struct{}, *http.Response, error) {
 			httpResp, err := a.kc.ApiClient.MySQLInstanceGroupsAPI.
 				SwitchoverMysqlInstanceGroup(ctx, instanceGroupID).
@@ -108,7 +108,7 @@ func (a *instanceGroupSwitchoverAction) switchover(ctx context.Context, instance
 }
 
 func (a *instanceGroupSwitchoverAction) readSwitchoverState(ctx context.Context, instanceGroupID string, timeouts resourceTimeouts.Value, respDiags *diag.Diagnostics) (instanceGroupSwitchoverModel, bool, bool) {
-	result, httpResp, err := common.ExecuteWithRetryAndAuth(ctx, a.kc, respDiags,
+	result, httpResp, err := common.ExecuteWithRetryAndAuth(ctx, a.kc, respDiags, common.ServiceMySQL,
 		func() (*mysqlsdk.GetMySQLInstanceGroupResponseModel, *http.Response, error) {
 			return a.kc.ApiClient.MySQLInstanceGroupsAPI.
 				GetMysqlInstanceGroup(ctx, instanceGroupID).
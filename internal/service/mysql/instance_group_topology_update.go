@@ -392,7 +392,7 @@ func (r *instanceGroupResource) scaleOut(
 	}
 	request := mysqlsdk.NewBodyScaleOutMysqlInstanceGroup(*requestGroup)
 
-	_, httpResp, err := common.ExecuteWithRetryAndAuth[struct{}](ctx, r.kc, respDiags,
+	_, httpResp, err := common.ExecuteWithRetryAndAuth[struct{}](ctx, r.kc, respDiags, common.ServiceMySQL,
 		func() (struct{}, *http.Response, error) {
 			httpResp, err := r.kc.ApiClient.MySQLInstanceGroupsAPI.
 				ScaleOutMysqlInstanceGroup(ctx, instanceGroupID).
@@ -422,7 +422,7 @@ func (r *instanceGroupResource) scaleIn(
 
 	request := mysqlsdk.NewBodyScaleInMysqlInstanceGroup(*mysqlsdk.NewMysqlV1ApiScaleInMysqlInstanceGroupModelInstanceGroupRequestModel(instanceIDs))
 
-	_, httpResp, err := common.ExecuteWithRetryAndAuth[struct{}](ctx, r.kc, respDiags,
+	_, httpResp, err := common.ExecuteWithRetryAndAuth[struct{}](ctx, r.kc, respDiags, common.ServiceMySQL,
 		func() (struct{}, *http.Response, error) {
 			httpResp, err := r.kc.ApiClient.MySQLInstanceGroupsAPI.
 				ScaleInMysqlInstanceGroup(ctx, instanceGroupID).
@@ -445,7 +445,7 @@ func (r *instanceGroupResource) selectScaleInTargetInstanceIDs(
 	scaleInCounts map[string]int32,
 	respDiags *diag.Diagnostics,
 ) ([]string, bool) {
-	result, httpResp, err := common.ExecuteWithRetryAndAuth(ctx, r.kc, respDiags,
+	result, httpResp, err := common.ExecuteWithRetryAndAuth(ctx, r.kc, respDiags, common.ServiceMySQL,
 		func() (*mysqlsdk.GetMySQLInstanceGroupResponseModel, *http.Response, error) {
 			return r.kc.ApiClient.MySQLInstanceGroupsAPI.
 				GetMysqlInstanceGroup(ctx, instanceGroupID).
@@ -458,7 +458,7 @@ func (r *instanceGroupResource) selectScaleInTargetInstanceIDs(
 		return nil, false
 	}
 
-	instancesResp, httpResp, err := common.ExecuteWithRetryAndAuth(ctx, r.kc, respDiags,
+	instancesResp, httpResp, err := common.ExecuteWithRetryAndAuth(ctx, r.kc, respDiags, common.ServiceMySQL,
 		func() (*mysqlsdk.GetMySQLInstanceGroupInstancesResponseModel, *http.Response, error) {
 			return r.kc.ApiClient.MySQLInstanceGroupsAPI.
 				ListMysqlInstances(ctx, instanceGroupID).
@@ -653,7 +653,7 @@ func (r *instanceGroupResource) readScaleInStatus(
 	targets map[string]struct{},
 	respDiags *diag.Diagnostics,
 ) (string, bool, bool) {
-	result, httpResp, err := common.ExecuteWithRetryAndAuth(ctx, r.kc, respDiags,
+	result, httpResp, err := common.ExecuteWithRetryAndAuth(ctx, r.kc, respDiags, common.ServiceMySQL,
 		func() (*mysqlsdk.GetMySQLInstanceGroupResponseModel, *http.Response, error) {
 			return r.kc.ApiClient.MySQLInstanceGroupsAPI.
 				GetMysqlInstanceGroup(ctx, instanceGroupID).
@@ -0,0 +1,93 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+package mysql
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestSubnetReplicaDiff(t *testing.T) {
+	tests := []struct {
+		name         string
+		state        map[string]int32
+		plan         map[string]int32
+		wantScaleIn  map[string]int32
+		wantScaleOut []instanceGroupScaleOutSubnetInfo
+	}{
+		{
+			name:         "unchanged",
+			state:        map[string]int32{"subnet-a": 1, "subnet-b": 2},
+			plan:         map[string]int32{"subnet-a": 1, "subnet-b": 2},
+			wantScaleIn:  map[string]int32{},
+			wantScaleOut: []instanceGroupScaleOutSubnetInfo{},
+		},
+		{
+			name:        "scale out existing and new subnet",
+			state:       map[string]int32{"subnet-a": 1},
+			plan:        map[string]int32{"subnet-a": 2, "subnet-b": 1},
+			wantScaleIn: map[string]int32{},
+			wantScaleOut: []instanceGroupScaleOutSubnetInfo{
+				{Replicas: 1, SubnetId: "subnet-a"},
+				{Replicas: 1, SubnetId: "subnet-b"},
+			},
+		},
+		{
+			name:         "scale in and remove subnet",
+			state:        map[string]int32{"subnet-a": 3, "subnet-b": 1},
+			plan:         map[string]int32{"subnet-a": 1},
+			wantScaleIn:  map[string]int32{"subnet-a": 2, "subnet-b": 1},
+			wantScaleOut: []instanceGroupScaleOutSubnetInfo{},
+		},
+		{
+			name:        "move replica between subnets",
+			state:       map[string]int32{"subnet-a": 2, "subnet-b": 1},
+			plan:        map[string]int32{"subnet-a": 1, "subnet-c": 2},
+			wantScaleIn: map[string]int32{"subnet-a": 1, "subnet-b": 1},
+			wantScaleOut: []instanceGroupScaleOutSubnetInfo{
+				{Replicas: 2, SubnetId: "subnet-c"},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			scaleIn, scaleOut := subnetReplicaDiff(tt.state, tt.plan)
+			if !reflect.DeepEqual(scaleIn, tt.wantScaleIn) {
+				t.Errorf("scaleIn = %v, want %v", scaleIn, tt.wantScaleIn)
+			}
+			if !reflect.DeepEqual(scaleOut, tt.wantScaleOut) {
+				t.Errorf("scaleOut = %v, want %v", scaleOut, tt.wantScaleOut)
+			}
+		})
+	}
+}
+
+func TestTopologyReplicaDiffForUpdate(t *testing.T) {
+	state := map[string]int32{"subnet-a": 1, "subnet-b": 1}
+	current := map[string]int32{"subnet-a": 2, "subnet-b": 1}
+
+	scaleIn, scaleOut := topologyReplicaDiffForUpdate(state, current, map[string]int32{"subnet-a": 1, "subnet-b": 1})
+	if scaleIn != nil || scaleOut != nil {
+		t.Errorf("expected no diff when plan matches state, got scaleIn=%v scaleOut=%v", scaleIn, scaleOut)
+	}
+
+	scaleIn, scaleOut = topologyReplicaDiffForUpdate(state, current, map[string]int32{"subnet-a": 1, "subnet-b": 2})
+	if want := map[string]int32{"subnet-a": 1}; !reflect.DeepEqual(scaleIn, want) {
+		t.Errorf("scaleIn = %v, want %v (diff must be computed from current replicas)", scaleIn, want)
+	}
+	if want := []instanceGroupScaleOutSubnetInfo{{Replicas: 1, SubnetId: "subnet-b"}}; !reflect.DeepEqual(scaleOut, want) {
+		t.Errorf("scaleOut = %v, want %v", scaleOut, want)
+	}
+}
+
+func TestTopologyReplicaCountsAfterScaleIn(t *testing.T) {
+	got := topologyReplicaCountsAfterScaleIn(
+		map[string]int32{"subnet-a": 3, "subnet-b": 1},
+		map[string]int32{"subnet-a": 1, "subnet-b": 1},
+	)
+	want := map[string]int32{"subnet-a": 2}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("topologyReplicaCountsAfterScaleIn() = %v, want %v", got, want)
+	}
+}
@@ -62,7 +62,7 @@ func (d *instanceGroupsDataSource) Read(ctx context.Context, req datasource.Read
 	ctx, cancel := context.WithTimeout(ctx, timeout)
 	defer cancel()
 
-	modelResp, httpResp, err := common.ExecuteWithRetryAndAuth(ctx, d.kc, &resp.Diagnostics,
+	modelResp, httpResp, err := common.ExecuteWithRetryAndAuth(ctx, d.kc, &resp.Diagnostics, common.ServiceMySQL,
 		func() (*mysqlsdk.GetMySQLInstanceGroupsResponseModel, *http.Response, error) {
 			return d.kc.ApiClient.MySQLInstanceGroupsAPI.
 				ListMysqlInstanceGroups(ctx).
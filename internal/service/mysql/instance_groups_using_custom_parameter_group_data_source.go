@@ -59,7 +59,7 @@ func (d *instanceGroupsUsingCustomParameterGroupDataSource) Read(ctx context.Con
 	ctx, cancel := context.WithTimeout(ctx, timeout)
 	defer cancel()
 
-	modelResp, httpResp, err := common.ExecuteWithRetryAndAuth(ctx, d.kc, &resp.Diagnostics,
+	modelResp, httpResp, err := common.ExecuteWithRetryAndAuth(ctx, d.kc, &resp.Diagnostics, common.ServiceMySQL,
 		func() (*mysqlsdk.MysqlV1ApiListMysqlInstanceGroupsUsingCustomParameterGroupModelGetMySQLInstanceGroupsUsingDefaultParameterGroupResponseModel, *http.Response, error) {
 			return d.kc.ApiClient.MySQLCustomParameterGroupsAPI.
 				ListMysqlInstanceGroupsUsingCustomParameterGroup(ctx, data.CustomParameterGroupId.ValueString()).
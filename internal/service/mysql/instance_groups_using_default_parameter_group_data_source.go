@@ -59,7 +59,7 @@ func (d *instanceGroupsUsingDefaultParameterGroupDataSource) Read(ctx context.Co
 	ctx, cancel := context.WithTimeout(ctx, timeout)
 	defer cancel()
 
-	modelResp, httpResp, err := common.ExecuteWithRetryAndAuth(ctx, d.kc, &resp.Diagnostics,
+	modelResp, httpResp, err := common.ExecuteWithRetryAndAuth(ctx, d.kc, &resp.Diagnostics, common.ServiceMySQL,
 		func() (*mysqlsdk.MysqlV1ApiListMysqlInstanceGroupsUsingDefaultParameterGroupModelGetMySQLInstanceGroupsUsingDefaultParameterGroupResponseModel, *http.Response, error) {
 			return d.kc.ApiClient.MySQLDefaultParameterGroupsAPI.
 				ListMysqlInstanceGroupsUsingDefaultParameterGroup(ctx, data.DefaultParameterGroupId.ValueString()).
@@ -84,7 +84,7 @@ func (d *publicIpDataSource) Read(ctx context.Context, req datasource.ReadReques
 	ctx, cancel := context.WithTimeout(ctx, timeout)
 	defer cancel()
 
-	publicIpResp, httpResp, err := common.ExecuteWithRetryAndAuth(ctx, d.kc, &resp.Diagnostics,
+	publicIpResp, httpResp, err := common.ExecuteWithRetryAndAuth(ctx, d.kc, &resp.Diagnostics, common.ServiceNetwork,
 		func() (*network.BnsNetworkV1ApiGetPublicIpModelResponsePublicIpModel, *http.Response, error) {
 			return d.kc.ApiClient.PublicIPAPI.GetPublicIp(ctx, config.Id.ValueString()).XAuthToken(d.kc.XAuthToken).Execute()
 		},
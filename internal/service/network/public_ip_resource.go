@@ -91,7 +91,7 @@ func (r *publicIpResource) Create(ctx context.Context, req resource.CreateReques
 	}
 	body := network.BodyCreatePublicIp{PublicIp: createReq}
 
-	respModel, httpResp, err := common.ExecuteWithRetryAndAuth(ctx, r.kc, &resp.Diagnostics,
+	respModel, httpResp, err := common.ExecuteWithRetryAndAuth(ctx, r.kc, &resp.Diagnostics, common.ServiceNetwork,
 		func() (*network.BnsNetworkV1ApiCreatePublicIpModelResponsePublicIpModel, *http.Response, error) {
 			return r.kc.ApiClient.PublicIPAPI.CreatePublicIp(ctx).XAuthToken(r.kc.XAuthToken).BodyCreatePublicIp(body).Execute()
 		},
@@ -143,7 +143,7 @@ func (r *publicIpResource) Create(ctx context.Context, req resource.CreateReques
 		}
 	}
 
-	result, httpResp, err := common.ExecuteWithRetryAndAuth(ctx, r.kc, &resp.Diagnostics,
+	result, httpResp, err := common.ExecuteWithRetryAndAuth(ctx, r.kc, &resp.Diagnostics, common.ServiceNetwork,
 		func() (*network.BnsNetworkV1ApiGetPublicIpModelResponsePublicIpModel, *http.Response, error) {
 			return r.kc.ApiClient.PublicIPAPI.
 				GetPublicIp(ctx, plan.Id.ValueString()).
@@ -186,7 +186,7 @@ func (r *publicIpResource) Read(ctx context.Context, req resource.ReadRequest, r
 	ctx, cancel := context.WithTimeout(ctx, timeout)
 	defer cancel()
 
-	respModel, httpResp, err := common.ExecuteWithRetryAndAuth(ctx, r.kc, &resp.Diagnostics,
+	respModel, httpResp, err := common.ExecuteWithRetryAndAuth(ctx, r.kc, &resp.Diagnostics, common.ServiceNetwork,
 		func() (*network.BnsNetworkV1ApiGetPublicIpModelResponsePublicIpModel, *http.Response, error) {
 			return r.kc.ApiClient.PublicIPAPI.
 				GetPublicIp(ctx, state.Id.ValueString()).
@@ -251,7 +251,7 @@ func (r *publicIpResource) Update(ctx context.Context, req resource.UpdateReques
 		editReq.SetDescription(plan.Description.ValueString())
 
 		body := *network.NewBodyUpdatePublicIp(editReq)
-		_, httpResp, err := common.ExecuteWithRetryAndAuth(ctx, r.kc, &resp.Diagnostics,
+		_, httpResp, err := common.ExecuteWithRetryAndAuth(ctx, r.kc, &resp.Diagnostics, common.ServiceNetwork,
 			func() (*network.BnsNetworkV1ApiUpdatePublicIpModelResponsePublicIpModel, *http.Response, error) {
 				return r.kc.ApiClient.PublicIPAPI.
 					UpdatePublicIp(ctx, plan.Id.ValueString()).
@@ -330,7 +330,7 @@ func (r *publicIpResource) Update(ctx context.Context, req resource.UpdateReques
 		}
 	}
 
-	respModel, httpResp, err := common.ExecuteWithRetryAndAuth(ctx, r.kc, &resp.Diagnostics,
+	respModel, httpResp, err := common.ExecuteWithRetryAndAuth(ctx, r.kc, &resp.Diagnostics, common.ServiceNetwork,
 		func() (*network.BnsNetworkV1ApiGetPublicIpModelResponsePublicIpModel, *http.Response, error) {
 			return r.kc.ApiClient.PublicIPAPI.
 				GetPublicIp(ctx, state.Id.ValueString()).
@@ -410,6 +410,7 @@ func (r *publicIpResource) Delete(ctx context.Context, req resource.DeleteReques
 		ctx,
 		r.kc,
 		&resp.Diagnostics,
+		common.ServiceNetwork,
 		func() (struct{}, *http.Response, error) {
 			resp, err := r.kc.ApiClient.PublicIPAPI.
 				DeletePublicIp(ctx, state.Id.ValueString()).
@@ -429,7 +430,7 @@ func (r *publicIpResource) Delete(ctx context.Context, req resource.DeleteReques
 	}
 
 	common.PollUntilDeletion(ctx, r, 2*time.Second, &resp.Diagnostics, func(ctx context.Context) (bool, *http.Response, error) {
-		_, httpResp, err := common.ExecuteWithRetryAndAuth(ctx, r.kc, &resp.Diagnostics,
+		_, httpResp, err := common.ExecuteWithRetryAndAuth(ctx, r.kc, &resp.Diagnostics, common.ServiceNetwork,
 			func() (*network.BnsNetworkV1ApiGetPublicIpModelResponsePublicIpModel, *http.Response, error) {
 				_, httpResp, err := r.kc.ApiClient.PublicIPAPI.
 					GetPublicIp(ctx, state.Id.ValueString()).
@@ -473,7 +474,7 @@ func (r *publicIpResource) attachPublicIPByType(
 
 	switch deviceType {
 	case "instance":
-		_, httpResp, err = common.ExecuteWithRetryAndAuth(ctx, r.kc, respDiags,
+		_, httpResp, err = common.ExecuteWithRetryAndAuth(ctx, r.kc, respDiags, common.ServiceBCS,
 			func() (*bcs.BcsInstanceV1ApiAssociatePublicIpModelResponsePublicIpModel, *http.Response, error) {
 				return r.kc.ApiClient.InstancePublicIPAPI.
 					AssociatePublicIp(ctx, deviceId, networkInterfaceId, publicIpId).
@@ -483,7 +484,7 @@ func (r *publicIpResource) attachPublicIPByType(
 		)
 
 	case "load-balancer":
-		_, httpResp, err = common.ExecuteWithRetryAndAuth(ctx, r.kc, respDiags,
+		_, httpResp, err = common.ExecuteWithRetryAndAuth(ctx, r.kc, respDiags, common.ServiceLoadBalancer,
 			func() (*loadbalancer.BnsLoadBalancerV1ApiAssociatePublicIpModelResponsePublicIpModel, *http.Response, error) {
 				return r.kc.ApiClient.LoadBalancerAPI.
 					AssociatePublicIp(ctx, deviceId, publicIpId).
@@ -519,7 +520,7 @@ func (r *publicIpResource) detachPublicIPByType(
 
 	switch deviceType {
 	case "instance":
-		_, httpResp, err = common.ExecuteWithRetryAndAuth(ctx, r.kc, respDiags,
+		_, httpResp, err = common.ExecuteWithRetryAndAuth(ctx, r.kc, respDiags, common.ServiceBCS,
 			func() (*bcs.BcsInstanceV1ApiRemovePublicIpModelResponsePublicIpModel, *http.Response, error) {
 				return r.kc.ApiClient.InstancePublicIPAPI.
 					RemovePublicIp(ctx, deviceId, networkInterfaceId).
@@ -529,7 +530,7 @@ func (r *publicIpResource) detachPublicIPByType(
 		)
 
 	case "load-balancer":
-		_, httpResp, err = common.ExecuteWithRetryAndAuth(ctx, r.kc, respDiags,
+		_, httpResp, err = common.ExecuteWithRetryAndAuth(ctx, r.kc, respDiags, common.ServiceLoadBalancer,
 			func() (*loadbalancer.BnsLoadBalancerV1ApiRemovePublicIpModelResponsePublicIpModel, *http.Response, error) {
 				return r.kc.ApiClient.LoadBalancerAPI.
 					RemovePublicIp(ctx, deviceId).
@@ -638,7 +639,7 @@ func (r *publicIpResource) cleanupCreateFailurePublicIP(
 	publicIpId string,
 	diags *diag.Diagnostics,
 ) error {
-	_, httpResp, err := common.ExecuteWithRetryAndAuth[struct{}](ctx, r.kc, diags,
+	_, httpResp, err := common.ExecuteWithRetryAndAuth[struct{}](ctx, r.kc, diags, common.ServiceNetwork,
 		func() (struct{}, *http.Response, error) {
 			resp, err := r.kc.ApiClient.PublicIPAPI.
 				DeletePublicIp(ctx, publicIpId).
@@ -653,7 +654,7 @@ func (r *publicIpResource) cleanupCreateFailurePublicIP(
 	}
 
 	common.PollUntilDeletion(ctx, r, 2*time.Second, diags, func(ctx context.Context) (bool, *http.Response, error) {
-		_, httpResp, err := common.ExecuteWithRetryAndAuth(ctx, r.kc, diags,
+		_, httpResp, err := common.ExecuteWithRetryAndAuth(ctx, r.kc, diags, common.ServiceNetwork,
 			func() (*network.BnsNetworkV1ApiGetPublicIpModelResponsePublicIpModel, *http.Response, error) {
 				_, hr, err := r.kc.ApiClient.PublicIPAPI.
 					GetPublicIp(ctx, publicIpId).
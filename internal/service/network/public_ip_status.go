@@ -27,7 +27,7 @@ func (r *publicIpResource) pollPublicIpUtilsStatus(
 		targetStatuses,
 		resp,
 		func(ctx context.Context) (*network.BnsNetworkV1ApiGetPublicIpModelFloatingIpModel, *http.Response, error) {
-			respModel, httpResp, err := common.ExecuteWithRetryAndAuth(ctx, r.kc, resp,
+			respModel, httpResp, err := common.ExecuteWithRetryAndAuth(ctx, r.kc, resp, common.ServiceNetwork,
 				func() (*network.BnsNetworkV1ApiGetPublicIpModelResponsePublicIpModel, *http.Response, error) {
 					return r.kc.ApiClient.PublicIPAPI.
 						GetPublicIp(ctx, publicIpId).
@@ -164,25 +164,29 @@ func (d *publicIpsDataSource) Read(ctx context.Context, req datasource.ReadReque
 		return
 	}
 
-	publicIpResp, httpResp, err := common.
-		ExecuteWithRetryAndAuth(ctx, d.kc, &resp.Diagnostics,
-			func() (*network.PublicIpListModel, *http.Response, error) {
-				return publicIpApi.Limit(1000).XAuthToken(d.kc.XAuthToken).Execute()
-			},
-		)
+	publicIpPages, httpResp, err := common.ListAllPagesPartial(ctx, d.kc, d, &resp.Diagnostics, common.ServiceNetwork,
+		func(limit int32, offset int32) (*network.PublicIpListModel, *http.Response, error) {
+			return publicIpApi.Limit(limit).Offset(offset).XAuthToken(d.kc.XAuthToken).Execute()
+		},
+		func(page *network.PublicIpListModel) int {
+			return len(page.PublicIps)
+		},
+	)
 	if err != nil {
 		common.AddApiActionError(ctx, d, httpResp, "ListPublicIps", err, &resp.Diagnostics)
 		return
 	}
 
-	for _, v := range publicIpResp.PublicIps {
-		var tmpPublicIp publicIpBaseModel
-		ok := d.mapPublicIps(ctx, &tmpPublicIp, &v, &resp.Diagnostics)
-		if !ok || resp.Diagnostics.HasError() {
-			return
-		}
+	for _, publicIpResp := range publicIpPages {
+		for _, v := range publicIpResp.PublicIps {
+			var tmpPublicIp publicIpBaseModel
+			ok := d.mapPublicIps(ctx, &tmpPublicIp, &v, &resp.Diagnostics)
+			if !ok || resp.Diagnostics.HasError() {
+				return
+			}
 
-		config.PublicIps = append(config.PublicIps, tmpPublicIp)
+			config.PublicIps = append(config.PublicIps, tmpPublicIp)
+		}
 	}
 
 	diags = resp.State.Set(ctx, &config)
@@ -27,44 +27,7 @@ func mapSecurityGroupBaseModel(
 			result.Rules,
 			securityGroupRuleAttrType,
 			func(src network.BnsNetworkV1ApiGetSecurityGroupModelSecurityGroupRuleModel) any {
-
-				portMin := ConvertNullableString(src.PortRangeMin)
-				portMax := ConvertNullableString(src.PortRangeMax)
-
-				portMinInt := types.Int32Null()
-				if !portMin.IsNull() && !portMin.IsUnknown() {
-					if portMin.ValueString() == "ALL" {
-						portMinInt = types.Int32Value(1)
-					} else {
-						if v, err := strconv.Atoi(portMin.ValueString()); err == nil {
-							portMinInt = types.Int32Value(int32(v))
-						}
-					}
-				}
-				portMaxInt := types.Int32Null()
-				if !portMax.IsNull() && !portMax.IsUnknown() {
-					if portMax.ValueString() == "ALL" {
-						portMaxInt = types.Int32Value(65535)
-					} else {
-						if v, err := strconv.Atoi(portMax.ValueString()); err == nil {
-							portMaxInt = types.Int32Value(int32(v))
-						}
-					}
-				}
-
-				return securityGroupRuleModel{
-					Id:              types.StringValue(src.Id),
-					Description:     ConvertNullableString(src.Description),
-					RemoteGroupId:   ConvertNullableString(src.RemoteGroupId),
-					RemoteGroupName: ConvertNullableString(src.RemoteGroupName),
-					Direction:       ConvertNullableString(src.Direction),
-					Protocol:        types.StringValue(string(src.Protocol)),
-					PortRangeMin:    portMinInt,
-					PortRangeMax:    portMaxInt,
-					RemoteIpPrefix:  ConvertNullableIPPrefix(src.RemoteIpPrefix),
-					CreatedAt:       ConvertNullableTime(src.CreatedAt),
-					UpdatedAt:       ConvertNullableTime(src.UpdatedAt),
-				}
+				return mapSecurityGroupRuleModel(&src)
 			},
 		)
 		respDiags.Append(ruleDiags...)
@@ -90,6 +53,57 @@ func mapSecurityGroupBaseModel(
 	return true
 }
 
+func mapSecurityGroupRuleModel(
+	src *network.BnsNetworkV1ApiGetSecurityGroupModelSecurityGroupRuleModel,
+) securityGroupRuleModel {
+	portMin := ConvertNullableString(src.PortRangeMin)
+	portMax := ConvertNullableString(src.PortRangeMax)
+
+	portMinInt := types.Int32Null()
+	if !portMin.IsNull() && !portMin.IsUnknown() {
+		if portMin.ValueString() == "ALL" {
+			portMinInt = types.Int32Value(1)
+		} else {
+			if v, err := strconv.Atoi(portMin.ValueString()); err == nil {
+				portMinInt = types.Int32Value(int32(v))
+			}
+		}
+	}
+	portMaxInt := types.Int32Null()
+	if !portMax.IsNull() && !portMax.IsUnknown() {
+		if portMax.ValueString() == "ALL" {
+			portMaxInt = types.Int32Value(65535)
+		} else {
+			if v, err := strconv.Atoi(portMax.ValueString()); err == nil {
+				portMaxInt = types.Int32Value(int32(v))
+			}
+		}
+	}
+
+	return securityGroupRuleModel{
+		Id:              types.StringValue(src.Id),
+		Description:     ConvertNullableString(src.Description),
+		RemoteGroupId:   ConvertNullableString(src.RemoteGroupId),
+		RemoteGroupName: ConvertNullableString(src.RemoteGroupName),
+		Direction:       ConvertNullableString(src.Direction),
+		Protocol:        types.StringValue(string(src.Protocol)),
+		PortRangeMin:    portMinInt,
+		PortRangeMax:    portMaxInt,
+		RemoteIpPrefix:  ConvertNullableIPPrefix(src.RemoteIpPrefix),
+		CreatedAt:       ConvertNullableTime(src.CreatedAt),
+		UpdatedAt:       ConvertNullableTime(src.UpdatedAt),
+	}
+}
+
+func mapSecurityGroupRuleResourceModel(
+	model *securityGroupRuleResourceModel,
+	sgId string,
+	src *network.BnsNetworkV1ApiGetSecurityGroupModelSecurityGroupRuleModel,
+) {
+	model.securityGroupRuleModel = mapSecurityGroupRuleModel(src)
+	model.SecurityGroupId = types.StringValue(sgId)
+}
+
 func mapSecurityGroupBaseModelFromList(
 	ctx context.Context,
 	base *securityGroupBaseModel,
@@ -55,7 +55,14 @@ var securityGroupRuleAttrType = map[string]attr.Type{
 
 type securityGroupResourceModel struct {
 	securityGroupBaseModel
-	Timeouts resourceTimeouts.Value `tfsdk:"timeouts"`
+	IgnoreUnmanagedRules types.Bool             `tfsdk:"ignore_unmanaged_rules"`
+	Timeouts             resourceTimeouts.Value `tfsdk:"timeouts"`
+}
+
+type securityGroupRuleResourceModel struct {
+	securityGroupRuleModel
+	SecurityGroupId types.String           `tfsdk:"security_group_id"`
+	Timeouts        resourceTimeouts.Value `tfsdk:"timeouts"`
 }
 
 type securityGroupDataSourceModel struct {
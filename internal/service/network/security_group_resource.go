@@ -75,43 +75,7 @@ func (r *securityGroupResource) ValidateConfig(ctx context.Context, req resource
 	}
 
 	for _, rule := range rules {
-		proto := strings.ToUpper(rule.Protocol.ValueString())
-
-		remoteIpSet := !rule.RemoteIpPrefix.IsNull()
-		remoteGroupSet := !rule.RemoteGroupId.IsNull()
-
-		onlyOneRemoteFieldExists := (remoteIpSet) != (remoteGroupSet)
-		if !onlyOneRemoteFieldExists {
-			common.AddValidationConfigError(ctx, r, &resp.Diagnostics,
-				"Exactly one of remote_ip_prefix or remote_group_id must be provided (not both or neither)",
-			)
-			continue
-		}
-
-		switch proto {
-		case string(network.SECURITYGROUPRULEPROTOCOL_TCP), string(network.SECURITYGROUPRULEPROTOCOL_UDP):
-			if rule.PortRangeMin.IsNull() {
-				common.AddValidationConfigError(ctx, r, &resp.Diagnostics,
-					fmt.Sprintf("port_range_min is required when protocol is '%s'", proto),
-				)
-			}
-			if rule.PortRangeMax.IsNull() {
-				common.AddValidationConfigError(ctx, r, &resp.Diagnostics,
-					fmt.Sprintf("port_range_max is required when protocol is '%s'", proto),
-				)
-			}
-			if rule.PortRangeMin.ValueInt32() > rule.PortRangeMax.ValueInt32() {
-				common.AddValidationConfigError(ctx, r, &resp.Diagnostics,
-					fmt.Sprintf("port_range_max must be greater than or equal to port_range_min when protocol is '%s'", proto),
-				)
-			}
-		case string(network.SECURITYGROUPRULEPROTOCOL_ALL), string(network.SECURITYGROUPRULEPROTOCOL_ICMP):
-			if !rule.PortRangeMin.IsNull() || !rule.PortRangeMax.IsNull() {
-				common.AddValidationConfigError(ctx, r, &resp.Diagnostics,
-					fmt.Sprintf("port_range_min and port_range_max must be null when protocol is '%s'", proto),
-				)
-			}
-		}
+		validateSecurityGroupRule(ctx, r, rule, &resp.Diagnostics)
 	}
 }
 
@@ -131,7 +95,7 @@ func (r *securityGroupResource) Read(ctx context.Context, req resource.ReadReque
 	ctx, cancel := context.WithTimeout(ctx, timeout)
 	defer cancel()
 
-	respModel, httpResp, err := common.ExecuteWithRetryAndAuth(ctx, r.kc, &resp.Diagnostics,
+	respModel, httpResp, err := common.ExecuteWithRetryAndAuth(ctx, r.kc, &resp.Diagnostics, common.ServiceNetwork,
 		func() (*network.BnsNetworkV1ApiGetSecurityGroupModelResponseSecurityGroupModel, *http.Response, error) {
 			return r.kc.ApiClient.SecurityGroupAPI.
 				GetSecurityGroup(ctx, state.Id.ValueString()).
@@ -149,11 +113,27 @@ func (r *securityGroupResource) Read(ctx context.Context, req resource.ReadReque
 		return
 	}
 
+	managedRules, diags := expandSecurityGroupRules(ctx, state.Rules)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	ok := mapSecurityGroupBaseModel(ctx, &state.securityGroupBaseModel, &respModel.SecurityGroup, &resp.Diagnostics)
 	if !ok || resp.Diagnostics.HasError() {
 		return
 	}
 
+	if state.IgnoreUnmanagedRules.IsNull() {
+		state.IgnoreUnmanagedRules = types.BoolValue(false)
+	}
+	if state.IgnoreUnmanagedRules.ValueBool() {
+		state.Rules = filterManagedSecurityGroupRules(ctx, state.Rules, securityGroupRuleIds(managedRules), &resp.Diagnostics)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
 	diags = resp.State.Set(ctx, &state)
 	resp.Diagnostics.Append(diags...)
 }
@@ -174,7 +154,7 @@ func (r *securityGroupResource) Create(ctx context.Context, req resource.CreateR
 	}
 
 	body := network.BodyCreateSecurityGroup{SecurityGroup: createReq}
-	respModel, httpResp, err := common.ExecuteWithRetryAndAuth(ctx, r.kc, &resp.Diagnostics,
+	respModel, httpResp, err := common.ExecuteWithRetryAndAuth(ctx, r.kc, &resp.Diagnostics, common.ServiceNetwork,
 		func() (*network.BnsNetworkV1ApiCreateSecurityGroupModelResponseSecurityGroupModel, *http.Response, error) {
 			return r.kc.ApiClient.SecurityGroupAPI.CreateSecurityGroup(ctx).
 				XAuthToken(r.kc.XAuthToken).
@@ -187,24 +167,32 @@ func (r *securityGroupResource) Create(ctx context.Context, req resource.CreateR
 		return
 	}
 
-	desiredRuleCount := 0
+	mutex := common.LockForID(respModel.SecurityGroup.Id)
+	mutex.Lock()
+	defer mutex.Unlock()
+
+	var desired []securityGroupRuleModel
 	if !plan.Rules.IsNull() && !plan.Rules.IsUnknown() {
-		var desired []securityGroupRuleModel
 		dDiags := plan.Rules.ElementsAs(ctx, &desired, false)
 		resp.Diagnostics.Append(dDiags...)
 
 		if !resp.Diagnostics.HasError() {
 			for i := range desired {
 				dr := &desired[i]
-				if ok := r.addSecurityGroupRule(ctx, respModel.SecurityGroup.Id, dr, &resp.Diagnostics); !ok {
+				if ok := createSecurityGroupRule(ctx, r.kc, r, respModel.SecurityGroup.Id, dr, &resp.Diagnostics); !ok {
 					return
 				}
 			}
 		}
-		desiredRuleCount = len(desired)
 	}
 
-	finalSg, ok := r.waitForRulesToPropagate(ctx, respModel.SecurityGroup.Id, desiredRuleCount, &resp.Diagnostics)
+	var finalSg *network.BnsNetworkV1ApiGetSecurityGroupModelSecurityGroupModel
+	var ok bool
+	if plan.IgnoreUnmanagedRules.ValueBool() {
+		finalSg, ok = waitForSecurityGroupRules(ctx, r.kc, r, respModel.SecurityGroup.Id, securityGroupRuleIds(desired), nil, &resp.Diagnostics)
+	} else {
+		finalSg, ok = r.waitForRulesToPropagate(ctx, respModel.SecurityGroup.Id, len(desired), &resp.Diagnostics)
+	}
 	if !ok || resp.Diagnostics.HasError() {
 		return
 	}
@@ -214,6 +202,13 @@ func (r *securityGroupResource) Create(ctx context.Context, req resource.CreateR
 		return
 	}
 
+	if plan.IgnoreUnmanagedRules.ValueBool() {
+		plan.Rules = filterManagedSecurityGroupRules(ctx, plan.Rules, securityGroupRuleIds(desired), &resp.Diagnostics)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
 	diags = resp.State.Set(ctx, plan)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
@@ -248,7 +243,7 @@ func (r *securityGroupResource) Update(ctx context.Context, req resource.UpdateR
 		}
 
 		body := network.BodyUpdateSecurityGroup{SecurityGroup: editReq}
-		_, httpResp, err := common.ExecuteWithRetryAndAuth(ctx, r.kc, &resp.Diagnostics,
+		_, httpResp, err := common.ExecuteWithRetryAndAuth(ctx, r.kc, &resp.Diagnostics, common.ServiceNetwork,
 			func() (interface{}, *http.Response, error) {
 				return r.kc.ApiClient.SecurityGroupAPI.
 					UpdateSecurityGroup(ctx, state.Id.ValueString()).
@@ -273,15 +268,29 @@ func (r *securityGroupResource) Update(ctx context.Context, req resource.UpdateR
 		}
 	}
 
-	if !plan.Rules.Equal(state.Rules) {
+	mutex := common.LockForID(state.Id.ValueString())
+	mutex.Lock()
+	defer mutex.Unlock()
+
+	planRules, pDiags := expandSecurityGroupRules(ctx, plan.Rules)
+	resp.Diagnostics.Append(pDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
-		planRules, pDiags := expandSecurityGroupRules(ctx, plan.Rules)
-		resp.Diagnostics.Append(pDiags...)
+	var removedRuleIds []string
+	if !plan.Rules.Equal(state.Rules) {
 		stateRules, sDiags := expandSecurityGroupRules(ctx, state.Rules)
 		resp.Diagnostics.Append(sDiags...)
 		if resp.Diagnostics.HasError() {
 			return
 		}
+		adoptSecurityGroupRules(planRules, stateRules)
+
+		// Until ignore_unmanaged_rules has been applied, the state lists every rule of the group,
+		// for example right after an import. Rules missing from the plan are then left in place
+		// instead of being treated as managed rules that were removed.
+		keepUnlisted := plan.IgnoreUnmanagedRules.ValueBool() && !state.IgnoreUnmanagedRules.ValueBool()
 
 		planRulesMap := make(map[string]securityGroupRuleModel)
 		for _, rule := range planRules {
@@ -295,27 +304,29 @@ func (r *securityGroupResource) Update(ctx context.Context, req resource.UpdateR
 		for i := range planRules {
 			rule := &planRules[i]
 			if _, exists := stateRulesMap[rule.Id.ValueString()]; !exists {
-				if ok := r.addSecurityGroupRule(ctx, state.Id.ValueString(), rule, &resp.Diagnostics); !ok {
+				if ok := createSecurityGroupRule(ctx, r.kc, r, state.Id.ValueString(), rule, &resp.Diagnostics); !ok {
 					return
 				}
 			}
 		}
 
 		for key, rule := range stateRulesMap {
-			if _, exists := planRulesMap[key]; !exists {
-				if ok := r.deleteSecurityGroupRule(ctx, state.Id.ValueString(), rule.Id.ValueString(), &resp.Diagnostics); !ok {
+			if _, exists := planRulesMap[key]; !exists && !keepUnlisted {
+				if _, ok := deleteSecurityGroupRule(ctx, r.kc, r, state.Id.ValueString(), rule.Id.ValueString(), &resp.Diagnostics); !ok {
 					return
 				}
+				removedRuleIds = append(removedRuleIds, rule.Id.ValueString())
 			}
 		}
 	}
 
-	desiredRuleCount := 0
-	if !plan.Rules.IsNull() {
-		desiredRuleCount = len(plan.Rules.Elements())
+	var finalSg *network.BnsNetworkV1ApiGetSecurityGroupModelSecurityGroupModel
+	var ok bool
+	if plan.IgnoreUnmanagedRules.ValueBool() {
+		finalSg, ok = waitForSecurityGroupRules(ctx, r.kc, r, state.Id.ValueString(), securityGroupRuleIds(planRules), removedRuleIds, &resp.Diagnostics)
+	} else {
+		finalSg, ok = r.waitForRulesToPropagate(ctx, state.Id.ValueString(), len(planRules), &resp.Diagnostics)
 	}
-
-	finalSg, ok := r.waitForRulesToPropagate(ctx, state.Id.ValueString(), desiredRuleCount, &resp.Diagnostics)
 	if !ok || resp.Diagnostics.HasError() {
 		return
 	}
@@ -325,6 +336,13 @@ func (r *securityGroupResource) Update(ctx context.Context, req resource.UpdateR
 		return
 	}
 
+	if plan.IgnoreUnmanagedRules.ValueBool() {
+		plan.Rules = filterManagedSecurityGroupRules(ctx, plan.Rules, securityGroupRuleIds(planRules), &resp.Diagnostics)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
 	if !newName.IsNull() {
 		plan.Name = newName
 	}
@@ -352,7 +370,7 @@ func (r *securityGroupResource) Delete(ctx context.Context, req resource.DeleteR
 	ctx, cancel := context.WithTimeout(ctx, timeout)
 	defer cancel()
 
-	_, httpResp, err := common.ExecuteWithRetryAndAuth(ctx, r.kc, &resp.Diagnostics,
+	_, httpResp, err := common.ExecuteWithRetryAndAuth(ctx, r.kc, &resp.Diagnostics, common.ServiceNetwork,
 		func() (struct{}, *http.Response, error) {
 			httpResp, err := r.kc.ApiClient.SecurityGroupAPI.
 				DeleteSecurityGroup(ctx, state.Id.ValueString()).
@@ -407,7 +425,7 @@ func (r *securityGroupResource) waitForRulesToPropagate(
 		[]string{targetStatus},
 		respDiags,
 		func(ctx context.Context) (*network.BnsNetworkV1ApiGetSecurityGroupModelSecurityGroupModel, *http.Response, error) {
-			respModel, httpResp, err := common.ExecuteWithRetryAndAuth(ctx, r.kc, respDiags,
+			respModel, httpResp, err := common.ExecuteWithRetryAndAuth(ctx, r.kc, respDiags, common.ServiceNetwork,
 				func() (*network.BnsNetworkV1ApiGetSecurityGroupModelResponseSecurityGroupModel, *http.Response, error) {
 					return r.kc.ApiClient.SecurityGroupAPI.
 						GetSecurityGroup(ctx, securityGroupId).
@@ -428,58 +446,3 @@ func (r *securityGroupResource) waitForRulesToPropagate(
 
 	return result, ok
 }
-
-func (r *securityGroupResource) addSecurityGroupRule(ctx context.Context, sgId string, rule *securityGroupRuleModel, diags *diag.Diagnostics) bool {
-	creq := network.CreateSecurityGroupRuleModel{
-		Direction: network.SecurityGroupRuleDirection(rule.Direction.ValueString()),
-		Protocol:  network.SecurityGroupRuleProtocol(rule.Protocol.ValueString()),
-	}
-	if !rule.Description.IsNull() && !rule.Description.IsUnknown() {
-		creq.SetDescription(rule.Description.ValueString())
-	}
-	if !rule.PortRangeMin.IsNull() && !rule.PortRangeMin.IsUnknown() {
-		creq.SetPortRangeMin(rule.PortRangeMin.ValueInt32())
-	}
-	if !rule.PortRangeMax.IsNull() && !rule.PortRangeMax.IsUnknown() {
-		creq.SetPortRangeMax(rule.PortRangeMax.ValueInt32())
-	}
-	if !rule.RemoteIpPrefix.IsNull() && !rule.RemoteIpPrefix.IsUnknown() {
-		creq.SetRemoteIpPrefix(rule.RemoteIpPrefix.ValueString())
-	}
-	if !rule.RemoteGroupId.IsNull() && !rule.RemoteGroupId.IsUnknown() {
-		creq.SetRemoteGroupId(rule.RemoteGroupId.ValueString())
-	}
-
-	crResp, httpResp, err := common.ExecuteWithRetryAndAuth(ctx, r.kc, diags,
-		func() (*network.ResponseSecurityGroupRuleModel, *http.Response, error) {
-			return r.kc.ApiClient.SecurityGroupAPI.
-				CreateSecurityGroupRule(ctx, sgId).
-				XAuthToken(r.kc.XAuthToken).
-				BodyCreateSecurityGroupRule(network.BodyCreateSecurityGroupRule{SecurityGroupRule: creq}).
-				Execute()
-		},
-	)
-	if err != nil {
-		common.AddApiActionError(ctx, r, httpResp, "CreateSecurityGroupRule", err, diags)
-		return false
-	}
-	rule.Id = types.StringValue(crResp.SecurityGroupRule.Id)
-	return true
-}
-
-func (r *securityGroupResource) deleteSecurityGroupRule(ctx context.Context, sgId string, ruleId string, diags *diag.Diagnostics) bool {
-	_, httpResp, err := common.ExecuteWithRetryAndAuth(ctx, r.kc, diags,
-		func() (interface{}, *http.Response, error) {
-			httpResp, err := r.kc.ApiClient.SecurityGroupAPI.
-				DeleteSecurityGroupRule(ctx, sgId, ruleId).
-				XAuthToken(r.kc.XAuthToken).
-				Execute()
-			return nil, httpResp, err
-		},
-	)
-	if err != nil {
-		common.AddApiActionError(ctx, r, httpResp, "DeleteSecurityGroupRule", err, diags)
-		return false
-	}
-	return true
-}
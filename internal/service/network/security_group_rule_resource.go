@@ -0,0 +1,231 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+package network
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+	"terraform-provider-kakaocloud/internal/common"
+	"terraform-provider-kakaocloud/internal/utils"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/kakaoenterprise/kc-sdk-go/services/network"
+)
+
+var (
+	_ resource.Resource                   = &securityGroupRuleResource{}
+	_ resource.ResourceWithConfigure      = &securityGroupRuleResource{}
+	_ resource.ResourceWithImportState    = &securityGroupRuleResource{}
+	_ resource.ResourceWithValidateConfig = &securityGroupRuleResource{}
+)
+
+func NewSecurityGroupRuleResource() resource.Resource {
+	return &securityGroupRuleResource{}
+}
+
+type securityGroupRuleResource struct {
+	kc *common.KakaoCloudClient
+}
+
+func (r *securityGroupRuleResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_security_group_rule"
+}
+
+func (r *securityGroupRuleResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: utils.MergeAttributes(
+			securityGroupRuleResourceSchemaAttributes,
+			map[string]schema.Attribute{
+				"timeouts": timeouts.AttributesAll(ctx),
+			},
+		),
+	}
+}
+
+func (r *securityGroupRuleResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	if req.Config.Raw.IsNull() {
+		return
+	}
+
+	var cfg securityGroupRuleResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &cfg)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if cfg.Protocol.IsUnknown() || cfg.RemoteIpPrefix.IsUnknown() || cfg.RemoteGroupId.IsUnknown() {
+		return
+	}
+
+	validateSecurityGroupRule(ctx, r, cfg.securityGroupRuleModel, &resp.Diagnostics)
+}
+
+func (r *securityGroupRuleResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan securityGroupRuleResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	timeout, diags := plan.Timeouts.Create(ctx, common.DefaultCreateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	sgId := plan.SecurityGroupId.ValueString()
+
+	mutex := common.LockForID(sgId)
+	mutex.Lock()
+	defer mutex.Unlock()
+
+	if ok := createSecurityGroupRule(ctx, r.kc, r, sgId, &plan.securityGroupRuleModel, &resp.Diagnostics); !ok {
+		return
+	}
+
+	ruleId := plan.Id.ValueString()
+	sg, ok := waitForSecurityGroupRules(ctx, r.kc, r, sgId, []string{ruleId}, nil, &resp.Diagnostics)
+	if !ok || resp.Diagnostics.HasError() {
+		return
+	}
+
+	rule := findSecurityGroupRule(sg, ruleId)
+	if rule == nil {
+		common.AddGeneralError(ctx, r, &resp.Diagnostics,
+			fmt.Sprintf("Security group rule %s was not found in security group %s after creation", ruleId, sgId))
+		return
+	}
+
+	mapSecurityGroupRuleResourceModel(&plan, sgId, rule)
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *securityGroupRuleResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state securityGroupRuleResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	timeout, diags := state.Timeouts.Read(ctx, common.DefaultReadTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	sgId := state.SecurityGroupId.ValueString()
+
+	respModel, httpResp, err := common.ExecuteWithRetryAndAuth(ctx, r.kc, &resp.Diagnostics, common.ServiceNetwork,
+		func() (*network.BnsNetworkV1ApiGetSecurityGroupModelResponseSecurityGroupModel, *http.Response, error) {
+			return r.kc.ApiClient.SecurityGroupAPI.
+				GetSecurityGroup(ctx, sgId).
+				XAuthToken(r.kc.XAuthToken).
+				Execute()
+		},
+	)
+	if httpResp != nil && httpResp.StatusCode == http.StatusNotFound {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		common.AddApiActionError(ctx, r, httpResp, "GetSecurityGroup", err, &resp.Diagnostics)
+		return
+	}
+
+	rule := findSecurityGroupRule(&respModel.SecurityGroup, state.Id.ValueString())
+	if rule == nil {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	mapSecurityGroupRuleResourceModel(&state, sgId, rule)
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *securityGroupRuleResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan securityGroupRuleResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.State.Set(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *securityGroupRuleResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state securityGroupRuleResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	timeout, diags := state.Timeouts.Delete(ctx, common.DefaultDeleteTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	sgId := state.SecurityGroupId.ValueString()
+	ruleId := state.Id.ValueString()
+
+	mutex := common.LockForID(sgId)
+	mutex.Lock()
+	defer mutex.Unlock()
+
+	httpResp, ok := deleteSecurityGroupRule(ctx, r.kc, r, sgId, ruleId, &resp.Diagnostics)
+	if !ok {
+		return
+	}
+	if httpResp != nil && httpResp.StatusCode == http.StatusNotFound {
+		return
+	}
+
+	waitForSecurityGroupRules(ctx, r.kc, r, sgId, nil, []string{ruleId}, &resp.Diagnostics)
+}
+
+func (r *securityGroupRuleResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	client, ok := req.ProviderData.(*common.KakaoCloudClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *common.KakaoCloudClient, got: %T.", req.ProviderData),
+		)
+		return
+	}
+	r.kc = client
+}
+
+func (r *securityGroupRuleResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	parts := strings.Split(req.ID, "/")
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		common.AddImportFormatError(ctx, r, &resp.Diagnostics,
+			"Expected import ID in the format: security_group_id/rule_id")
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("security_group_id"), parts[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), parts[1])...)
+}
@@ -4,9 +4,21 @@ package network
 
 import (
 	"context"
+	"fmt"
+	"net/http"
+	"strings"
+	"terraform-provider-kakaocloud/internal/common"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/kakaoenterprise/kc-sdk-go/services/network"
+)
+
+const (
+	securityGroupRulesSynced  = "synced"
+	securityGroupRulesPending = "pending"
 )
 
 func expandSecurityGroupRules(ctx context.Context, ruleList types.Set) ([]securityGroupRuleModel, diag.Diagnostics) {
@@ -18,3 +30,268 @@ func expandSecurityGroupRules(ctx context.Context, ruleList types.Set) ([]securi
 	}
 	return rules, diags
 }
+
+func validateSecurityGroupRule(ctx context.Context, obj interface{}, rule securityGroupRuleModel, diags *diag.Diagnostics) {
+	proto := strings.ToUpper(rule.Protocol.ValueString())
+
+	remoteIpSet := !rule.RemoteIpPrefix.IsNull()
+	remoteGroupSet := !rule.RemoteGroupId.IsNull()
+
+	onlyOneRemoteFieldExists := (remoteIpSet) != (remoteGroupSet)
+	if !onlyOneRemoteFieldExists {
+		common.AddValidationConfigError(ctx, obj, diags,
+			"Exactly one of remote_ip_prefix or remote_group_id must be provided (not both or neither)",
+		)
+		return
+	}
+
+	switch proto {
+	case string(network.SECURITYGROUPRULEPROTOCOL_TCP), string(network.SECURITYGROUPRULEPROTOCOL_UDP):
+		if rule.PortRangeMin.IsNull() {
+			common.AddValidationConfigError(ctx, obj, diags,
+				fmt.Sprintf("port_range_min is required when protocol is '%s'", proto),
+			)
+		}
+		if rule.PortRangeMax.IsNull() {
+			common.AddValidationConfigError(ctx, obj, diags,
+				fmt.Sprintf("port_range_max is required when protocol is '%s'", proto),
+			)
+		}
+		if rule.PortRangeMin.ValueInt32() > rule.PortRangeMax.ValueInt32() {
+			common.AddValidationConfigError(ctx, obj, diags,
+				fmt.Sprintf("port_range_max must be greater than or equal to port_range_min when protocol is '%s'", proto),
+			)
+		}
+	case string(network.SECURITYGROUPRULEPROTOCOL_ALL), string(network.SECURITYGROUPRULEPROTOCOL_ICMP):
+		if !rule.PortRangeMin.IsNull() || !rule.PortRangeMax.IsNull() {
+			common.AddValidationConfigError(ctx, obj, diags,
+				fmt.Sprintf("port_range_min and port_range_max must be null when protocol is '%s'", proto),
+			)
+		}
+	}
+}
+
+func createSecurityGroupRule(
+	ctx context.Context,
+	kc *common.KakaoCloudClient,
+	obj interface{},
+	sgId string,
+	rule *securityGroupRuleModel,
+	diags *diag.Diagnostics,
+) bool {
+	creq := network.CreateSecurityGroupRuleModel{
+		Direction: network.SecurityGroupRuleDirection(rule.Direction.ValueString()),
+		Protocol:  network.SecurityGroupRuleProtocol(rule.Protocol.ValueString()),
+	}
+	if !rule.Description.IsNull() && !rule.Description.IsUnknown() {
+		creq.SetDescription(rule.Description.ValueString())
+	}
+	if !rule.PortRangeMin.IsNull() && !rule.PortRangeMin.IsUnknown() {
+		creq.SetPortRangeMin(rule.PortRangeMin.ValueInt32())
+	}
+	if !rule.PortRangeMax.IsNull() && !rule.PortRangeMax.IsUnknown() {
+		creq.SetPortRangeMax(rule.PortRangeMax.ValueInt32())
+	}
+	if !rule.RemoteIpPrefix.IsNull() && !rule.RemoteIpPrefix.IsUnknown() {
+		creq.SetRemoteIpPrefix(rule.RemoteIpPrefix.ValueString())
+	}
+	if !rule.RemoteGroupId.IsNull() && !rule.RemoteGroupId.IsUnknown() {
+		creq.SetRemoteGroupId(rule.RemoteGroupId.ValueString())
+	}
+
+	crResp, httpResp, err := common.ExecuteWithRetryAndAuth(ctx, kc, diags, common.ServiceNetwork,
+		func() (*network.ResponseSecurityGroupRuleModel, *http.Response, error) {
+			return kc.ApiClient.SecurityGroupAPI.
+				CreateSecurityGroupRule(ctx, sgId).
+				XAuthToken(kc.XAuthToken).
+				BodyCreateSecurityGroupRule(network.BodyCreateSecurityGroupRule{SecurityGroupRule: creq}).
+				Execute()
+		},
+	)
+	if err != nil {
+		common.AddApiActionError(ctx, obj, httpResp, "CreateSecurityGroupRule", err, diags)
+		return false
+	}
+	rule.Id = types.StringValue(crResp.SecurityGroupRule.Id)
+	return true
+}
+
+func deleteSecurityGroupRule(
+	ctx context.Context,
+	kc *common.KakaoCloudClient,
+	obj interface{},
+	sgId string,
+	ruleId string,
+	diags *diag.Diagnostics,
+) (*http.Response, bool) {
+	_, httpResp, err := common.ExecuteWithRetryAndAuth(ctx, kc, diags, common.ServiceNetwork,
+		func() (interface{}, *http.Response, error) {
+			httpResp, err := kc.ApiClient.SecurityGroupAPI.
+				DeleteSecurityGroupRule(ctx, sgId, ruleId).
+				XAuthToken(kc.XAuthToken).
+				Execute()
+			return nil, httpResp, err
+		},
+	)
+	if err != nil {
+		if httpResp != nil && httpResp.StatusCode == http.StatusNotFound {
+			return httpResp, true
+		}
+		common.AddApiActionError(ctx, obj, httpResp, "DeleteSecurityGroupRule", err, diags)
+		return httpResp, false
+	}
+	return httpResp, true
+}
+
+func waitForSecurityGroupRules(
+	ctx context.Context,
+	kc *common.KakaoCloudClient,
+	obj interface{},
+	sgId string,
+	presentIds []string,
+	absentIds []string,
+	diags *diag.Diagnostics,
+) (*network.BnsNetworkV1ApiGetSecurityGroupModelSecurityGroupModel, bool) {
+	return common.PollUntilResult(
+		ctx,
+		obj,
+		3*time.Second,
+		"security group",
+		sgId,
+		[]string{securityGroupRulesSynced},
+		diags,
+		func(ctx context.Context) (*network.BnsNetworkV1ApiGetSecurityGroupModelSecurityGroupModel, *http.Response, error) {
+			respModel, httpResp, err := common.ExecuteWithRetryAndAuth(ctx, kc, diags, common.ServiceNetwork,
+				func() (*network.BnsNetworkV1ApiGetSecurityGroupModelResponseSecurityGroupModel, *http.Response, error) {
+					return kc.ApiClient.SecurityGroupAPI.
+						GetSecurityGroup(ctx, sgId).
+						XAuthToken(kc.XAuthToken).
+						Execute()
+				},
+			)
+			if err != nil {
+				return nil, httpResp, err
+			}
+			return &respModel.SecurityGroup, httpResp, nil
+		},
+		func(sg *network.BnsNetworkV1ApiGetSecurityGroupModelSecurityGroupModel) string {
+			existing := make(map[string]struct{}, len(sg.Rules))
+			for _, rule := range sg.Rules {
+				existing[rule.Id] = struct{}{}
+			}
+			for _, id := range presentIds {
+				if _, ok := existing[id]; !ok {
+					return securityGroupRulesPending
+				}
+			}
+			for _, id := range absentIds {
+				if _, ok := existing[id]; ok {
+					return securityGroupRulesPending
+				}
+			}
+			return securityGroupRulesSynced
+		},
+	)
+}
+
+func findSecurityGroupRule(
+	sg *network.BnsNetworkV1ApiGetSecurityGroupModelSecurityGroupModel,
+	ruleId string,
+) *network.BnsNetworkV1ApiGetSecurityGroupModelSecurityGroupRuleModel {
+	for i := range sg.Rules {
+		if sg.Rules[i].Id == ruleId {
+			return &sg.Rules[i]
+		}
+	}
+	return nil
+}
+
+func filterManagedSecurityGroupRules(
+	ctx context.Context,
+	rules types.Set,
+	managedIds []string,
+	diags *diag.Diagnostics,
+) types.Set {
+	ruleType := types.ObjectType{AttrTypes: securityGroupRuleAttrType}
+
+	all, d := expandSecurityGroupRules(ctx, rules)
+	diags.Append(d...)
+	if diags.HasError() {
+		return types.SetNull(ruleType)
+	}
+
+	managed := make(map[string]struct{}, len(managedIds))
+	for _, id := range managedIds {
+		managed[id] = struct{}{}
+	}
+
+	var filtered []securityGroupRuleModel
+	for _, rule := range all {
+		if _, ok := managed[rule.Id.ValueString()]; ok {
+			filtered = append(filtered, rule)
+		}
+	}
+
+	if len(filtered) == 0 {
+		return types.SetNull(ruleType)
+	}
+
+	result, d := types.SetValueFrom(ctx, ruleType, filtered)
+	diags.Append(d...)
+	return result
+}
+
+// adoptSecurityGroupRules gives each planned rule without an ID the ID of an unclaimed existing
+// rule with the same settings, so that rules already in the group are kept instead of being
+// created again. This is what lets an imported group take over its rules.
+func adoptSecurityGroupRules(planned []securityGroupRuleModel, existing []securityGroupRuleModel) {
+	claimed := make(map[string]struct{}, len(existing))
+	for _, rule := range planned {
+		if !rule.Id.IsNull() && !rule.Id.IsUnknown() {
+			claimed[rule.Id.ValueString()] = struct{}{}
+		}
+	}
+
+	for i := range planned {
+		if !planned[i].Id.IsNull() && !planned[i].Id.IsUnknown() {
+			continue
+		}
+		for _, rule := range existing {
+			if _, ok := claimed[rule.Id.ValueString()]; ok {
+				continue
+			}
+			if securityGroupRuleMatches(planned[i], rule) {
+				planned[i].Id = rule.Id
+				claimed[rule.Id.ValueString()] = struct{}{}
+				break
+			}
+		}
+	}
+}
+
+// securityGroupRuleMatches reports whether an existing rule has the settings of a planned one.
+// Settings left unknown in the plan are computed by the API and match any value.
+func securityGroupRuleMatches(planned securityGroupRuleModel, existing securityGroupRuleModel) bool {
+	return strings.EqualFold(planned.Direction.ValueString(), existing.Direction.ValueString()) &&
+		strings.EqualFold(planned.Protocol.ValueString(), existing.Protocol.ValueString()) &&
+		securityGroupRuleValueMatches(planned.PortRangeMin, existing.PortRangeMin) &&
+		securityGroupRuleValueMatches(planned.PortRangeMax, existing.PortRangeMax) &&
+		securityGroupRuleValueMatches(planned.RemoteIpPrefix, existing.RemoteIpPrefix) &&
+		securityGroupRuleValueMatches(planned.RemoteGroupId, existing.RemoteGroupId) &&
+		securityGroupRuleValueMatches(planned.Description, existing.Description)
+}
+
+func securityGroupRuleValueMatches(planned attr.Value, existing attr.Value) bool {
+	return planned.IsUnknown() || planned.Equal(existing)
+}
+
+func securityGroupRuleIds(rules []securityGroupRuleModel) []string {
+	ids := make([]string, 0, len(rules))
+	for _, rule := range rules {
+		if rule.Id.IsNull() || rule.Id.IsUnknown() {
+			continue
+		}
+		ids = append(ids, rule.Id.ValueString())
+	}
+	return ids
+}
@@ -0,0 +1,166 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+package network
+
+import (
+	"context"
+	"reflect"
+	"sort"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework-nettypes/cidrtypes"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func testSecurityGroupRule(id string, protocol string, portMin int32, portMax int32, cidr string) securityGroupRuleModel {
+	rule := securityGroupRuleModel{
+		Id:              types.StringValue(id),
+		Direction:       types.StringValue("ingress"),
+		Protocol:        types.StringValue(protocol),
+		PortRangeMin:    types.Int32Null(),
+		PortRangeMax:    types.Int32Null(),
+		RemoteIpPrefix:  cidrtypes.NewIPPrefixValue(cidr),
+		RemoteGroupId:   types.StringNull(),
+		RemoteGroupName: types.StringNull(),
+		Description:     types.StringNull(),
+		CreatedAt:       types.StringNull(),
+		UpdatedAt:       types.StringNull(),
+	}
+	if id == "" {
+		rule.Id = types.StringUnknown()
+	}
+	if portMin != 0 {
+		rule.PortRangeMin = types.Int32Value(portMin)
+	}
+	if portMax != 0 {
+		rule.PortRangeMax = types.Int32Value(portMax)
+	}
+	return rule
+}
+
+func TestValidateSecurityGroupRule(t *testing.T) {
+	withRemoteGroup := testSecurityGroupRule("", "TCP", 22, 22, "10.0.0.0/8")
+	withRemoteGroup.RemoteGroupId = types.StringValue("5e4c3b2a-1f0e-4d9c-8b7a-6f5e4d3c2b1a")
+	remoteGroupOnly := withRemoteGroup
+	remoteGroupOnly.RemoteIpPrefix = cidrtypes.NewIPPrefixNull()
+	noRemote := remoteGroupOnly
+	noRemote.RemoteGroupId = types.StringNull()
+
+	cases := []struct {
+		name    string
+		rule    securityGroupRuleModel
+		wantErr bool
+	}{
+		{"tcp port range", testSecurityGroupRule("", "TCP", 80, 443, "0.0.0.0/0"), false},
+		{"lowercase udp", testSecurityGroupRule("", "udp", 53, 53, "0.0.0.0/0"), false},
+		{"remote group only", remoteGroupOnly, false},
+		{"icmp without ports", testSecurityGroupRule("", "ICMP", 0, 0, "0.0.0.0/0"), false},
+		{"both remotes", withRemoteGroup, true},
+		{"no remote", noRemote, true},
+		{"tcp without port_range_min", testSecurityGroupRule("", "TCP", 0, 443, "0.0.0.0/0"), true},
+		{"tcp without port_range_max", testSecurityGroupRule("", "TCP", 80, 0, "0.0.0.0/0"), true},
+		{"reversed port range", testSecurityGroupRule("", "TCP", 443, 80, "0.0.0.0/0"), true},
+		{"all with ports", testSecurityGroupRule("", "ALL", 1, 65535, "0.0.0.0/0"), true},
+		{"icmp with a port", testSecurityGroupRule("", "ICMP", 8, 0, "0.0.0.0/0"), true},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			var diags diag.Diagnostics
+			validateSecurityGroupRule(context.Background(), &securityGroupResource{}, tc.rule, &diags)
+			if diags.HasError() != tc.wantErr {
+				t.Fatalf("HasError() = %v, want %v: %v", diags.HasError(), tc.wantErr, diags)
+			}
+		})
+	}
+}
+
+func TestFilterManagedSecurityGroupRules(t *testing.T) {
+	ctx := context.Background()
+	ruleType := types.ObjectType{AttrTypes: securityGroupRuleAttrType}
+	all := []securityGroupRuleModel{
+		testSecurityGroupRule("rule-ssh", "TCP", 22, 22, "10.0.0.0/8"),
+		testSecurityGroupRule("rule-https", "TCP", 443, 443, "0.0.0.0/0"),
+		testSecurityGroupRule("rule-icmp", "ICMP", 0, 0, "0.0.0.0/0"),
+	}
+	rules, d := types.SetValueFrom(ctx, ruleType, all)
+	if d.HasError() {
+		t.Fatal(d)
+	}
+
+	cases := []struct {
+		name    string
+		rules   types.Set
+		managed []string
+		want    []string
+	}{
+		{"some managed", rules, []string{"rule-ssh", "rule-icmp"}, []string{"rule-icmp", "rule-ssh"}},
+		{"all managed", rules, []string{"rule-ssh", "rule-https", "rule-icmp"}, []string{"rule-https", "rule-icmp", "rule-ssh"}},
+		{"managed rule gone", rules, []string{"rule-https", "rule-deleted"}, []string{"rule-https"}},
+		{"none managed", rules, nil, nil},
+		{"no rules", types.SetNull(ruleType), []string{"rule-ssh"}, nil},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			var diags diag.Diagnostics
+			got := filterManagedSecurityGroupRules(ctx, tc.rules, tc.managed, &diags)
+			if diags.HasError() {
+				t.Fatal(diags)
+			}
+			if tc.want == nil {
+				if !got.IsNull() {
+					t.Fatalf("got %v, want a null set", got)
+				}
+				return
+			}
+			filtered, d := expandSecurityGroupRules(ctx, got)
+			if d.HasError() {
+				t.Fatal(d)
+			}
+			ids := securityGroupRuleIds(filtered)
+			sort.Strings(ids)
+			if !reflect.DeepEqual(ids, tc.want) {
+				t.Fatalf("got %v, want %v", ids, tc.want)
+			}
+		})
+	}
+}
+
+func TestAdoptSecurityGroupRules(t *testing.T) {
+	existing := []securityGroupRuleModel{
+		testSecurityGroupRule("rule-ssh", "TCP", 22, 22, "10.0.0.0/8"),
+		testSecurityGroupRule("rule-https", "TCP", 443, 443, "0.0.0.0/0"),
+		testSecurityGroupRule("rule-https-copy", "TCP", 443, 443, "0.0.0.0/0"),
+		testSecurityGroupRule("rule-icmp", "ICMP", 0, 0, "0.0.0.0/0"),
+	}
+
+	icmp := testSecurityGroupRule("", "icmp", 0, 0, "0.0.0.0/0")
+	icmp.PortRangeMin = types.Int32Unknown()
+	icmp.PortRangeMax = types.Int32Unknown()
+	icmp.Description = types.StringUnknown()
+	described := testSecurityGroupRule("", "TCP", 22, 22, "10.0.0.0/8")
+	described.Description = types.StringValue("ssh")
+
+	planned := []securityGroupRuleModel{
+		testSecurityGroupRule("", "TCP", 443, 443, "0.0.0.0/0"),
+		testSecurityGroupRule("", "TCP", 443, 443, "0.0.0.0/0"),
+		testSecurityGroupRule("", "TCP", 443, 443, "0.0.0.0/0"),
+		icmp,
+		described,
+		testSecurityGroupRule("", "UDP", 53, 53, "0.0.0.0/0"),
+	}
+	adoptSecurityGroupRules(planned, existing)
+
+	want := []string{"rule-https", "rule-https-copy", "", "rule-icmp", "", ""}
+	for i, rule := range planned {
+		got := ""
+		if !rule.Id.IsUnknown() {
+			got = rule.Id.ValueString()
+		}
+		if got != want[i] {
+			t.Errorf("planned rule %d adopted %q, want %q", i, got, want[i])
+		}
+	}
+}
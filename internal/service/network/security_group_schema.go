@@ -11,7 +11,9 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	dschema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
 	rschema "github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int32planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
@@ -116,6 +118,11 @@ func getSecurityGroupResourceSchema() map[string]rschema.Attribute {
 				boolplanmodifier.UseStateForUnknown(),
 			},
 		},
+		"ignore_unmanaged_rules": rschema.BoolAttribute{
+			Optional: true,
+			Computed: true,
+			Default:  booldefault.StaticBool(false),
+		},
 		"rules": rschema.SetNestedAttribute{
 			Optional: true,
 			Validators: []validator.Set{
@@ -137,6 +144,117 @@ func getSecurityGroupResourceSchema() map[string]rschema.Attribute {
 	}
 }
 
+func getSecurityGroupRuleResourceSchema() map[string]rschema.Attribute {
+	return map[string]rschema.Attribute{
+		"id": rschema.StringAttribute{
+			Computed: true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		},
+		"security_group_id": rschema.StringAttribute{
+			Required:   true,
+			Validators: common.UuidValidator(),
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.RequiresReplace(),
+			},
+		},
+		"direction": rschema.StringAttribute{
+			Required: true,
+			Validators: []validator.String{
+				stringvalidator.OneOf(
+					string(network.SECURITYGROUPRULEDIRECTION_INGRESS),
+					string(network.SECURITYGROUPRULEDIRECTION_EGRESS),
+				),
+			},
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.RequiresReplace(),
+			},
+		},
+		"protocol": rschema.StringAttribute{
+			Required: true,
+			Validators: []validator.String{
+				stringvalidator.OneOf(
+					string(network.SECURITYGROUPRULEPROTOCOL_TCP),
+					string(network.SECURITYGROUPRULEPROTOCOL_UDP),
+					string(network.SECURITYGROUPRULEPROTOCOL_ICMP),
+					string(network.SECURITYGROUPRULEPROTOCOL_ALL),
+				),
+			},
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.RequiresReplace(),
+			},
+		},
+		"port_range_min": rschema.Int32Attribute{
+			Optional: true,
+			Computed: true,
+			Validators: []validator.Int32{
+				int32validator.Between(1, 65535),
+			},
+			PlanModifiers: []planmodifier.Int32{
+				int32planmodifier.RequiresReplaceIfConfigured(),
+				int32planmodifier.UseStateForUnknown(),
+			},
+		},
+		"port_range_max": rschema.Int32Attribute{
+			Optional: true,
+			Computed: true,
+			Validators: []validator.Int32{
+				int32validator.Between(1, 65535),
+			},
+			PlanModifiers: []planmodifier.Int32{
+				int32planmodifier.RequiresReplaceIfConfigured(),
+				int32planmodifier.UseStateForUnknown(),
+			},
+		},
+		"remote_ip_prefix": rschema.StringAttribute{
+			Optional:   true,
+			Computed:   true,
+			CustomType: cidrtypes.IPPrefixType{},
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.RequiresReplaceIfConfigured(),
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		},
+		"remote_group_id": rschema.StringAttribute{
+			Optional:   true,
+			Computed:   true,
+			Validators: common.UuidValidator(),
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.RequiresReplaceIfConfigured(),
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		},
+		"remote_group_name": rschema.StringAttribute{
+			Computed: true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		},
+		"description": rschema.StringAttribute{
+			Optional:   true,
+			Computed:   true,
+			Validators: common.DescriptionValidatorWithMaxLength(50),
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.RequiresReplaceIfConfigured(),
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		},
+		"created_at": rschema.StringAttribute{
+			Computed: true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		},
+		"updated_at": rschema.StringAttribute{
+			Computed: true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		},
+	}
+}
+
 func getSecurityGroupRuleDataSourceSchema() map[string]dschema.Attribute {
 	return map[string]dschema.Attribute{
 		"id": dschema.StringAttribute{
@@ -210,6 +328,7 @@ func getSecurityGroupDataSourceSchema() map[string]dschema.Attribute {
 
 var securityGroupResourceSchemaAttributes = getSecurityGroupResourceSchema()
 var securityGroupRuleInlineResourceSchema = getSecurityGroupRuleInlineResourceSchema()
+var securityGroupRuleResourceSchemaAttributes = getSecurityGroupRuleResourceSchema()
 
 var securityGroupDataSourceSchemaAttributes = getSecurityGroupDataSourceSchema()
 var securityGroupRuleDataSourceSchema = getSecurityGroupRuleDataSourceSchema()
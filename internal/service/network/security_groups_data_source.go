@@ -138,9 +138,12 @@ func (d *securityGroupsDataSource) Read(ctx context.Context, req datasource.Read
 		return
 	}
 
-	sgResp, httpResp, err := common.ExecuteWithRetryAndAuth(ctx, d.kc, &resp.Diagnostics,
-		func() (*network.SecurityGroupListModel, *http.Response, error) {
-			return sgApi.Limit(1000).XAuthToken(d.kc.XAuthToken).Execute()
+	sgPages, httpResp, err := common.ListAllPagesPartial(ctx, d.kc, d, &resp.Diagnostics, common.ServiceNetwork,
+		func(limit int32, offset int32) (*network.SecurityGroupListModel, *http.Response, error) {
+			return sgApi.Limit(limit).Offset(offset).XAuthToken(d.kc.XAuthToken).Execute()
+		},
+		func(page *network.SecurityGroupListModel) int {
+			return len(page.SecurityGroups)
 		},
 	)
 	if err != nil {
@@ -149,13 +152,15 @@ func (d *securityGroupsDataSource) Read(ctx context.Context, req datasource.Read
 	}
 
 	var resultSgs []securityGroupBaseModel
-	for i := range sgResp.SecurityGroups {
-		var base securityGroupBaseModel
-		mapSecurityGroupBaseModelFromList(ctx, &base, &sgResp.SecurityGroups[i], &resp.Diagnostics)
-		if resp.Diagnostics.HasError() {
-			return
+	for _, sgResp := range sgPages {
+		for i := range sgResp.SecurityGroups {
+			var base securityGroupBaseModel
+			mapSecurityGroupBaseModelFromList(ctx, &base, &sgResp.SecurityGroups[i], &resp.Diagnostics)
+			if resp.Diagnostics.HasError() {
+				return
+			}
+			resultSgs = append(resultSgs, base)
 		}
-		resultSgs = append(resultSgs, base)
 	}
 
 	config.SecurityGroups = resultSgs
@@ -14,6 +14,7 @@ type transitGatewayAttachmentApprovalResourceModel struct {
 	VpcId              types.String   `tfsdk:"vpc_id"`
 	ProvisioningStatus types.String   `tfsdk:"provisioning_status"`
 	TgwProjectId       types.String   `tfsdk:"tgw_project_id"`
+	ApproverProjectId  types.String   `tfsdk:"approver_project_id"`
 	VpcName            types.String   `tfsdk:"vpc_name"`
 	CidrBlock          types.String   `tfsdk:"cidr_block"`
 	ProjectId          types.String   `tfsdk:"project_id"`
@@ -6,6 +6,7 @@ import (
 	"context"
 	"fmt"
 	"net/http"
+	"strings"
 	"terraform-provider-kakaocloud/internal/common"
 	"terraform-provider-kakaocloud/internal/utils"
 	"time"
@@ -54,6 +55,8 @@ func (r *transitGatewayAttachmentApprovalResource) Create(ctx context.Context, r
 		return
 	}
 
+	kc := r.kc.ForProject(plan.ApproverProjectId.ValueString())
+
 	timeout, diags := plan.Timeouts.Create(ctx, common.DefaultCreateTimeout)
 
 	resp.Diagnostics.Append(diags...)
@@ -66,10 +69,10 @@ func (r *transitGatewayAttachmentApprovalResource) Create(ctx context.Context, r
 
 	attachmentId := plan.AttachmentId.ValueString()
 
-	approveResp, httpResp, err := common.ExecuteWithRetryAndAuth(ctx, r.kc, &resp.Diagnostics,
+	approveResp, httpResp, err := common.ExecuteWithRetryAndAuth(ctx, kc, &resp.Diagnostics, common.ServiceTGW,
 		func() (*tgw.BnsTgwV1ApiApproveTgwAttachmentModelCreateTgwAttachmentResponseModel, *http.Response, error) {
-			return r.kc.ApiClient.AttachmentsAPI.ApproveTgwAttachment(ctx, attachmentId).
-				XAuthToken(r.kc.XAuthToken).
+			return kc.ApiClient.AttachmentsAPI.ApproveTgwAttachment(ctx, attachmentId).
+				XAuthToken(kc.XAuthToken).
 				Execute()
 		},
 	)
@@ -83,10 +86,10 @@ func (r *transitGatewayAttachmentApprovalResource) Create(ctx context.Context, r
 	result, ok := common.PollUntilResult(
 		ctx, r, 10*time.Second, "transit gateway route", attachmentId, []string{common.TgwStatusActive, common.TgwStatusError, common.TgwStatusInUse, common.TgwStatusInactive, common.TgwStatusAvaliable}, &resp.Diagnostics,
 		func(ctx context.Context) (*tgw.BnsTgwV1ApiGetTgwAttachmentModelTgwAttachmentResponseModel, *http.Response, error) {
-			attachResp, httpResp, err := common.ExecuteWithRetryAndAuth(ctx, r.kc, &resp.Diagnostics,
+			attachResp, httpResp, err := common.ExecuteWithRetryAndAuth(ctx, kc, &resp.Diagnostics, common.ServiceTGW,
 				func() (*tgw.GetTgwAttachmentResponseModel, *http.Response, error) {
-					return r.kc.ApiClient.AttachmentsAPI.GetTgwAttachment(ctx, attachmentId).
-						XAuthToken(r.kc.XAuthToken).
+					return kc.ApiClient.AttachmentsAPI.GetTgwAttachment(ctx, attachmentId).
+						XAuthToken(kc.XAuthToken).
 						Execute()
 				},
 			)
@@ -134,6 +137,8 @@ func (r *transitGatewayAttachmentApprovalResource) Read(ctx context.Context, req
 		return
 	}
 
+	kc := r.kc.ForProject(state.ApproverProjectId.ValueString())
+
 	timeout, diags := state.Timeouts.Read(ctx, common.DefaultReadTimeout)
 
 	resp.Diagnostics.Append(diags...)
@@ -144,10 +149,10 @@ func (r *transitGatewayAttachmentApprovalResource) Read(ctx context.Context, req
 	ctx, cancel := context.WithTimeout(ctx, timeout)
 	defer cancel()
 
-	respModel, httpResp, err := common.ExecuteWithRetryAndAuth(ctx, r.kc, &resp.Diagnostics,
+	respModel, httpResp, err := common.ExecuteWithRetryAndAuth(ctx, kc, &resp.Diagnostics, common.ServiceTGW,
 		func() (*tgw.GetTgwAttachmentResponseModel, *http.Response, error) {
-			return r.kc.ApiClient.AttachmentsAPI.GetTgwAttachment(ctx, state.AttachmentId.ValueString()).
-				XAuthToken(r.kc.XAuthToken).Execute()
+			return kc.ApiClient.AttachmentsAPI.GetTgwAttachment(ctx, state.AttachmentId.ValueString()).
+				XAuthToken(kc.XAuthToken).Execute()
 		},
 	)
 
@@ -208,6 +213,16 @@ func (r *transitGatewayAttachmentApprovalResource) Configure(_ context.Context,
 }
 
 func (r *transitGatewayAttachmentApprovalResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("attachment_id"), req.ID)...)
+	parts := strings.Split(req.ID, "/")
+	if len(parts) != 1 && len(parts) != 2 {
+		common.AddImportFormatError(ctx, r, &resp.Diagnostics,
+			"Expected import ID in the format: attachment_id or attachment_id/approver_project_id")
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), parts[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("attachment_id"), parts[0])...)
+	if len(parts) == 2 {
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("approver_project_id"), parts[1])...)
+	}
 }
@@ -36,6 +36,13 @@ var transitGatewayAttachmentApprovalResourceSchemaAttributes = map[string]rschem
 	"tgw_project_id": rschema.StringAttribute{
 		Computed: true,
 	},
+	"approver_project_id": rschema.StringAttribute{
+		Optional:   true,
+		Validators: common.UuidNoHyphenValidator(),
+		PlanModifiers: []planmodifier.String{
+			stringplanmodifier.RequiresReplace(),
+		},
+	},
 	"vpc_name": rschema.StringAttribute{
 		Computed: true,
 	},
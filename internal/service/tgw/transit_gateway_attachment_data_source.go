@@ -66,7 +66,7 @@ func (d *transitGatewayAttachmentDataSource) Read(ctx context.Context, req datas
 	ctx, cancel := context.WithTimeout(ctx, timeout)
 	defer cancel()
 
-	attachResp, httpResp, err := common.ExecuteWithRetryAndAuth(ctx, d.kc, &resp.Diagnostics,
+	attachResp, httpResp, err := common.ExecuteWithRetryAndAuth(ctx, d.kc, &resp.Diagnostics, common.ServiceTGW,
 		func() (*tgw.GetTgwAttachmentResponseModel, *http.Response, error) {
 			return d.kc.ApiClient.AttachmentsAPI.GetTgwAttachment(ctx, config.Id.ValueString()).
 				XAuthToken(d.kc.XAuthToken).Execute()
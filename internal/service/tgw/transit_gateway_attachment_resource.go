@@ -89,7 +89,7 @@ func (r *transitGatewayAttachmentResource) Create(ctx context.Context, req resou
 		Attachment: createReq,
 	}
 
-	respModel, httpResp, err := common.ExecuteWithRetryAndAuth(ctx, r.kc, &resp.Diagnostics,
+	respModel, httpResp, err := common.ExecuteWithRetryAndAuth(ctx, r.kc, &resp.Diagnostics, common.ServiceTGW,
 		func() (*tgw.BnsTgwV1ApiCreateTgwAttachmentModelCreateTgwAttachmentResponseModel, *http.Response, error) {
 			return r.kc.ApiClient.AttachmentsAPI.CreateTgwAttachment(ctx).
 				XAuthToken(r.kc.XAuthToken).
@@ -107,7 +107,7 @@ func (r *transitGatewayAttachmentResource) Create(ctx context.Context, req resou
 	result, ok := common.PollUntilResult(
 		ctx, r, 5*time.Second, "transit gateway attachment", plan.Id.ValueString(), []string{common.TgwStatusActive, common.TgwStatusError, common.TgwStatusInUse, common.TgwStatusInactive, common.TgwStatusAvaliable, common.TgwStatusPendingApprove}, &resp.Diagnostics,
 		func(ctx context.Context) (*tgw.BnsTgwV1ApiGetTgwAttachmentModelTgwAttachmentResponseModel, *http.Response, error) {
-			respModel, httpResp, err := common.ExecuteWithRetryAndAuth(ctx, r.kc, &resp.Diagnostics,
+			respModel, httpResp, err := common.ExecuteWithRetryAndAuth(ctx, r.kc, &resp.Diagnostics, common.ServiceTGW,
 				func() (*tgw.GetTgwAttachmentResponseModel, *http.Response, error) {
 					return r.kc.ApiClient.AttachmentsAPI.
 						GetTgwAttachment(ctx, plan.Id.ValueString()).
@@ -178,7 +178,7 @@ func (r *transitGatewayAttachmentResource) Read(ctx context.Context, req resourc
 	ctx, cancel := context.WithTimeout(ctx, timeout)
 	defer cancel()
 
-	respModel, httpResp, err := common.ExecuteWithRetryAndAuth(ctx, r.kc, &resp.Diagnostics,
+	respModel, httpResp, err := common.ExecuteWithRetryAndAuth(ctx, r.kc, &resp.Diagnostics, common.ServiceTGW,
 		func() (*tgw.GetTgwAttachmentResponseModel, *http.Response, error) {
 			return r.kc.ApiClient.AttachmentsAPI.GetTgwAttachment(ctx, state.Id.ValueString()).
 				XAuthToken(r.kc.XAuthToken).
@@ -271,7 +271,7 @@ func (r *transitGatewayAttachmentResource) Update(ctx context.Context, req resou
 			Attachment: updateReq,
 		}
 
-		_, httpResp, err := common.ExecuteWithRetryAndAuth(ctx, r.kc, &resp.Diagnostics,
+		_, httpResp, err := common.ExecuteWithRetryAndAuth(ctx, r.kc, &resp.Diagnostics, common.ServiceTGW,
 			func() (*tgw.BnsTgwV1ApiUpdateTgwAttachmentModelCreateTgwAttachmentResponseModel, *http.Response, error) {
 				return r.kc.ApiClient.AttachmentsAPI.UpdateTgwAttachment(ctx, plan.Id.ValueString()).
 					XAuthToken(r.kc.XAuthToken).
@@ -289,7 +289,7 @@ func (r *transitGatewayAttachmentResource) Update(ctx context.Context, req resou
 		result, ok := common.PollUntilResult(
 			ctx, r, 5*time.Second, "transit gateway attachment", plan.Id.ValueString(), []string{common.TgwStatusActive, common.TgwStatusError, common.TgwStatusInUse, common.TgwStatusInactive, common.TgwStatusAvaliable, common.TgwStatusPendingApprove}, &resp.Diagnostics,
 			func(ctx context.Context) (*tgw.BnsTgwV1ApiGetTgwAttachmentModelTgwAttachmentResponseModel, *http.Response, error) {
-				respModel, httpResp, err := common.ExecuteWithRetryAndAuth(ctx, r.kc, &resp.Diagnostics,
+				respModel, httpResp, err := common.ExecuteWithRetryAndAuth(ctx, r.kc, &resp.Diagnostics, common.ServiceTGW,
 					func() (*tgw.GetTgwAttachmentResponseModel, *http.Response, error) {
 						return r.kc.ApiClient.AttachmentsAPI.
 							GetTgwAttachment(ctx, plan.Id.ValueString()).
@@ -369,7 +369,7 @@ func (r *transitGatewayAttachmentResource) Delete(ctx context.Context, req resou
 		return
 	}
 
-	_, httpResp, err := common.ExecuteWithRetryAndAuth(ctx, r.kc, &resp.Diagnostics,
+	_, httpResp, err := common.ExecuteWithRetryAndAuth(ctx, r.kc, &resp.Diagnostics, common.ServiceTGW,
 		func() (interface{}, *http.Response, error) {
 			httpResp, err := r.kc.ApiClient.AttachmentsAPI.DeleteTgwAttachment(ctx, state.Id.ValueString()).
 				XAuthToken(r.kc.XAuthToken).
@@ -386,7 +386,7 @@ func (r *transitGatewayAttachmentResource) Delete(ctx context.Context, req resou
 	}
 
 	common.PollUntilDeletion(ctx, r, 10*time.Second, &resp.Diagnostics, func(ctx context.Context) (bool, *http.Response, error) {
-		_, httpResp, err := common.ExecuteWithRetryAndAuth(ctx, r.kc, &resp.Diagnostics,
+		_, httpResp, err := common.ExecuteWithRetryAndAuth(ctx, r.kc, &resp.Diagnostics, common.ServiceTGW,
 			func() (interface{}, *http.Response, error) {
 				_, httpResp, err := r.kc.ApiClient.AttachmentsAPI.
 					GetTgwAttachment(ctx, state.Id.ValueString()).
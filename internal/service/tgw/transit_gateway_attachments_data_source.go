@@ -152,9 +152,12 @@ func (d *transitGatewayAttachmentsDataSource) Read(ctx context.Context, req data
 		return
 	}
 
-	attachResp, httpResp, err := common.ExecuteWithRetryAndAuth(ctx, d.kc, &resp.Diagnostics,
-		func() (*tgw.GetTgwAttachmentsResponseModel, *http.Response, error) {
-			return attachmentApi.Limit(1000).XAuthToken(d.kc.XAuthToken).Execute()
+	attachPages, httpResp, err := common.ListAllPagesPartial(ctx, d.kc, d, &resp.Diagnostics, common.ServiceTGW,
+		func(limit int32, offset int32) (*tgw.GetTgwAttachmentsResponseModel, *http.Response, error) {
+			return attachmentApi.Limit(limit).Offset(offset).XAuthToken(d.kc.XAuthToken).Execute()
+		},
+		func(page *tgw.GetTgwAttachmentsResponseModel) int {
+			return len(page.Attachments)
 		},
 	)
 	if err != nil {
@@ -163,13 +166,15 @@ func (d *transitGatewayAttachmentsDataSource) Read(ctx context.Context, req data
 	}
 
 	config.TransitGatewayAttachments = make([]transitGatewayAttachmentBaseModel, 0)
-	for _, item := range attachResp.Attachments {
-		var attachModel transitGatewayAttachmentBaseModel
-		ok := mapTransitGatewayAttachmentModelFromList(ctx, &attachModel, &item, &resp.Diagnostics)
-		if !ok || resp.Diagnostics.HasError() {
-			return
+	for _, attachResp := range attachPages {
+		for _, item := range attachResp.Attachments {
+			var attachModel transitGatewayAttachmentBaseModel
+			ok := mapTransitGatewayAttachmentModelFromList(ctx, &attachModel, &item, &resp.Diagnostics)
+			if !ok || resp.Diagnostics.HasError() {
+				return
+			}
+			config.TransitGatewayAttachments = append(config.TransitGatewayAttachments, attachModel)
 		}
-		config.TransitGatewayAttachments = append(config.TransitGatewayAttachments, attachModel)
 	}
 
 	diags = resp.State.Set(ctx, &config)
@@ -66,7 +66,7 @@ func (d *transitGatewayDataSource) Read(ctx context.Context, req datasource.Read
 	ctx, cancel := context.WithTimeout(ctx, timeout)
 	defer cancel()
 
-	tgwResp, httpResp, err := common.ExecuteWithRetryAndAuth(ctx, d.kc, &resp.Diagnostics,
+	tgwResp, httpResp, err := common.ExecuteWithRetryAndAuth(ctx, d.kc, &resp.Diagnostics, common.ServiceTGW,
 		func() (*tgw.GetTgwResponseModel, *http.Response, error) {
 			return d.kc.ApiClient.TgwsAPI.GetTransitGateway(ctx, config.Id.ValueString()).
 				XAuthToken(d.kc.XAuthToken).Execute()
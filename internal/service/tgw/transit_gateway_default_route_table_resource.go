@@ -86,7 +86,7 @@ func (r *transitGatewayDefaultRouteTableResource) Create(ctx context.Context, re
 	ctx, cancel := context.WithTimeout(ctx, timeout)
 	defer cancel()
 
-	respModel, httpResp, err := common.ExecuteWithRetryAndAuth(ctx, r.kc, &resp.Diagnostics,
+	respModel, httpResp, err := common.ExecuteWithRetryAndAuth(ctx, r.kc, &resp.Diagnostics, common.ServiceTGW,
 		func() (*tgw.GetTgwResponseModel, *http.Response, error) {
 			return r.kc.ApiClient.TgwsAPI.GetTransitGateway(ctx, plan.TgwId.ValueString()).
 				XAuthToken(r.kc.XAuthToken).
@@ -130,7 +130,7 @@ func (r *transitGatewayDefaultRouteTableResource) Read(ctx context.Context, req
 	ctx, cancel := context.WithTimeout(ctx, timeout)
 	defer cancel()
 
-	respModel, httpResp, err := common.ExecuteWithRetryAndAuth(ctx, r.kc, &resp.Diagnostics,
+	respModel, httpResp, err := common.ExecuteWithRetryAndAuth(ctx, r.kc, &resp.Diagnostics, common.ServiceTGW,
 		func() (*tgw.GetTgwResponseModel, *http.Response, error) {
 			return r.kc.ApiClient.TgwsAPI.GetTransitGateway(ctx, state.TgwId.ValueString()).
 				XAuthToken(r.kc.XAuthToken).Execute()
@@ -172,7 +172,7 @@ func (r *transitGatewayDefaultRouteTableResource) Update(ctx context.Context, re
 	ctx, cancel := context.WithTimeout(ctx, timeout)
 	defer cancel()
 
-	respModel, httpResp, err := common.ExecuteWithRetryAndAuth(ctx, r.kc, &resp.Diagnostics,
+	respModel, httpResp, err := common.ExecuteWithRetryAndAuth(ctx, r.kc, &resp.Diagnostics, common.ServiceTGW,
 		func() (*tgw.GetTgwResponseModel, *http.Response, error) {
 			return r.kc.ApiClient.TgwsAPI.GetTransitGateway(ctx, plan.TgwId.ValueString()).
 				XAuthToken(r.kc.XAuthToken).
@@ -216,7 +216,7 @@ func (r *transitGatewayDefaultRouteTableResource) Delete(ctx context.Context, re
 	ctx, cancel := context.WithTimeout(ctx, timeout)
 	defer cancel()
 
-	respModel, httpResp, err := common.ExecuteWithRetryAndAuth(ctx, r.kc, &resp.Diagnostics,
+	respModel, httpResp, err := common.ExecuteWithRetryAndAuth(ctx, r.kc, &resp.Diagnostics, common.ServiceTGW,
 		func() (*tgw.GetTgwResponseModel, *http.Response, error) {
 			return r.kc.ApiClient.TgwsAPI.GetTransitGateway(ctx, state.TgwId.ValueString()).
 				XAuthToken(r.kc.XAuthToken).
@@ -297,7 +297,7 @@ func (r *transitGatewayDefaultRouteTableResource) updateDefaultRouteTable(
 		Tgw: *updateReq,
 	}
 
-	_, httpResp, err := common.ExecuteWithRetryAndAuth(ctx, r.kc, diags,
+	_, httpResp, err := common.ExecuteWithRetryAndAuth(ctx, r.kc, diags, common.ServiceTGW,
 		func() (*tgw.BnsTgwV1ApiUpdateTransitGatewayModelCreateTgwResponseModel, *http.Response, error) {
 			return r.kc.ApiClient.TgwsAPI.UpdateTransitGateway(ctx, tgwID).
 				XAuthToken(r.kc.XAuthToken).
@@ -87,7 +87,7 @@ func (r *transitGatewayResource) Create(ctx context.Context, req resource.Create
 		Tgw: createReq,
 	}
 
-	respModel, httpResp, err := common.ExecuteWithRetryAndAuth(ctx, r.kc, &resp.Diagnostics,
+	respModel, httpResp, err := common.ExecuteWithRetryAndAuth(ctx, r.kc, &resp.Diagnostics, common.ServiceTGW,
 		func() (*tgw.BnsTgwV1ApiCreateTransitGatewayModelCreateTgwResponseModel, *http.Response, error) {
 			return r.kc.ApiClient.TgwsAPI.CreateTransitGateway(ctx).
 				XAuthToken(r.kc.XAuthToken).
@@ -141,7 +141,7 @@ func (r *transitGatewayResource) Read(ctx context.Context, req resource.ReadRequ
 	ctx, cancel := context.WithTimeout(ctx, timeout)
 	defer cancel()
 
-	respModel, httpResp, err := common.ExecuteWithRetryAndAuth(ctx, r.kc, &resp.Diagnostics,
+	respModel, httpResp, err := common.ExecuteWithRetryAndAuth(ctx, r.kc, &resp.Diagnostics, common.ServiceTGW,
 		func() (*tgw.GetTgwResponseModel, *http.Response, error) {
 			return r.kc.ApiClient.TgwsAPI.GetTransitGateway(ctx, state.Id.ValueString()).
 				XAuthToken(r.kc.XAuthToken).Execute()
@@ -236,7 +236,7 @@ func (r *transitGatewayResource) Update(ctx context.Context, req resource.Update
 			Tgw: *updateReq,
 		}
 
-		_, httpResp, err := common.ExecuteWithRetryAndAuth(ctx, r.kc, &resp.Diagnostics,
+		_, httpResp, err := common.ExecuteWithRetryAndAuth(ctx, r.kc, &resp.Diagnostics, common.ServiceTGW,
 			func() (*tgw.BnsTgwV1ApiUpdateTransitGatewayModelCreateTgwResponseModel, *http.Response, error) {
 				return r.kc.ApiClient.TgwsAPI.UpdateTransitGateway(ctx, plan.Id.ValueString()).
 					XAuthToken(r.kc.XAuthToken).
@@ -300,7 +300,7 @@ func (r *transitGatewayResource) Delete(ctx context.Context, req resource.Delete
 		return
 	}
 
-	_, httpResp, err := common.ExecuteWithRetryAndAuth(ctx, r.kc, &resp.Diagnostics,
+	_, httpResp, err := common.ExecuteWithRetryAndAuth(ctx, r.kc, &resp.Diagnostics, common.ServiceTGW,
 		func() (interface{}, *http.Response, error) {
 			httpResp, err := r.kc.ApiClient.TgwsAPI.DeleteTransitGateway(ctx, state.Id.ValueString()).
 				XAuthToken(r.kc.XAuthToken).
@@ -317,7 +317,7 @@ func (r *transitGatewayResource) Delete(ctx context.Context, req resource.Delete
 	}
 
 	common.PollUntilDeletion(ctx, r, 10*time.Second, &resp.Diagnostics, func(ctx context.Context) (bool, *http.Response, error) {
-		_, httpResp, err := common.ExecuteWithRetryAndAuth(ctx, r.kc, &resp.Diagnostics,
+		_, httpResp, err := common.ExecuteWithRetryAndAuth(ctx, r.kc, &resp.Diagnostics, common.ServiceTGW,
 			func() (interface{}, *http.Response, error) {
 				_, httpResp, err := r.kc.ApiClient.TgwsAPI.
 					GetTransitGateway(ctx, state.Id.ValueString()).
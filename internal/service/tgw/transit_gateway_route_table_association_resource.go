@@ -136,7 +136,7 @@ func (r *transitGatewayRouteTableAssociationResource) Create(ctx context.Context
 		Association: createReq,
 	}
 
-	respModel, httpResp, err := common.ExecuteWithRetryAndAuth(ctx, r.kc, &resp.Diagnostics,
+	respModel, httpResp, err := common.ExecuteWithRetryAndAuth(ctx, r.kc, &resp.Diagnostics, common.ServiceTGW,
 		func() (*tgw.CreateTgwRouteTableAssociationResponseModel, *http.Response, error) {
 			return r.kc.ApiClient.RouteTablesAPI.CreateTgwRouteTableAssociation(ctx, routeTableId).
 				XAuthToken(r.kc.XAuthToken).
@@ -279,7 +279,7 @@ func (r *transitGatewayRouteTableAssociationResource) ImportState(ctx context.Co
 
 func (r *transitGatewayRouteTableAssociationResource) fetchAttachmentRouteTable(ctx context.Context, tgwAttachmentId string, resp *diag.Diagnostics) (string, error) {
 
-	respModel, httpResp, err := common.ExecuteWithRetryAndAuth(ctx, r.kc, resp,
+	respModel, httpResp, err := common.ExecuteWithRetryAndAuth(ctx, r.kc, resp, common.ServiceTGW,
 		func() (*tgw.GetTgwAttachmentResponseModel, *http.Response, error) {
 			return r.kc.ApiClient.AttachmentsAPI.GetTgwAttachment(ctx, tgwAttachmentId).
 				XAuthToken(r.kc.XAuthToken).
@@ -304,7 +304,7 @@ func (r *transitGatewayRouteTableAssociationResource) fetchRouteTableAssociation
 
 	var fetchResult *routeTableAssociationFetchResultModel
 
-	listResp, httpResp, err := common.ExecuteWithRetryAndAuth(ctx, r.kc, resp,
+	listResp, httpResp, err := common.ExecuteWithRetryAndAuth(ctx, r.kc, resp, common.ServiceTGW,
 		func() (*tgw.GetTgwRouteTableAssociationsResponseModel, *http.Response, error) {
 			return r.kc.ApiClient.RouteTablesAPI.
 				ListTgwRouteTableAssociations(ctx, routeTableId).
@@ -340,7 +340,7 @@ func (r *transitGatewayRouteTableAssociationResource) pollAssociationActive(
 	resp *diag.Diagnostics,
 ) (string, bool) {
 	for {
-		respModel, httpResp, err := common.ExecuteWithRetryAndAuth(ctx, r.kc, resp,
+		respModel, httpResp, err := common.ExecuteWithRetryAndAuth(ctx, r.kc, resp, common.ServiceTGW,
 			func() (*tgw.GetTgwRouteTableAssociationsResponseModel, *http.Response, error) {
 				return r.kc.ApiClient.RouteTablesAPI.
 					ListTgwRouteTableAssociations(ctx, tgwRouteTableId).
@@ -371,7 +371,7 @@ func deleteRouteTableAssociation(
 	routeTableId, associationId string,
 	resp *diag.Diagnostics,
 ) bool {
-	_, httpResp, err := common.ExecuteWithRetryAndAuth(ctx, kc, resp,
+	_, httpResp, err := common.ExecuteWithRetryAndAuth(ctx, kc, resp, common.ServiceTGW,
 		func() (interface{}, *http.Response, error) {
 			httpResp, err := kc.ApiClient.RouteTablesAPI.DeleteTgwRouteTableAssociation(ctx, routeTableId, associationId).
 				XAuthToken(kc.XAuthToken).
@@ -401,7 +401,7 @@ func pollAssociationDelete(
 	resp *diag.Diagnostics,
 ) bool {
 	for {
-		respModel, httpResp, err := common.ExecuteWithRetryAndAuth(ctx, kc, resp,
+		respModel, httpResp, err := common.ExecuteWithRetryAndAuth(ctx, kc, resp, common.ServiceTGW,
 			func() (*tgw.GetTgwRouteTableAssociationsResponseModel, *http.Response, error) {
 				return kc.ApiClient.RouteTablesAPI.
 					ListTgwRouteTableAssociations(ctx, tgwRouteTableId).
@@ -114,9 +114,12 @@ func (d *transitGatewayRouteTableAssociationsDataSource) Read(ctx context.Contex
 		return
 	}
 
-	listResp, httpResp, err := common.ExecuteWithRetryAndAuth(ctx, d.kc, &resp.Diagnostics,
-		func() (*tgw.GetTgwRouteTableAssociationsResponseModel, *http.Response, error) {
-			return associationApi.Limit(1000).XAuthToken(d.kc.XAuthToken).Execute()
+	associationPages, httpResp, err := common.ListAllPagesPartial(ctx, d.kc, d, &resp.Diagnostics, common.ServiceTGW,
+		func(limit int32, offset int32) (*tgw.GetTgwRouteTableAssociationsResponseModel, *http.Response, error) {
+			return associationApi.Limit(limit).Offset(offset).XAuthToken(d.kc.XAuthToken).Execute()
+		},
+		func(page *tgw.GetTgwRouteTableAssociationsResponseModel) int {
+			return len(page.Associations)
 		},
 	)
 	if err != nil {
@@ -125,13 +128,15 @@ func (d *transitGatewayRouteTableAssociationsDataSource) Read(ctx context.Contex
 	}
 
 	config.Associations = make([]transitGatewayRouteTableAssociationBaseModel, 0)
-	for _, assoc := range listResp.Associations {
-		var assocModel transitGatewayRouteTableAssociationBaseModel
-		ok := mapTransitGatewayRouteTableAssociationBaseModel(ctx, &assocModel, &assoc, config.RouteTableId.ValueString(), &resp.Diagnostics)
-		if !ok || resp.Diagnostics.HasError() {
-			return
+	for _, listResp := range associationPages {
+		for _, assoc := range listResp.Associations {
+			var assocModel transitGatewayRouteTableAssociationBaseModel
+			ok := mapTransitGatewayRouteTableAssociationBaseModel(ctx, &assocModel, &assoc, config.RouteTableId.ValueString(), &resp.Diagnostics)
+			if !ok || resp.Diagnostics.HasError() {
+				return
+			}
+			config.Associations = append(config.Associations, assocModel)
 		}
-		config.Associations = append(config.Associations, assocModel)
 	}
 
 	diags = resp.State.Set(ctx, &config)
@@ -66,7 +66,7 @@ func (d *transitGatewayRouteTableDataSource) Read(ctx context.Context, req datas
 	ctx, cancel := context.WithTimeout(ctx, timeout)
 	defer cancel()
 
-	routeTableResp, httpResp, err := common.ExecuteWithRetryAndAuth(ctx, d.kc, &resp.Diagnostics,
+	routeTableResp, httpResp, err := common.ExecuteWithRetryAndAuth(ctx, d.kc, &resp.Diagnostics, common.ServiceTGW,
 		func() (*tgw.GetTgwRouteTableResponseModel, *http.Response, error) {
 			return d.kc.ApiClient.RouteTablesAPI.GetTgwRouteTable(ctx, config.Id.ValueString()).
 				XAuthToken(d.kc.XAuthToken).Execute()
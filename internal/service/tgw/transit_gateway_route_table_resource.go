@@ -86,7 +86,7 @@ func (r *transitGatewayRouteTableResource) Create(ctx context.Context, req resou
 		RouteTable: createReq,
 	}
 
-	respModel, httpResp, err := common.ExecuteWithRetryAndAuth(ctx, r.kc, &resp.Diagnostics,
+	respModel, httpResp, err := common.ExecuteWithRetryAndAuth(ctx, r.kc, &resp.Diagnostics, common.ServiceTGW,
 		func() (*tgw.BnsTgwV1ApiCreateTgwRouteTableModelCreateTgwRouteTableResponseModel, *http.Response, error) {
 			return r.kc.ApiClient.RouteTablesAPI.CreateTgwRouteTable(ctx).
 				XAuthToken(r.kc.XAuthToken).
@@ -162,7 +162,7 @@ func (r *transitGatewayRouteTableResource) Read(ctx context.Context, req resourc
 	ctx, cancel := context.WithTimeout(ctx, timeout)
 	defer cancel()
 
-	respModel, httpResp, err := common.ExecuteWithRetryAndAuth(ctx, r.kc, &resp.Diagnostics,
+	respModel, httpResp, err := common.ExecuteWithRetryAndAuth(ctx, r.kc, &resp.Diagnostics, common.ServiceTGW,
 		func() (*tgw.GetTgwRouteTableResponseModel, *http.Response, error) {
 			return r.kc.ApiClient.RouteTablesAPI.GetTgwRouteTable(ctx, state.Id.ValueString()).
 				XAuthToken(r.kc.XAuthToken).
@@ -251,7 +251,7 @@ func (r *transitGatewayRouteTableResource) Update(ctx context.Context, req resou
 			RouteTable: *updateReq,
 		}
 
-		_, httpResp, err := common.ExecuteWithRetryAndAuth(ctx, r.kc, &resp.Diagnostics,
+		_, httpResp, err := common.ExecuteWithRetryAndAuth(ctx, r.kc, &resp.Diagnostics, common.ServiceTGW,
 			func() (*tgw.BnsTgwV1ApiUpdateTgwRouteTableModelCreateTgwRouteTableResponseModel, *http.Response, error) {
 				return r.kc.ApiClient.RouteTablesAPI.UpdateTgwRouteTable(ctx, plan.Id.ValueString()).
 					XAuthToken(r.kc.XAuthToken).
@@ -363,7 +363,7 @@ func (r *transitGatewayRouteTableResource) Delete(ctx context.Context, req resou
 		}
 	}
 
-	_, httpResp, err := common.ExecuteWithRetryAndAuth(ctx, r.kc, &resp.Diagnostics,
+	_, httpResp, err := common.ExecuteWithRetryAndAuth(ctx, r.kc, &resp.Diagnostics, common.ServiceTGW,
 		func() (interface{}, *http.Response, error) {
 			httpResp, err := r.kc.ApiClient.RouteTablesAPI.DeleteTgwRouteTable(ctx, state.Id.ValueString()).
 				XAuthToken(r.kc.XAuthToken).
@@ -380,7 +380,7 @@ func (r *transitGatewayRouteTableResource) Delete(ctx context.Context, req resou
 	}
 
 	common.PollUntilDeletion(ctx, r, 5*time.Second, &resp.Diagnostics, func(ctx context.Context) (bool, *http.Response, error) {
-		_, httpResp, err := common.ExecuteWithRetryAndAuth(ctx, r.kc, &resp.Diagnostics,
+		_, httpResp, err := common.ExecuteWithRetryAndAuth(ctx, r.kc, &resp.Diagnostics, common.ServiceTGW,
 			func() (interface{}, *http.Response, error) {
 				_, httpResp, err := r.kc.ApiClient.RouteTablesAPI.
 					GetTgwRouteTable(ctx, state.Id.ValueString()).
@@ -437,7 +437,7 @@ func pollRouteTableUntilAvailable(
 		},
 		diags,
 		func(ctx context.Context) (*tgw.BnsTgwV1ApiGetTgwRouteTableModelTgwRouteTableResponseModel, *http.Response, error) {
-			respModel, httpResp, err := common.ExecuteWithRetryAndAuth(ctx, kc, diags,
+			respModel, httpResp, err := common.ExecuteWithRetryAndAuth(ctx, kc, diags, common.ServiceTGW,
 				func() (*tgw.GetTgwRouteTableResponseModel, *http.Response, error) {
 					return kc.ApiClient.RouteTablesAPI.
 						GetTgwRouteTable(ctx, routeTableId).
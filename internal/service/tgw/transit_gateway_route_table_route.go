@@ -90,7 +90,7 @@ func (r *transitGatewayRouteTableResource) addRoute(ctx context.Context, tgwRout
 		Route: *createReq,
 	}
 
-	respModel, httpResp, err := common.ExecuteWithRetryAndAuth(ctx, r.kc, diag,
+	respModel, httpResp, err := common.ExecuteWithRetryAndAuth(ctx, r.kc, diag, common.ServiceTGW,
 		func() (*tgw.BnsTgwV1ApiCreateTgwRouteModelCreateTgwRouteTableRouteResponseModel, *http.Response, error) {
 			return r.kc.ApiClient.RouteTablesAPI.CreateTgwRoute(ctx, tgwRouteTableId).
 				XAuthToken(r.kc.XAuthToken).
@@ -120,7 +120,7 @@ func (r *transitGatewayRouteTableResource) updateRoute(ctx context.Context, tgwR
 		Route: *updateReq,
 	}
 
-	_, httpResp, err := common.ExecuteWithRetryAndAuth(ctx, r.kc, diag,
+	_, httpResp, err := common.ExecuteWithRetryAndAuth(ctx, r.kc, diag, common.ServiceTGW,
 		func() (*tgw.BnsTgwV1ApiUpdateTgwRouteModelCreateTgwRouteTableRouteResponseModel, *http.Response, error) {
 			return r.kc.ApiClient.RouteTablesAPI.UpdateTgwRouteTableRoute(
 				ctx, tgwRouteTableId, route.Id.ValueString(),
@@ -142,7 +142,7 @@ func (r *transitGatewayRouteTableResource) updateRoute(ctx context.Context, tgwR
 }
 
 func (r *transitGatewayRouteTableResource) deleteRoute(ctx context.Context, tgwRouteTableId, routeId string, diag *diag.Diagnostics) bool {
-	_, httpResp, err := common.ExecuteWithRetryAndAuth(ctx, r.kc, diag,
+	_, httpResp, err := common.ExecuteWithRetryAndAuth(ctx, r.kc, diag, common.ServiceTGW,
 		func() (interface{}, *http.Response, error) {
 			httpResp, err := r.kc.ApiClient.RouteTablesAPI.DeleteTgwRoute(ctx, tgwRouteTableId, routeId).
 				XAuthToken(r.kc.XAuthToken).
@@ -169,7 +169,7 @@ func (r *transitGatewayRouteTableResource) pollRouteActive(
 ) bool {
 	for {
 		time.Sleep(5 * time.Second)
-		respModel, httpResp, err := common.ExecuteWithRetryAndAuth(ctx, r.kc, resp,
+		respModel, httpResp, err := common.ExecuteWithRetryAndAuth(ctx, r.kc, resp, common.ServiceTGW,
 			func() (*tgw.GetTgwRouteTableRoutesResponseModel, *http.Response, error) {
 				return r.kc.ApiClient.RouteTablesAPI.
 					ListTgwRoutes(ctx, tgwRouteTableId).
@@ -201,7 +201,7 @@ func (r *transitGatewayRouteTableResource) pollRouteDelete(
 ) bool {
 	for {
 		time.Sleep(5 * time.Second)
-		respModel, httpResp, err := common.ExecuteWithRetryAndAuth(ctx, r.kc, resp,
+		respModel, httpResp, err := common.ExecuteWithRetryAndAuth(ctx, r.kc, resp, common.ServiceTGW,
 			func() (*tgw.GetTgwRouteTableRoutesResponseModel, *http.Response, error) {
 				return r.kc.ApiClient.RouteTablesAPI.
 					ListTgwRoutes(ctx, tgwRouteTableId).
@@ -148,9 +148,12 @@ func (d *transitGatewayRouteTablesDataSource) Read(ctx context.Context, req data
 
 	config.TransitGatewayRouteTables = make([]transitGatewayRouteTableDataSourceBaseModel, 0)
 
-	routeTablesResp, httpResp, err := common.ExecuteWithRetryAndAuth(ctx, d.kc, &resp.Diagnostics,
-		func() (*tgw.GetTgwRouteTablesResponseModel, *http.Response, error) {
-			return routeTableApi.Limit(1000).XAuthToken(d.kc.XAuthToken).Execute()
+	routeTablesPages, httpResp, err := common.ListAllPagesPartial(ctx, d.kc, d, &resp.Diagnostics, common.ServiceTGW,
+		func(limit int32, offset int32) (*tgw.GetTgwRouteTablesResponseModel, *http.Response, error) {
+			return routeTableApi.Limit(limit).Offset(offset).XAuthToken(d.kc.XAuthToken).Execute()
+		},
+		func(page *tgw.GetTgwRouteTablesResponseModel) int {
+			return len(page.TgwRouteTables)
 		},
 	)
 	if err != nil {
@@ -158,13 +161,15 @@ func (d *transitGatewayRouteTablesDataSource) Read(ctx context.Context, req data
 		return
 	}
 
-	for _, item := range routeTablesResp.TgwRouteTables {
-		var routeTableModel transitGatewayRouteTableDataSourceBaseModel
+	for _, routeTablesResp := range routeTablesPages {
+		for _, item := range routeTablesResp.TgwRouteTables {
+			var routeTableModel transitGatewayRouteTableDataSourceBaseModel
 
-		if !mapTransitGatewayRouteTableListModel(ctx, &routeTableModel, &item, &resp.Diagnostics) {
-			return
+			if !mapTransitGatewayRouteTableListModel(ctx, &routeTableModel, &item, &resp.Diagnostics) {
+				return
+			}
+			config.TransitGatewayRouteTables = append(config.TransitGatewayRouteTables, routeTableModel)
 		}
-		config.TransitGatewayRouteTables = append(config.TransitGatewayRouteTables, routeTableModel)
 	}
 
 	diags = resp.State.Set(ctx, &config)
@@ -110,9 +110,12 @@ func (d *transitGatewayRoutesDataSource) Read(ctx context.Context, req datasourc
 		return
 	}
 
-	routesResp, httpResp, err := common.ExecuteWithRetryAndAuth(ctx, d.kc, &resp.Diagnostics,
-		func() (*tgw.GetTgwRouteTableRoutesResponseModel, *http.Response, error) {
-			return routeApi.Limit(1000).XAuthToken(d.kc.XAuthToken).Execute()
+	routesPages, httpResp, err := common.ListAllPagesPartial(ctx, d.kc, d, &resp.Diagnostics, common.ServiceTGW,
+		func(limit int32, offset int32) (*tgw.GetTgwRouteTableRoutesResponseModel, *http.Response, error) {
+			return routeApi.Limit(limit).Offset(offset).XAuthToken(d.kc.XAuthToken).Execute()
+		},
+		func(page *tgw.GetTgwRouteTableRoutesResponseModel) int {
+			return len(page.Routes)
 		},
 	)
 	if err != nil {
@@ -121,13 +124,15 @@ func (d *transitGatewayRoutesDataSource) Read(ctx context.Context, req datasourc
 	}
 
 	config.TransitGatewayRoutes = make([]transitGatewayRouteBaseModel, 0)
-	for _, item := range routesResp.Routes {
-		var routeModel transitGatewayRouteBaseModel
-		ok := mapTransitGatewayRouteListModel(ctx, &routeModel, &item, config.RouteTableId.ValueString(), &resp.Diagnostics)
-		if !ok || resp.Diagnostics.HasError() {
-			return
+	for _, routesResp := range routesPages {
+		for _, item := range routesResp.Routes {
+			var routeModel transitGatewayRouteBaseModel
+			ok := mapTransitGatewayRouteListModel(ctx, &routeModel, &item, config.RouteTableId.ValueString(), &resp.Diagnostics)
+			if !ok || resp.Diagnostics.HasError() {
+				return
+			}
+			config.TransitGatewayRoutes = append(config.TransitGatewayRoutes, routeModel)
 		}
-		config.TransitGatewayRoutes = append(config.TransitGatewayRoutes, routeModel)
 	}
 
 	diags = resp.State.Set(ctx, &config)
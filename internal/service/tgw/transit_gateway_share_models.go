@@ -12,6 +12,7 @@ type transitGatewayShareResourceModel struct {
 	Id              types.String           `tfsdk:"id"`
 	TgwId           types.String           `tfsdk:"tgw_id"`
 	TargetProjectId types.String           `tfsdk:"target_project_id"`
+	ProjectId       types.String           `tfsdk:"project_id"`
 	Timeouts        resourceTimeouts.Value `tfsdk:"timeouts"`
 }
 
@@ -55,6 +55,8 @@ func (r *transitGatewayShareResource) Create(ctx context.Context, req resource.C
 		return
 	}
 
+	kc := r.kc.ForProject(plan.ProjectId.ValueString())
+
 	mutex := common.LockForID(plan.TgwId.ValueString())
 	mutex.Lock()
 	defer mutex.Unlock()
@@ -68,18 +70,18 @@ func (r *transitGatewayShareResource) Create(ctx context.Context, req resource.C
 	ctx, cancel := context.WithTimeout(ctx, timeout)
 	defer cancel()
 
-	_, ok := pollTgw(ctx, r.kc, r, plan.TgwId.ValueString(), []string{"ACTIVE", "ERROR"}, &resp.Diagnostics)
+	_, ok := pollTgw(ctx, kc, r, plan.TgwId.ValueString(), []string{"ACTIVE", "ERROR"}, &resp.Diagnostics)
 	if !ok || resp.Diagnostics.HasError() {
 		return
 	}
 
-	_, httpResp, err := common.ExecuteWithRetryAndAuth(ctx, r.kc, &resp.Diagnostics,
+	_, httpResp, err := common.ExecuteWithRetryAndAuth(ctx, kc, &resp.Diagnostics, common.ServiceTGW,
 		func() (interface{}, *http.Response, error) {
-			return r.kc.ApiClient.TgwsAPI.ShareTransitGateway(
+			return kc.ApiClient.TgwsAPI.ShareTransitGateway(
 				ctx,
 				plan.TgwId.ValueString(),
 				plan.TargetProjectId.ValueString(),
-			).XAuthToken(r.kc.XAuthToken).Execute()
+			).XAuthToken(kc.XAuthToken).Execute()
 		},
 	)
 	if err != nil {
@@ -104,6 +106,8 @@ func (r *transitGatewayShareResource) Read(ctx context.Context, req resource.Rea
 		return
 	}
 
+	kc := r.kc.ForProject(state.ProjectId.ValueString())
+
 	timeout, diags := state.Timeouts.Read(ctx, common.DefaultReadTimeout)
 
 	resp.Diagnostics.Append(diags...)
@@ -114,10 +118,10 @@ func (r *transitGatewayShareResource) Read(ctx context.Context, req resource.Rea
 	ctx, cancel := context.WithTimeout(ctx, timeout)
 	defer cancel()
 
-	respModel, httpResp, err := common.ExecuteWithRetryAndAuth(ctx, r.kc, &resp.Diagnostics,
+	respModel, httpResp, err := common.ExecuteWithRetryAndAuth(ctx, kc, &resp.Diagnostics, common.ServiceTGW,
 		func() (*tgw.GetTgwProjectsResponseModel, *http.Response, error) {
-			return r.kc.ApiClient.TgwsAPI.ListTgwSharedProjects(ctx, state.TgwId.ValueString()).
-				XAuthToken(r.kc.XAuthToken).
+			return kc.ApiClient.TgwsAPI.ListTgwSharedProjects(ctx, state.TgwId.ValueString()).
+				XAuthToken(kc.XAuthToken).
 				Execute()
 		},
 	)
@@ -166,6 +170,8 @@ func (r *transitGatewayShareResource) Delete(ctx context.Context, req resource.D
 		return
 	}
 
+	kc := r.kc.ForProject(state.ProjectId.ValueString())
+
 	mutex := common.LockForID(state.TgwId.ValueString())
 	mutex.Lock()
 	defer mutex.Unlock()
@@ -179,16 +185,16 @@ func (r *transitGatewayShareResource) Delete(ctx context.Context, req resource.D
 	ctx, cancel := context.WithTimeout(ctx, timeout)
 	defer cancel()
 
-	_, ok := pollTgw(ctx, r.kc, r, state.TgwId.ValueString(), []string{"ACTIVE", "ERROR"}, &resp.Diagnostics)
+	_, ok := pollTgw(ctx, kc, r, state.TgwId.ValueString(), []string{"ACTIVE", "ERROR"}, &resp.Diagnostics)
 	if !ok || resp.Diagnostics.HasError() {
 		return
 	}
 
-	_, httpResp, err := common.ExecuteWithRetryAndAuth(ctx, r.kc, &resp.Diagnostics,
+	_, httpResp, err := common.ExecuteWithRetryAndAuth(ctx, kc, &resp.Diagnostics, common.ServiceTGW,
 		func() (interface{}, *http.Response, error) {
-			httpResp, err := r.kc.ApiClient.TgwsAPI.UnshareTransitGateway(
+			httpResp, err := kc.ApiClient.TgwsAPI.UnshareTransitGateway(
 				ctx, state.TgwId.ValueString(), state.TargetProjectId.ValueString(),
-			).XAuthToken(r.kc.XAuthToken).Execute()
+			).XAuthToken(kc.XAuthToken).Execute()
 			return nil, httpResp, err
 		},
 	)
@@ -201,10 +207,10 @@ func (r *transitGatewayShareResource) Delete(ctx context.Context, req resource.D
 	}
 
 	common.PollUntilDeletion(ctx, r, 10*time.Second, &resp.Diagnostics, func(ctx context.Context) (bool, *http.Response, error) {
-		listResp, httpResp, err := common.ExecuteWithRetryAndAuth(ctx, r.kc, &resp.Diagnostics,
+		listResp, httpResp, err := common.ExecuteWithRetryAndAuth(ctx, kc, &resp.Diagnostics, common.ServiceTGW,
 			func() (*tgw.GetTgwProjectsResponseModel, *http.Response, error) {
-				return r.kc.ApiClient.TgwsAPI.ListTgwSharedProjects(ctx, state.TgwId.ValueString()).
-					XAuthToken(r.kc.XAuthToken).
+				return kc.ApiClient.TgwsAPI.ListTgwSharedProjects(ctx, state.TgwId.ValueString()).
+					XAuthToken(kc.XAuthToken).
 					Execute()
 			},
 		)
@@ -246,13 +252,16 @@ func (r *transitGatewayShareResource) Configure(_ context.Context, req resource.
 func (r *transitGatewayShareResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 
 	parts := strings.Split(req.ID, "/")
-	if len(parts) != 2 {
+	if len(parts) != 2 && len(parts) != 3 {
 		common.AddImportFormatError(ctx, r, &resp.Diagnostics,
-			"Expected import ID in the format: tgw_id/target_project_id")
+			"Expected import ID in the format: tgw_id/target_project_id or tgw_id/target_project_id/project_id")
 		return
 	}
 
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), parts[0]+"/"+parts[1])...)
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("tgw_id"), parts[0])...)
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("target_project_id"), parts[1])...)
+	if len(parts) == 3 {
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("project_id"), parts[2])...)
+	}
 }
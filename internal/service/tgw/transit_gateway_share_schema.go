@@ -33,6 +33,13 @@ func getTransitGatewayShareResourceSchema() map[string]rschema.Attribute {
 				stringplanmodifier.RequiresReplace(),
 			},
 		},
+		"project_id": rschema.StringAttribute{
+			Optional:   true,
+			Validators: common.UuidNoHyphenValidator(),
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.RequiresReplace(),
+			},
+		},
 	}
 }
 
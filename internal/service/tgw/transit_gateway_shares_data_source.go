@@ -63,7 +63,7 @@ func (d *transitGatewaySharedProjectsDataSource) Read(ctx context.Context, req d
 	ctx, cancel := context.WithTimeout(ctx, timeout)
 	defer cancel()
 
-	respModel, httpResp, err := common.ExecuteWithRetryAndAuth(ctx, d.kc, &resp.Diagnostics,
+	respModel, httpResp, err := common.ExecuteWithRetryAndAuth(ctx, d.kc, &resp.Diagnostics, common.ServiceTGW,
 		func() (*tgw.GetTgwProjectsResponseModel, *http.Response, error) {
 			return d.kc.ApiClient.TgwsAPI.ListTgwSharedProjects(ctx, config.TgwId.ValueString()).
 				XAuthToken(d.kc.XAuthToken).
@@ -58,7 +58,7 @@ func pollTgw(
 	result, ok := common.PollUntilResult(
 		ctx, resource, 3*time.Second, "transit gateway", tgwId, targetStatuses, diags,
 		func(ctx context.Context) (*tgw.BnsTgwV1ApiGetTransitGatewayModelTgwResponseModel, *http.Response, error) {
-			respModel, httpResp, err := common.ExecuteWithRetryAndAuth(ctx, kc, diags,
+			respModel, httpResp, err := common.ExecuteWithRetryAndAuth(ctx, kc, diags, common.ServiceTGW,
 				func() (*tgw.GetTgwResponseModel, *http.Response, error) {
 					return kc.ApiClient.TgwsAPI.
 						GetTransitGateway(ctx, tgwId).
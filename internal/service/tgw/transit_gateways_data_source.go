@@ -162,9 +162,12 @@ func (d *transitGatewaysDataSource) Read(ctx context.Context, req datasource.Rea
 		return
 	}
 
-	tgwResp, httpResp, err := common.ExecuteWithRetryAndAuth(ctx, d.kc, &resp.Diagnostics,
-		func() (*tgw.GetTgwsResponseModel, *http.Response, error) {
-			return tgwApi.Limit(1000).XAuthToken(d.kc.XAuthToken).Execute()
+	tgwPages, httpResp, err := common.ListAllPagesPartial(ctx, d.kc, d, &resp.Diagnostics, common.ServiceTGW,
+		func(limit int32, offset int32) (*tgw.GetTgwsResponseModel, *http.Response, error) {
+			return tgwApi.Limit(limit).Offset(offset).XAuthToken(d.kc.XAuthToken).Execute()
+		},
+		func(page *tgw.GetTgwsResponseModel) int {
+			return len(page.Tgws)
 		},
 	)
 	if err != nil {
@@ -173,13 +176,15 @@ func (d *transitGatewaysDataSource) Read(ctx context.Context, req datasource.Rea
 	}
 
 	config.TransitGateways = make([]transitGatewayBaseModel, 0)
-	for _, item := range tgwResp.Tgws {
-		var tgwModel transitGatewayBaseModel
-		ok := mapTransitGatewayListModel(ctx, &tgwModel, &item, &resp.Diagnostics)
-		if !ok || resp.Diagnostics.HasError() {
-			return
+	for _, tgwResp := range tgwPages {
+		for _, item := range tgwResp.Tgws {
+			var tgwModel transitGatewayBaseModel
+			ok := mapTransitGatewayListModel(ctx, &tgwModel, &item, &resp.Diagnostics)
+			if !ok || resp.Diagnostics.HasError() {
+				return
+			}
+			config.TransitGateways = append(config.TransitGateways, tgwModel)
 		}
-		config.TransitGateways = append(config.TransitGateways, tgwModel)
 	}
 
 	diags = resp.State.Set(ctx, &config)
@@ -66,7 +66,7 @@ func (d *volumeDataSource) Read(ctx context.Context, req datasource.ReadRequest,
 	ctx, cancel := context.WithTimeout(ctx, timeout)
 	defer cancel()
 
-	volumesResp, httpResp, err := common.ExecuteWithRetryAndAuth(ctx, d.kc, &resp.Diagnostics,
+	volumesResp, httpResp, err := common.ExecuteWithRetryAndAuth(ctx, d.kc, &resp.Diagnostics, common.ServiceVolume,
 		func() (*volume.BcsVolumeV1ApiGetVolumeModelResponseVolumeModel, *http.Response, error) {
 			return d.kc.ApiClient.VolumeAPI.GetVolume(ctx, config.Id.ValueString()).XAuthToken(d.kc.XAuthToken).Execute()
 		},
@@ -45,6 +45,8 @@ type volumeResourceModel struct {
 	VolumeSnapshotId   types.String           `tfsdk:"volume_snapshot_id"`
 	SourceVolumeId     types.String           `tfsdk:"source_volume_id"`
 	EncryptionSecretId types.String           `tfsdk:"encryption_secret_id"`
+	Tags               types.Map              `tfsdk:"tags"`
+	TagsAll            types.Map              `tfsdk:"tags_all"`
 	Timeouts           resourceTimeouts.Value `tfsdk:"timeouts"`
 }
 
@@ -22,6 +22,7 @@ import (
 var (
 	_ resource.ResourceWithConfigure      = &volumeResource{}
 	_ resource.ResourceWithImportState    = &volumeResource{}
+	_ resource.ResourceWithModifyPlan     = &volumeResource{}
 	_ resource.ResourceWithValidateConfig = &volumeResource{}
 )
 
@@ -41,6 +42,7 @@ func (r *volumeResource) Schema(ctx context.Context, _ resource.SchemaRequest, r
 	resp.Schema = schema.Schema{
 		Attributes: MergeResourceSchemaAttributes(
 			volumeResourceSchemaAttributes,
+			common.TagsResourceSchemaAttributes(),
 			map[string]schema.Attribute{
 				"timeouts": timeouts.AttributesAll(ctx),
 			},
@@ -80,6 +82,17 @@ func (r *volumeResource) Create(ctx context.Context, req resource.CreateRequest,
 		return
 	}
 
+	if !plan.VolumeSnapshotId.IsNull() && !plan.VolumeSnapshotId.IsUnknown() {
+		tagsAll := common.ExpandTags(ctx, plan.TagsAll, &resp.Diagnostics)
+		if len(tagsAll) > 0 {
+			metadata := common.BuildTagsMetadata(result.Metadata, nil, tagsAll)
+			if ok := r.updateVolumeMetadata(ctx, plan.Id.ValueString(), plan.Name.ValueString(), metadata, &resp.Diagnostics); !ok {
+				return
+			}
+			result.Metadata = metadata
+		}
+	}
+
 	if !plan.VolumeSnapshotId.IsNull() && !plan.VolumeSnapshotId.IsUnknown() {
 		currentSize := result.Size.Get()
 		if !plan.Size.IsNull() && !plan.Size.IsUnknown() && plan.Size.ValueInt32() > *currentSize {
@@ -95,6 +108,7 @@ func (r *volumeResource) Create(ctx context.Context, req resource.CreateRequest,
 	if !ok || resp.Diagnostics.HasError() {
 		return
 	}
+	plan.Tags, plan.TagsAll = common.FlattenTags(ctx, result.Metadata, plan.Tags, plan.TagsAll, &resp.Diagnostics)
 
 	diags = resp.State.Set(ctx, plan)
 	resp.Diagnostics.Append(diags...)
@@ -122,7 +136,7 @@ func (r *volumeResource) Read(ctx context.Context, req resource.ReadRequest, res
 	ctx, cancel := context.WithTimeout(ctx, timeout)
 	defer cancel()
 
-	respModel, httpResp, err := common.ExecuteWithRetryAndAuth(ctx, r.kc, &resp.Diagnostics,
+	respModel, httpResp, err := common.ExecuteWithRetryAndAuth(ctx, r.kc, &resp.Diagnostics, common.ServiceVolume,
 		func() (*volume.BcsVolumeV1ApiGetVolumeModelResponseVolumeModel, *http.Response, error) {
 			return r.kc.ApiClient.VolumeAPI.GetVolume(ctx, state.Id.ValueString()).XAuthToken(r.kc.XAuthToken).Execute()
 		},
@@ -143,6 +157,7 @@ func (r *volumeResource) Read(ctx context.Context, req resource.ReadRequest, res
 	if !ok || resp.Diagnostics.HasError() {
 		return
 	}
+	state.Tags, state.TagsAll = common.FlattenTags(ctx, volumeResult.Metadata, state.Tags, state.TagsAll, &resp.Diagnostics)
 
 	diags = resp.State.Set(ctx, &state)
 	resp.Diagnostics.Append(diags...)
@@ -186,12 +201,14 @@ func (r *volumeResource) Update(ctx context.Context, req resource.UpdateRequest,
 		requiredStatuses = StatusesReadyForNameDesc
 	}
 
-	_, ok := CheckVolumeStatus(ctx, r.kc, r, plan.Id.ValueString(), requiredStatuses, &resp.Diagnostics)
+	current, ok := CheckVolumeStatus(ctx, r.kc, r, plan.Id.ValueString(), requiredStatuses, &resp.Diagnostics)
 	if !ok || resp.Diagnostics.HasError() {
 		return
 	}
 
-	if plan.Name != state.Name || (!plan.Description.IsUnknown() && plan.Description != state.Description) {
+	tagsChanged := !plan.TagsAll.IsUnknown() && !plan.TagsAll.Equal(state.TagsAll)
+
+	if plan.Name != state.Name || (!plan.Description.IsUnknown() && plan.Description != state.Description) || tagsChanged {
 		editReq := volume.EditVolumeModel{
 			Name: plan.Name.ValueString(),
 		}
@@ -201,9 +218,22 @@ func (r *volumeResource) Update(ctx context.Context, req resource.UpdateRequest,
 			editReq.SetDescriptionNil()
 		}
 
+		var metadata map[string]string
+		if tagsChanged {
+			metadata = common.BuildTagsMetadata(
+				current.Metadata,
+				common.ExpandTags(ctx, state.TagsAll, &resp.Diagnostics),
+				common.ExpandTags(ctx, plan.TagsAll, &resp.Diagnostics),
+			)
+			if resp.Diagnostics.HasError() {
+				return
+			}
+			editReq.SetMetadata(metadata)
+		}
+
 		body := *volume.NewBodyUpdateVolume(editReq)
 
-		volumeResult, httpResp, err := common.ExecuteWithRetryAndAuth(ctx, r.kc, &resp.Diagnostics,
+		volumeResult, httpResp, err := common.ExecuteWithRetryAndAuth(ctx, r.kc, &resp.Diagnostics, common.ServiceVolume,
 			func() (*volume.BcsVolumeV1ApiUpdateVolumeModelResponseVolumeModel, *http.Response, error) {
 				return r.kc.ApiClient.VolumeAPI.UpdateVolume(ctx, state.Id.ValueString()).
 					XAuthToken(r.kc.XAuthToken).
@@ -219,7 +249,15 @@ func (r *volumeResource) Update(ctx context.Context, req resource.UpdateRequest,
 
 		state.Name = types.StringValue(volumeResult.Volume.Name)
 		state.Description = ConvertNullableString(volumeResult.Volume.Description)
+
+		if tagsChanged {
+			metaMap, metaDiags := types.MapValueFrom(ctx, types.StringType, metadata)
+			resp.Diagnostics.Append(metaDiags...)
+			state.Metadata = metaMap
+		}
 	}
+	state.Tags = plan.Tags
+	state.TagsAll = plan.TagsAll
 
 	if !plan.Size.IsNull() && !plan.Size.IsUnknown() && !plan.Size.Equal(state.Size) {
 		if ok := r.UpdateVolumeSize(ctx, r.kc, state.Id.ValueString(), plan.Size.ValueInt32(), &resp.Diagnostics); !ok {
@@ -260,7 +298,7 @@ func (r *volumeResource) Delete(ctx context.Context, req resource.DeleteRequest,
 		return
 	}
 
-	_, httpResp, err := common.ExecuteWithRetryAndAuth(ctx, r.kc, &resp.Diagnostics,
+	_, httpResp, err := common.ExecuteWithRetryAndAuth(ctx, r.kc, &resp.Diagnostics, common.ServiceVolume,
 		func() (interface{}, *http.Response, error) {
 			return r.kc.ApiClient.VolumeAPI.DeleteVolume(ctx, state.Id.ValueString()).
 				XAuthToken(r.kc.XAuthToken).
@@ -277,7 +315,7 @@ func (r *volumeResource) Delete(ctx context.Context, req resource.DeleteRequest,
 	}
 
 	common.PollUntilDeletion(ctx, r, 2*time.Second, &resp.Diagnostics, func(ctx context.Context) (bool, *http.Response, error) {
-		_, httpResp, err := common.ExecuteWithRetryAndAuth(ctx, r.kc, &resp.Diagnostics,
+		_, httpResp, err := common.ExecuteWithRetryAndAuth(ctx, r.kc, &resp.Diagnostics, common.ServiceVolume,
 			func() (*volume.BcsVolumeV1ApiGetVolumeModelResponseVolumeModel, *http.Response, error) {
 				_, httpResp, err := r.kc.ApiClient.VolumeAPI.
 					GetVolume(ctx, state.Id.ValueString()).
@@ -290,6 +328,10 @@ func (r *volumeResource) Delete(ctx context.Context, req resource.DeleteRequest,
 	})
 }
 
+func (r *volumeResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	common.ModifyPlanTagsAll(ctx, r.kc, req, resp)
+}
+
 func (r *volumeResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 
 	if req.ProviderData == nil {
@@ -341,11 +383,16 @@ func (r *volumeResource) createVolumeFromBody(ctx context.Context, plan *volumeR
 		createReq.SetSourceVolumeId(plan.SourceVolumeId.ValueString())
 	}
 
+	tagsAll := common.ExpandTags(ctx, plan.TagsAll, &resp.Diagnostics)
+	if len(tagsAll) > 0 {
+		createReq.SetMetadata(tagsAll)
+	}
+
 	body := volume.BodyCreateVolume{
 		Volume: createReq,
 	}
 
-	respModel, httpResp, err := common.ExecuteWithRetryAndAuth(ctx, r.kc, &resp.Diagnostics,
+	respModel, httpResp, err := common.ExecuteWithRetryAndAuth(ctx, r.kc, &resp.Diagnostics, common.ServiceVolume,
 		func() (*volume.BcsVolumeV1ApiCreateVolumeModelResponseVolumeModel, *http.Response, error) {
 			return r.kc.ApiClient.VolumeAPI.CreateVolume(ctx).XAuthToken(r.kc.XAuthToken).BodyCreateVolume(body).Execute()
 		},
@@ -387,7 +434,7 @@ func (r *volumeResource) restoreVolumeFromSnapshot(ctx context.Context, plan *vo
 		Restore: restoreReq,
 	}
 
-	respModel, httpResp, err := common.ExecuteWithRetryAndAuth(ctx, r.kc, &resp.Diagnostics,
+	respModel, httpResp, err := common.ExecuteWithRetryAndAuth(ctx, r.kc, &resp.Diagnostics, common.ServiceVolume,
 		func() (*volume.ResponseRestoreVolumeSnapshotModel, *http.Response, error) {
 			return r.kc.ApiClient.VolumeSnapshotAPI.RestoreSnapshot(ctx, plan.VolumeSnapshotId.ValueString()).
 				XAuthToken(r.kc.XAuthToken).
@@ -459,7 +506,7 @@ func (r *volumeResource) UpdateVolumeSize(ctx context.Context, kc *common.KakaoC
 		},
 	}
 
-	_, httpResp, err := common.ExecuteWithRetryAndAuth(ctx, r.kc, diags,
+	_, httpResp, err := common.ExecuteWithRetryAndAuth(ctx, r.kc, diags, common.ServiceVolume,
 		func() (interface{}, *http.Response, error) {
 			return kc.ApiClient.VolumeAPI.ExtendVolume(ctx, volumeId).
 				XAuthToken(kc.XAuthToken).
@@ -475,3 +522,28 @@ func (r *volumeResource) UpdateVolumeSize(ctx context.Context, kc *common.KakaoC
 
 	return true
 }
+
+func (r *volumeResource) updateVolumeMetadata(ctx context.Context, volumeId string, name string, metadata map[string]string, diags *diag.Diagnostics) bool {
+	editReq := volume.EditVolumeModel{
+		Name: name,
+	}
+	editReq.SetMetadata(metadata)
+
+	body := *volume.NewBodyUpdateVolume(editReq)
+
+	_, httpResp, err := common.ExecuteWithRetryAndAuth(ctx, r.kc, diags, common.ServiceVolume,
+		func() (*volume.BcsVolumeV1ApiUpdateVolumeModelResponseVolumeModel, *http.Response, error) {
+			return r.kc.ApiClient.VolumeAPI.UpdateVolume(ctx, volumeId).
+				XAuthToken(r.kc.XAuthToken).
+				BodyUpdateVolume(body).
+				Execute()
+		},
+	)
+
+	if err != nil {
+		common.AddApiActionError(ctx, r, httpResp, "UpdateVolume", err, diags)
+		return false
+	}
+
+	return true
+}
@@ -0,0 +1,91 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+package volume_test
+
+import (
+	"fmt"
+	"terraform-provider-kakaocloud/internal/acctest"
+	"terraform-provider-kakaocloud/internal/acctest/mockserver"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+const testAccVolumeDefaultTags = `
+  default_tags {
+    tags = {
+      owner = "platform"
+    }
+  }
+`
+
+func TestAccVolumeResource_tags(t *testing.T) {
+	server := acctest.NewMockServer(t)
+	resourceName := "kakaocloud_volume.test"
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		CheckDestroy: func(s *terraform.State) error {
+			if n := server.Collection("volume", "volumes").Len(); n != 0 {
+				return fmt.Errorf("expected all volumes to be destroyed, %d left", n)
+			}
+			return nil
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: acctest.ProviderConfig(server, testAccVolumeDefaultTags) + testAccVolumeResourceConfig(10, "dev"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "status", "available"),
+					resource.TestCheckResourceAttr(resourceName, "size", "10"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.environment", "dev"),
+					resource.TestCheckResourceAttr(resourceName, "tags_all.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags_all.owner", "platform"),
+					testAccCheckVolumeMetadata(server, resourceName, "environment", "dev"),
+				),
+			},
+			{
+				Config: acctest.ProviderConfig(server, testAccVolumeDefaultTags) + testAccVolumeResourceConfig(20, "prod"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "size", "20"),
+					resource.TestCheckResourceAttr(resourceName, "tags.environment", "prod"),
+					resource.TestCheckResourceAttr(resourceName, "tags_all.owner", "platform"),
+					testAccCheckVolumeMetadata(server, resourceName, "environment", "prod"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckVolumeMetadata(server *mockserver.Server, resourceName string, key string, value string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("resource not found: %s", resourceName)
+		}
+		obj, ok := server.Collection("volume", "volumes").Get(rs.Primary.ID)
+		if !ok {
+			return fmt.Errorf("volume %s not found in mock server", rs.Primary.ID)
+		}
+		metadata, _ := obj["metadata"].(map[string]any)
+		if metadata[key] != value {
+			return fmt.Errorf("expected metadata %s=%s, got %v", key, value, metadata[key])
+		}
+		return nil
+	}
+}
+
+func testAccVolumeResourceConfig(size int, environment string) string {
+	return fmt.Sprintf(`
+resource "kakaocloud_volume" "test" {
+  name              = "acc-volume"
+  availability_zone = "kr-central-2-a"
+  size              = %d
+
+  tags = {
+    environment = %q
+  }
+}
+`, size, environment)
+}
@@ -0,0 +1,306 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+package volume
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+	"terraform-provider-kakaocloud/internal/common"
+	"terraform-provider-kakaocloud/internal/utils"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/kakaoenterprise/kc-sdk-go/services/volume"
+)
+
+var (
+	_ resource.ResourceWithConfigure   = &volumeSnapshotCopyResource{}
+	_ resource.ResourceWithImportState = &volumeSnapshotCopyResource{}
+)
+
+func NewVolumeSnapshotCopyResource() resource.Resource {
+	return &volumeSnapshotCopyResource{}
+}
+
+type volumeSnapshotCopyResource struct {
+	kc *common.KakaoCloudClient
+}
+
+func (r *volumeSnapshotCopyResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_volume_snapshot_copy"
+}
+
+func (r *volumeSnapshotCopyResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: utils.MergeResourceSchemaAttributes(
+			volumeSnapshotCopyResourceSchemaAttributes,
+			map[string]schema.Attribute{
+				"timeouts": timeouts.AttributesAll(ctx),
+			},
+		),
+	}
+}
+
+func (r *volumeSnapshotCopyResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan volumeSnapshotCopyResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	timeout, diags := plan.Timeouts.Create(ctx, common.DefaultCreateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	destKc, ok := r.destinationClient(ctx, plan.DestinationRegion.ValueString(), &resp.Diagnostics)
+	if !ok {
+		return
+	}
+
+	_, ok = CheckVolumeSnapshotStatus(ctx, r.kc, r, plan.SourceSnapshotId.ValueString(), false, &resp.Diagnostics)
+	if !ok || resp.Diagnostics.HasError() {
+		return
+	}
+
+	copyReq := volume.CopyVolumeSnapshotModel{
+		DestinationRegion: plan.DestinationRegion.ValueString(),
+	}
+	if !plan.Name.IsNull() && !plan.Name.IsUnknown() {
+		copyReq.SetName(plan.Name.ValueString())
+	}
+	if !plan.Description.IsNull() && !plan.Description.IsUnknown() {
+		copyReq.SetDescription(plan.Description.ValueString())
+	}
+	body := *volume.NewBodyCopySnapshot(copyReq)
+
+	respModel, httpResp, err := common.ExecuteWithRetryAndAuth(ctx, r.kc, &resp.Diagnostics, common.ServiceVolume,
+		func() (*volume.BcsVolumeV1ApiCopySnapshotModelResponseVolumeSnapshotModel, *http.Response, error) {
+			return r.kc.ApiClient.VolumeSnapshotAPI.
+				CopySnapshot(ctx, plan.SourceSnapshotId.ValueString()).
+				XAuthToken(r.kc.XAuthToken).
+				BodyCopySnapshot(body).
+				Execute()
+		},
+	)
+	if err != nil {
+		common.AddApiActionError(ctx, r, httpResp, "CopySnapshot", err, &resp.Diagnostics)
+		return
+	}
+
+	plan.Id = types.StringValue(respModel.Snapshot.Id)
+
+	// Save the copy right away, so that a copy which fails to become available is still destroyed.
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), plan.Id)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("source_snapshot_id"), plan.SourceSnapshotId)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("destination_region"), plan.DestinationRegion)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	result, ok := CheckVolumeSnapshotStatus(ctx, destKc, r, plan.Id.ValueString(), false, &resp.Diagnostics)
+	if !ok || resp.Diagnostics.HasError() {
+		return
+	}
+
+	mapVolumeSnapshotCopyModel(&plan, result)
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *volumeSnapshotCopyResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state volumeSnapshotCopyResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	timeout, diags := state.Timeouts.Read(ctx, common.DefaultReadTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	destKc, ok := r.destinationClient(ctx, state.DestinationRegion.ValueString(), &resp.Diagnostics)
+	if !ok {
+		return
+	}
+
+	respModel, httpResp, err := common.ExecuteWithRetryAndAuth(ctx, destKc, &resp.Diagnostics, common.ServiceVolume,
+		func() (*volume.BcsVolumeV1ApiGetSnapshotModelResponseVolumeSnapshotModel, *http.Response, error) {
+			return destKc.ApiClient.VolumeSnapshotAPI.
+				GetSnapshot(ctx, state.Id.ValueString()).
+				XAuthToken(destKc.XAuthToken).
+				Execute()
+		},
+	)
+	if httpResp != nil && httpResp.StatusCode == http.StatusNotFound {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		common.AddApiActionError(ctx, r, httpResp, "GetSnapshot", err, &resp.Diagnostics)
+		return
+	}
+
+	mapVolumeSnapshotCopyModel(&state, &respModel.Snapshot)
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Update only changes timeouts, as every other argument forces a new copy. The copy is read
+// again so that no computed attribute is left unknown.
+func (r *volumeSnapshotCopyResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan volumeSnapshotCopyResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	timeout, diags := plan.Timeouts.Update(ctx, common.DefaultUpdateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	destKc, ok := r.destinationClient(ctx, plan.DestinationRegion.ValueString(), &resp.Diagnostics)
+	if !ok {
+		return
+	}
+
+	respModel, httpResp, err := common.ExecuteWithRetryAndAuth(ctx, destKc, &resp.Diagnostics, common.ServiceVolume,
+		func() (*volume.BcsVolumeV1ApiGetSnapshotModelResponseVolumeSnapshotModel, *http.Response, error) {
+			return destKc.ApiClient.VolumeSnapshotAPI.
+				GetSnapshot(ctx, plan.Id.ValueString()).
+				XAuthToken(destKc.XAuthToken).
+				Execute()
+		},
+	)
+	if err != nil {
+		common.AddApiActionError(ctx, r, httpResp, "GetSnapshot", err, &resp.Diagnostics)
+		return
+	}
+
+	mapVolumeSnapshotCopyModel(&plan, &respModel.Snapshot)
+
+	diags = resp.State.Set(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *volumeSnapshotCopyResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state volumeSnapshotCopyResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	timeout, diags := state.Timeouts.Delete(ctx, common.DefaultDeleteTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	destKc, ok := r.destinationClient(ctx, state.DestinationRegion.ValueString(), &resp.Diagnostics)
+	if !ok {
+		return
+	}
+
+	_, ok = CheckVolumeSnapshotStatus(ctx, destKc, r, state.Id.ValueString(), true, &resp.Diagnostics)
+	if !ok || resp.Diagnostics.HasError() {
+		return
+	}
+
+	_, httpResp, err := common.ExecuteWithRetryAndAuth(ctx, destKc, &resp.Diagnostics, common.ServiceVolume,
+		func() (interface{}, *http.Response, error) {
+			return destKc.ApiClient.VolumeSnapshotAPI.
+				DeleteSnapshot(ctx, state.Id.ValueString()).
+				XAuthToken(destKc.XAuthToken).
+				Execute()
+		},
+	)
+	if err != nil {
+		if httpResp != nil && httpResp.StatusCode == http.StatusNotFound {
+			return
+		}
+		common.AddApiActionError(ctx, r, httpResp, "DeleteSnapshot", err, &resp.Diagnostics)
+		return
+	}
+
+	common.PollUntilDeletion(ctx, r, 2*time.Second, &resp.Diagnostics, func(ctx context.Context) (bool, *http.Response, error) {
+		_, httpResp, err := common.ExecuteWithRetryAndAuth(ctx, destKc, &resp.Diagnostics, common.ServiceVolume,
+			func() (*volume.BcsVolumeV1ApiGetSnapshotModelResponseVolumeSnapshotModel, *http.Response, error) {
+				_, httpResp, err := destKc.ApiClient.VolumeSnapshotAPI.
+					GetSnapshot(ctx, state.Id.ValueString()).
+					XAuthToken(destKc.XAuthToken).
+					Execute()
+				return nil, httpResp, err
+			},
+		)
+		return false, httpResp, err
+	})
+}
+
+func (r *volumeSnapshotCopyResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*common.KakaoCloudClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *common.KakaoCloudClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.kc = client
+}
+
+func (r *volumeSnapshotCopyResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	parts := strings.Split(req.ID, "/")
+	if len(parts) != 3 || parts[0] == "" || parts[1] == "" || parts[2] == "" {
+		common.AddImportFormatError(ctx, r, &resp.Diagnostics,
+			"Expected import ID in the format: source_snapshot_id/destination_region/snapshot_id")
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("source_snapshot_id"), parts[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("destination_region"), parts[1])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), parts[2])...)
+}
+
+func (r *volumeSnapshotCopyResource) destinationClient(ctx context.Context, region string, respDiags *diag.Diagnostics) (*common.KakaoCloudClient, bool) {
+	kc, err := r.kc.ForRegion(ctx, region)
+	if err != nil {
+		common.AddGeneralError(ctx, r, respDiags, err.Error())
+		return nil, false
+	}
+	return kc, true
+}
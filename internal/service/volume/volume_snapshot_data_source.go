@@ -65,7 +65,7 @@ func (d *volumeSnapshotDataSource) Read(ctx context.Context, req datasource.Read
 	ctx, cancel := context.WithTimeout(ctx, timeout)
 	defer cancel()
 
-	respModel, httpResp, err := common.ExecuteWithRetryAndAuth(ctx, d.kc, &resp.Diagnostics,
+	respModel, httpResp, err := common.ExecuteWithRetryAndAuth(ctx, d.kc, &resp.Diagnostics, common.ServiceVolume,
 		func() (*volume.BcsVolumeV1ApiGetSnapshotModelResponseVolumeSnapshotModel, *http.Response, error) {
 			return d.kc.ApiClient.VolumeSnapshotAPI.GetSnapshot(ctx, config.Id.ValueString()).
 				XAuthToken(d.kc.XAuthToken).Execute()
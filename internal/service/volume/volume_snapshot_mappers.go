@@ -36,3 +36,16 @@ func mapVolumeSnapshotBaseModel(
 
 	return true
 }
+
+func mapVolumeSnapshotCopyModel(
+	model *volumeSnapshotCopyResourceModel,
+	snapshotResult *volume.BcsVolumeV1ApiGetSnapshotModelVolumeSnapshotModel,
+) {
+	model.Id = types.StringValue(snapshotResult.Id)
+	model.Name = ConvertNullableString(snapshotResult.Name)
+	model.Description = ConvertNullableString(snapshotResult.Description)
+	model.Size = ConvertNullableInt64(snapshotResult.Size)
+	model.Status = ConvertNullableString(snapshotResult.Status)
+	model.ProjectId = ConvertNullableString(snapshotResult.ProjectId)
+	model.CreatedAt = ConvertNullableTime(snapshotResult.CreatedAt)
+}
@@ -43,3 +43,16 @@ type volumeSnapshotsDataSourceModel struct {
 	VolumeSnapshots []volumeSnapshotBaseModel `tfsdk:"volume_snapshots"`
 	Timeouts        datasourceTimeouts.Value  `tfsdk:"timeouts"`
 }
+
+type volumeSnapshotCopyResourceModel struct {
+	Id                types.String           `tfsdk:"id"`
+	SourceSnapshotId  types.String           `tfsdk:"source_snapshot_id"`
+	DestinationRegion types.String           `tfsdk:"destination_region"`
+	Name              types.String           `tfsdk:"name"`
+	Description       types.String           `tfsdk:"description"`
+	Size              types.Int64            `tfsdk:"size"`
+	Status            types.String           `tfsdk:"status"`
+	ProjectId         types.String           `tfsdk:"project_id"`
+	CreatedAt         types.String           `tfsdk:"created_at"`
+	Timeouts          resourceTimeouts.Value `tfsdk:"timeouts"`
+}
@@ -82,7 +82,7 @@ func (r *volumeSnapshotResource) Create(ctx context.Context, req resource.Create
 		return
 	}
 
-	respModel, httpResp, err := common.ExecuteWithRetryAndAuth(ctx, r.kc, &resp.Diagnostics,
+	respModel, httpResp, err := common.ExecuteWithRetryAndAuth(ctx, r.kc, &resp.Diagnostics, common.ServiceVolume,
 		func() (*volume.ResponseCreateVolumeSnapshotModel, *http.Response, error) {
 			return r.kc.ApiClient.VolumeAPI.CreateSnapshot(ctx, plan.VolumeId.ValueString()).
 				XAuthToken(r.kc.XAuthToken).BodyCreateSnapshot(body).Execute()
@@ -131,7 +131,7 @@ func (r *volumeSnapshotResource) Read(ctx context.Context, req resource.ReadRequ
 	ctx, cancel := context.WithTimeout(ctx, timeout)
 	defer cancel()
 
-	respModel, httpResp, err := common.ExecuteWithRetryAndAuth(ctx, r.kc, &resp.Diagnostics,
+	respModel, httpResp, err := common.ExecuteWithRetryAndAuth(ctx, r.kc, &resp.Diagnostics, common.ServiceVolume,
 		func() (*volume.BcsVolumeV1ApiGetSnapshotModelResponseVolumeSnapshotModel, *http.Response, error) {
 			return r.kc.ApiClient.VolumeSnapshotAPI.GetSnapshot(ctx, state.Id.ValueString()).
 				XAuthToken(r.kc.XAuthToken).Execute()
@@ -203,7 +203,7 @@ func (r *volumeSnapshotResource) Update(ctx context.Context, req resource.Update
 
 		body := *volume.NewBodyUpdateSnapshot(editReq)
 
-		_, httpResp, err := common.ExecuteWithRetryAndAuth(ctx, r.kc, &resp.Diagnostics,
+		_, httpResp, err := common.ExecuteWithRetryAndAuth(ctx, r.kc, &resp.Diagnostics, common.ServiceVolume,
 			func() (*volume.BcsVolumeV1ApiUpdateSnapshotModelResponseVolumeSnapshotModel, *http.Response, error) {
 				return r.kc.ApiClient.VolumeSnapshotAPI.UpdateSnapshot(ctx, plan.Id.ValueString()).
 					XAuthToken(r.kc.XAuthToken).
@@ -247,7 +247,7 @@ func (r *volumeSnapshotResource) Delete(ctx context.Context, req resource.Delete
 		return
 	}
 
-	_, httpResp, err := common.ExecuteWithRetryAndAuth(ctx, r.kc, &resp.Diagnostics,
+	_, httpResp, err := common.ExecuteWithRetryAndAuth(ctx, r.kc, &resp.Diagnostics, common.ServiceVolume,
 		func() (interface{}, *http.Response, error) {
 			return r.kc.ApiClient.VolumeSnapshotAPI.DeleteSnapshot(ctx, state.Id.ValueString()).
 				XAuthToken(r.kc.XAuthToken).
@@ -263,7 +263,7 @@ func (r *volumeSnapshotResource) Delete(ctx context.Context, req resource.Delete
 	}
 
 	common.PollUntilDeletion(ctx, r, 2*time.Second, &resp.Diagnostics, func(ctx context.Context) (bool, *http.Response, error) {
-		_, httpResp, err := common.ExecuteWithRetryAndAuth(ctx, r.kc, &resp.Diagnostics,
+		_, httpResp, err := common.ExecuteWithRetryAndAuth(ctx, r.kc, &resp.Diagnostics, common.ServiceVolume,
 			func() (*volume.BcsVolumeV1ApiGetSnapshotModelResponseVolumeSnapshotModel, *http.Response, error) {
 				_, httpResp, err := r.kc.ApiClient.VolumeSnapshotAPI.
 					GetSnapshot(ctx, state.Id.ValueString()).
@@ -315,7 +315,7 @@ func (r *volumeSnapshotResource) checkVolumeStatus(
 		[]string{common.VolumeStatusAvailable, common.VolumeStatusInUse, common.VolumeStatusError, common.VolumeStatusErrorRestore, common.VolumeStatusDeleting},
 		&resp.Diagnostics,
 		func(ctx context.Context) (*volume.BcsVolumeV1ApiGetVolumeModelVolumeModel, *http.Response, error) {
-			respModel, httpResp, err := common.ExecuteWithRetryAndAuth(ctx, r.kc, &resp.Diagnostics,
+			respModel, httpResp, err := common.ExecuteWithRetryAndAuth(ctx, r.kc, &resp.Diagnostics, common.ServiceVolume,
 				func() (*volume.BcsVolumeV1ApiGetVolumeModelResponseVolumeModel, *http.Response, error) {
 					return r.kc.ApiClient.VolumeAPI.
 						GetVolume(ctx, volumeId).
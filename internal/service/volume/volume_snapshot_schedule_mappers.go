@@ -0,0 +1,44 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+package volume
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/kakaoenterprise/kc-sdk-go/services/volume"
+	. "terraform-provider-kakaocloud/internal/utils"
+)
+
+func mapVolumeSnapshotScheduleModel(
+	ctx context.Context,
+	model *volumeSnapshotScheduleResourceModel,
+	scheduleResult *volume.BcsVolumeV1ApiGetSnapshotScheduleModelSnapshotScheduleModel,
+	respDiags *diag.Diagnostics,
+) bool {
+	model.Id = types.StringValue(scheduleResult.Id)
+	model.Name = ConvertNullableString(scheduleResult.Name)
+	model.Description = ConvertNullableStringWithEmptyToNull(scheduleResult.Description)
+	model.Frequency = ConvertNullableString(scheduleResult.Frequency)
+	model.StartTime = ConvertNullableString(scheduleResult.StartTime)
+	model.DayOfWeek = ConvertNullableString(scheduleResult.DayOfWeek)
+	model.DayOfMonth = ConvertNullableInt32(scheduleResult.DayOfMonth)
+	model.RetentionCount = ConvertNullableInt32(scheduleResult.RetentionCount)
+	model.IsIncremental = ConvertNullableBool(scheduleResult.IsIncremental)
+	model.ProjectId = ConvertNullableString(scheduleResult.ProjectId)
+	model.CreatedAt = ConvertNullableTime(scheduleResult.CreatedAt)
+	model.UpdatedAt = ConvertNullableTime(scheduleResult.UpdatedAt)
+
+	if len(scheduleResult.VolumeIds) > 0 || !model.VolumeIds.IsNull() {
+		volumeIds, diags := SetFromStrings(ctx, scheduleResult.VolumeIds)
+		respDiags.Append(diags...)
+		model.VolumeIds = volumeIds
+	}
+
+	if respDiags.HasError() {
+		return false
+	}
+
+	return true
+}
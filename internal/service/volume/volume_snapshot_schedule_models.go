@@ -0,0 +1,33 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+package volume
+
+import (
+	datasourceTimeouts "github.com/hashicorp/terraform-plugin-framework-timeouts/datasource/timeouts"
+	resourceTimeouts "github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type volumeSnapshotScheduleResourceModel struct {
+	Id             types.String           `tfsdk:"id"`
+	Name           types.String           `tfsdk:"name"`
+	Description    types.String           `tfsdk:"description"`
+	Frequency      types.String           `tfsdk:"frequency"`
+	StartTime      types.String           `tfsdk:"start_time"`
+	DayOfWeek      types.String           `tfsdk:"day_of_week"`
+	DayOfMonth     types.Int32            `tfsdk:"day_of_month"`
+	RetentionCount types.Int32            `tfsdk:"retention_count"`
+	IsIncremental  types.Bool             `tfsdk:"is_incremental"`
+	VolumeIds      types.Set              `tfsdk:"volume_ids"`
+	ProjectId      types.String           `tfsdk:"project_id"`
+	CreatedAt      types.String           `tfsdk:"created_at"`
+	UpdatedAt      types.String           `tfsdk:"updated_at"`
+	Timeouts       resourceTimeouts.Value `tfsdk:"timeouts"`
+}
+
+type volumeSnapshotScheduleSnapshotsDataSourceModel struct {
+	ScheduleId      types.String              `tfsdk:"schedule_id"`
+	VolumeId        types.String              `tfsdk:"volume_id"`
+	VolumeSnapshots []volumeSnapshotBaseModel `tfsdk:"volume_snapshots"`
+	Timeouts        datasourceTimeouts.Value  `tfsdk:"timeouts"`
+}
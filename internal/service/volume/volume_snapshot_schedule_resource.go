@@ -0,0 +1,430 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+package volume
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"slices"
+	"terraform-provider-kakaocloud/internal/common"
+	"terraform-provider-kakaocloud/internal/utils"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/kakaoenterprise/kc-sdk-go/services/volume"
+)
+
+var (
+	_ resource.ResourceWithConfigure      = &volumeSnapshotScheduleResource{}
+	_ resource.ResourceWithImportState    = &volumeSnapshotScheduleResource{}
+	_ resource.ResourceWithValidateConfig = &volumeSnapshotScheduleResource{}
+)
+
+func NewVolumeSnapshotScheduleResource() resource.Resource {
+	return &volumeSnapshotScheduleResource{}
+}
+
+type volumeSnapshotScheduleResource struct {
+	kc *common.KakaoCloudClient
+}
+
+func (r *volumeSnapshotScheduleResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_volume_snapshot_schedule"
+}
+
+func (r *volumeSnapshotScheduleResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: utils.MergeResourceSchemaAttributes(
+			volumeSnapshotScheduleResourceSchemaAttributes,
+			map[string]schema.Attribute{
+				"timeouts": timeouts.AttributesAll(ctx),
+			},
+		),
+	}
+}
+
+func (r *volumeSnapshotScheduleResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config volumeSnapshotScheduleResourceModel
+	diags := req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() || config.Frequency.IsUnknown() {
+		return
+	}
+
+	switch config.Frequency.ValueString() {
+	case SnapshotScheduleFrequencyDaily:
+		if !config.DayOfWeek.IsNull() || !config.DayOfMonth.IsNull() {
+			common.AddValidationConfigError(ctx, r, &resp.Diagnostics,
+				"Invalid Configuration: day_of_week and day_of_month cannot be set for a daily schedule.")
+		}
+	case SnapshotScheduleFrequencyWeekly:
+		if config.DayOfWeek.IsNull() || !config.DayOfMonth.IsNull() {
+			common.AddValidationConfigError(ctx, r, &resp.Diagnostics,
+				"Invalid Configuration: A weekly schedule requires day_of_week and cannot set day_of_month.")
+		}
+	case SnapshotScheduleFrequencyMonthly:
+		if config.DayOfMonth.IsNull() || !config.DayOfWeek.IsNull() {
+			common.AddValidationConfigError(ctx, r, &resp.Diagnostics,
+				"Invalid Configuration: A monthly schedule requires day_of_month and cannot set day_of_week.")
+		}
+	}
+}
+
+func (r *volumeSnapshotScheduleResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan volumeSnapshotScheduleResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	timeout, diags := plan.Timeouts.Create(ctx, common.DefaultCreateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	createReq := volume.CreateSnapshotScheduleModel{
+		Name:           plan.Name.ValueString(),
+		Frequency:      plan.Frequency.ValueString(),
+		StartTime:      plan.StartTime.ValueString(),
+		RetentionCount: plan.RetentionCount.ValueInt32(),
+		IsIncremental:  plan.IsIncremental.ValueBool(),
+	}
+	if !plan.Description.IsNull() && !plan.Description.IsUnknown() {
+		createReq.SetDescription(plan.Description.ValueString())
+	}
+	if !plan.DayOfWeek.IsNull() {
+		createReq.SetDayOfWeek(plan.DayOfWeek.ValueString())
+	}
+	if !plan.DayOfMonth.IsNull() {
+		createReq.SetDayOfMonth(plan.DayOfMonth.ValueInt32())
+	}
+
+	body := volume.BodyCreateSnapshotSchedule{
+		Schedule: createReq,
+	}
+
+	respModel, httpResp, err := common.ExecuteWithRetryAndAuth(ctx, r.kc, &resp.Diagnostics, common.ServiceVolume,
+		func() (*volume.ResponseSnapshotScheduleModel, *http.Response, error) {
+			return r.kc.ApiClient.VolumeSnapshotScheduleAPI.CreateSnapshotSchedule(ctx).
+				XAuthToken(r.kc.XAuthToken).BodyCreateSnapshotSchedule(body).Execute()
+		},
+	)
+	if err != nil {
+		common.AddApiActionError(ctx, r, httpResp, "CreateSnapshotSchedule", err, &resp.Diagnostics)
+		return
+	}
+
+	plan.Id = types.StringValue(respModel.Schedule.Id)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), plan.Id)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	volumeIds := utils.StringsFromSet(ctx, plan.VolumeIds, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ok := r.updateScheduleVolumes(ctx, plan.Id.ValueString(), volumeIds, nil, &resp.Diagnostics)
+	if !ok || resp.Diagnostics.HasError() {
+		return
+	}
+
+	result, ok := r.getSnapshotSchedule(ctx, plan.Id.ValueString(), &resp.Diagnostics)
+	if !ok || resp.Diagnostics.HasError() {
+		return
+	}
+
+	ok = mapVolumeSnapshotScheduleModel(ctx, &plan, result, &resp.Diagnostics)
+	if !ok || resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *volumeSnapshotScheduleResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state volumeSnapshotScheduleResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	timeout, diags := state.Timeouts.Read(ctx, common.DefaultReadTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	respModel, httpResp, err := common.ExecuteWithRetryAndAuth(ctx, r.kc, &resp.Diagnostics, common.ServiceVolume,
+		func() (*volume.ResponseSnapshotScheduleModel, *http.Response, error) {
+			return r.kc.ApiClient.VolumeSnapshotScheduleAPI.GetSnapshotSchedule(ctx, state.Id.ValueString()).
+				XAuthToken(r.kc.XAuthToken).Execute()
+		},
+	)
+	if common.IsNotFound(err) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		common.AddApiActionError(ctx, r, httpResp, "GetSnapshotSchedule", err, &resp.Diagnostics)
+		return
+	}
+
+	ok := mapVolumeSnapshotScheduleModel(ctx, &state, &respModel.Schedule, &resp.Diagnostics)
+	if !ok || resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *volumeSnapshotScheduleResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state volumeSnapshotScheduleResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	timeout, diags := plan.Timeouts.Update(ctx, common.DefaultUpdateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	if editReq, changed := newSnapshotScheduleEditRequest(&plan, &state); changed {
+		body := *volume.NewBodyUpdateSnapshotSchedule(editReq)
+
+		_, httpResp, err := common.ExecuteWithRetryAndAuth(ctx, r.kc, &resp.Diagnostics, common.ServiceVolume,
+			func() (*volume.ResponseSnapshotScheduleModel, *http.Response, error) {
+				return r.kc.ApiClient.VolumeSnapshotScheduleAPI.UpdateSnapshotSchedule(ctx, plan.Id.ValueString()).
+					XAuthToken(r.kc.XAuthToken).
+					BodyUpdateSnapshotSchedule(body).
+					Execute()
+			},
+		)
+		if err != nil {
+			common.AddApiActionError(ctx, r, httpResp, "UpdateSnapshotSchedule", err, &resp.Diagnostics)
+			return
+		}
+	}
+
+	if !plan.VolumeIds.Equal(state.VolumeIds) {
+		planIds := utils.StringsFromSet(ctx, plan.VolumeIds, &resp.Diagnostics)
+		stateIds := utils.StringsFromSet(ctx, state.VolumeIds, &resp.Diagnostics)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		ok := r.updateScheduleVolumes(ctx, plan.Id.ValueString(), planIds, stateIds, &resp.Diagnostics)
+		if !ok || resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	result, ok := r.getSnapshotSchedule(ctx, plan.Id.ValueString(), &resp.Diagnostics)
+	if !ok || resp.Diagnostics.HasError() {
+		return
+	}
+
+	ok = mapVolumeSnapshotScheduleModel(ctx, &plan, result, &resp.Diagnostics)
+	if !ok || resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.State.Set(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *volumeSnapshotScheduleResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state volumeSnapshotScheduleResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	timeout, diags := state.Timeouts.Delete(ctx, common.DefaultDeleteTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	_, httpResp, err := common.ExecuteWithRetryAndAuth(ctx, r.kc, &resp.Diagnostics, common.ServiceVolume,
+		func() (interface{}, *http.Response, error) {
+			return r.kc.ApiClient.VolumeSnapshotScheduleAPI.DeleteSnapshotSchedule(ctx, state.Id.ValueString()).
+				XAuthToken(r.kc.XAuthToken).
+				Execute()
+		},
+	)
+	if err != nil {
+		if common.IsNotFound(err) {
+			return
+		}
+		common.AddApiActionError(ctx, r, httpResp, "DeleteSnapshotSchedule", err, &resp.Diagnostics)
+		return
+	}
+
+	common.PollUntilDeletion(ctx, r, 2*time.Second, &resp.Diagnostics, func(ctx context.Context) (bool, *http.Response, error) {
+		_, httpResp, err := common.ExecuteWithRetryAndAuth(ctx, r.kc, &resp.Diagnostics, common.ServiceVolume,
+			func() (*volume.ResponseSnapshotScheduleModel, *http.Response, error) {
+				return r.kc.ApiClient.VolumeSnapshotScheduleAPI.
+					GetSnapshotSchedule(ctx, state.Id.ValueString()).
+					XAuthToken(r.kc.XAuthToken).
+					Execute()
+			},
+		)
+		return false, httpResp, err
+	})
+}
+
+func (r *volumeSnapshotScheduleResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*common.KakaoCloudClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *common.KakaoCloudClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.kc = client
+}
+
+func (r *volumeSnapshotScheduleResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+// newSnapshotScheduleEditRequest returns the update request for the schedule settings in plan, and
+// whether any of them changed. A description removed from the configuration is cleared.
+func newSnapshotScheduleEditRequest(plan, state *volumeSnapshotScheduleResourceModel) (volume.EditSnapshotScheduleModel, bool) {
+	editReq := volume.EditSnapshotScheduleModel{}
+	if plan.Name.Equal(state.Name) &&
+		plan.Description.Equal(state.Description) &&
+		plan.Frequency.Equal(state.Frequency) &&
+		plan.StartTime.Equal(state.StartTime) &&
+		plan.DayOfWeek.Equal(state.DayOfWeek) &&
+		plan.DayOfMonth.Equal(state.DayOfMonth) &&
+		plan.RetentionCount.Equal(state.RetentionCount) {
+		return editReq, false
+	}
+
+	if !plan.Name.Equal(state.Name) {
+		editReq.SetName(plan.Name.ValueString())
+	}
+	if !plan.Description.Equal(state.Description) {
+		if !plan.Description.IsNull() && !plan.Description.IsUnknown() {
+			editReq.SetDescription(plan.Description.ValueString())
+		} else {
+			editReq.SetDescription("")
+		}
+	}
+	editReq.SetFrequency(plan.Frequency.ValueString())
+	editReq.SetStartTime(plan.StartTime.ValueString())
+	if plan.DayOfWeek.IsNull() {
+		editReq.SetDayOfWeekNil()
+	} else {
+		editReq.SetDayOfWeek(plan.DayOfWeek.ValueString())
+	}
+	if plan.DayOfMonth.IsNull() {
+		editReq.SetDayOfMonthNil()
+	} else {
+		editReq.SetDayOfMonth(plan.DayOfMonth.ValueInt32())
+	}
+	editReq.SetRetentionCount(plan.RetentionCount.ValueInt32())
+	return editReq, true
+}
+
+func (r *volumeSnapshotScheduleResource) getSnapshotSchedule(
+	ctx context.Context,
+	scheduleId string,
+	respDiags *diag.Diagnostics,
+) (*volume.BcsVolumeV1ApiGetSnapshotScheduleModelSnapshotScheduleModel, bool) {
+	respModel, httpResp, err := common.ExecuteWithRetryAndAuth(ctx, r.kc, respDiags, common.ServiceVolume,
+		func() (*volume.ResponseSnapshotScheduleModel, *http.Response, error) {
+			return r.kc.ApiClient.VolumeSnapshotScheduleAPI.GetSnapshotSchedule(ctx, scheduleId).
+				XAuthToken(r.kc.XAuthToken).Execute()
+		},
+	)
+	if err != nil {
+		common.AddApiActionError(ctx, r, httpResp, "GetSnapshotSchedule", err, respDiags)
+		return nil, false
+	}
+	return &respModel.Schedule, true
+}
+
+func (r *volumeSnapshotScheduleResource) updateScheduleVolumes(
+	ctx context.Context,
+	scheduleId string,
+	planIds []string,
+	stateIds []string,
+	respDiags *diag.Diagnostics,
+) bool {
+	for _, volumeId := range stateIds {
+		if slices.Contains(planIds, volumeId) {
+			continue
+		}
+		_, httpResp, err := common.ExecuteWithRetryAndAuth(ctx, r.kc, respDiags, common.ServiceVolume,
+			func() (interface{}, *http.Response, error) {
+				return r.kc.ApiClient.VolumeSnapshotScheduleAPI.RemoveSnapshotScheduleVolume(ctx, scheduleId, volumeId).
+					XAuthToken(r.kc.XAuthToken).
+					Execute()
+			},
+		)
+		if err != nil && !common.IsNotFound(err) {
+			common.AddApiActionError(ctx, r, httpResp, "RemoveSnapshotScheduleVolume", err, respDiags)
+			return false
+		}
+	}
+
+	for _, volumeId := range planIds {
+		if slices.Contains(stateIds, volumeId) {
+			continue
+		}
+		_, httpResp, err := common.ExecuteWithRetryAndAuth(ctx, r.kc, respDiags, common.ServiceVolume,
+			func() (interface{}, *http.Response, error) {
+				return r.kc.ApiClient.VolumeSnapshotScheduleAPI.AddSnapshotScheduleVolume(ctx, scheduleId, volumeId).
+					XAuthToken(r.kc.XAuthToken).
+					Execute()
+			},
+		)
+		if err != nil {
+			common.AddApiActionError(ctx, r, httpResp, "AddSnapshotScheduleVolume", err, respDiags)
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,134 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+package volume
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/kakaoenterprise/kc-sdk-go/services/volume"
+)
+
+func testSnapshotScheduleModel(description types.String) volumeSnapshotScheduleResourceModel {
+	return volumeSnapshotScheduleResourceModel{
+		Name:           types.StringValue("nightly"),
+		Description:    description,
+		Frequency:      types.StringValue(SnapshotScheduleFrequencyDaily),
+		StartTime:      types.StringValue("02:00"),
+		DayOfWeek:      types.StringNull(),
+		DayOfMonth:     types.Int32Null(),
+		RetentionCount: types.Int32Value(7),
+	}
+}
+
+func TestNewSnapshotScheduleEditRequest(t *testing.T) {
+	cases := []struct {
+		name            string
+		plan            types.String
+		state           types.String
+		wantChanged     bool
+		wantDescription *string
+	}{
+		{"unchanged", types.StringValue("db"), types.StringValue("db"), false, nil},
+		{"unchanged without description", types.StringNull(), types.StringNull(), false, nil},
+		{"set", types.StringValue("db"), types.StringNull(), true, ptr("db")},
+		{"changed", types.StringValue("db backups"), types.StringValue("db"), true, ptr("db backups")},
+		{"cleared", types.StringNull(), types.StringValue("db"), true, ptr("")},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			plan := testSnapshotScheduleModel(tc.plan)
+			state := testSnapshotScheduleModel(tc.state)
+			editReq, changed := newSnapshotScheduleEditRequest(&plan, &state)
+			if changed != tc.wantChanged {
+				t.Fatalf("changed = %v, want %v", changed, tc.wantChanged)
+			}
+			if !changed {
+				return
+			}
+			if editReq.HasName() {
+				t.Errorf("name was sent although it did not change")
+			}
+			if tc.wantDescription == nil {
+				if editReq.HasDescription() {
+					t.Errorf("description was sent although it did not change")
+				}
+				return
+			}
+			if !editReq.HasDescription() || editReq.GetDescription() != *tc.wantDescription {
+				t.Errorf("description = %q, want %q", editReq.GetDescription(), *tc.wantDescription)
+			}
+		})
+	}
+
+	plan := testSnapshotScheduleModel(types.StringNull())
+	state := testSnapshotScheduleModel(types.StringNull())
+	plan.RetentionCount = types.Int32Value(14)
+	if editReq, changed := newSnapshotScheduleEditRequest(&plan, &state); !changed || editReq.GetRetentionCount() != 14 {
+		t.Errorf("retention count change: changed = %v, retention_count = %d", changed, editReq.GetRetentionCount())
+	}
+}
+
+func TestMapVolumeSnapshotScheduleModel(t *testing.T) {
+	cases := []struct {
+		name            string
+		description     string
+		volumeIds       string
+		stateVolumeIds  types.Set
+		wantDescription types.String
+		wantVolumeIds   int
+	}{
+		{"description", `"db"`, `["5e4c3b2a-1f0e-4d9c-8b7a-6f5e4d3c2b1a"]`, types.SetNull(types.StringType), types.StringValue("db"), 1},
+		{"empty description", `""`, `[]`, types.SetNull(types.StringType), types.StringNull(), -1},
+		{"null description", `null`, `[]`, types.SetNull(types.StringType), types.StringNull(), -1},
+		{"volumes removed", `null`, `[]`, types.SetValueMust(types.StringType, nil), types.StringNull(), 0},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			var result volume.BcsVolumeV1ApiGetSnapshotScheduleModelSnapshotScheduleModel
+			body := `{
+  "id": "0b7d3c1e-2f4a-4c5b-8d6e-7f8091a2b3c4",
+  "name": "nightly",
+  "description": ` + tc.description + `,
+  "frequency": "daily",
+  "start_time": "02:00",
+  "day_of_week": null,
+  "day_of_month": null,
+  "retention_count": 7,
+  "is_incremental": false,
+  "project_id": "mock-project-id",
+  "volume_ids": ` + tc.volumeIds + `,
+  "created_at": "2026-01-02T03:04:05Z",
+  "updated_at": "2026-01-02T03:04:05Z"
+}`
+			if err := json.Unmarshal([]byte(body), &result); err != nil {
+				t.Fatal(err)
+			}
+
+			model := volumeSnapshotScheduleResourceModel{VolumeIds: tc.stateVolumeIds}
+			var diags diag.Diagnostics
+			if !mapVolumeSnapshotScheduleModel(context.Background(), &model, &result, &diags) {
+				t.Fatal(diags)
+			}
+			if !model.Description.Equal(tc.wantDescription) {
+				t.Errorf("description = %v, want %v", model.Description, tc.wantDescription)
+			}
+			if tc.wantVolumeIds < 0 {
+				if !model.VolumeIds.IsNull() {
+					t.Errorf("volume_ids = %v, want null", model.VolumeIds)
+				}
+			} else if n := len(model.VolumeIds.Elements()); model.VolumeIds.IsNull() || n != tc.wantVolumeIds {
+				t.Errorf("volume_ids = %v, want %d elements", model.VolumeIds, tc.wantVolumeIds)
+			}
+		})
+	}
+}
+
+func ptr(s string) *string {
+	return &s
+}
@@ -0,0 +1,118 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+package volume
+
+import (
+	"regexp"
+	"terraform-provider-kakaocloud/internal/common"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int32validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	rschema "github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+const (
+	SnapshotScheduleFrequencyDaily   = "daily"
+	SnapshotScheduleFrequencyWeekly  = "weekly"
+	SnapshotScheduleFrequencyMonthly = "monthly"
+)
+
+var snapshotScheduleDaysOfWeek = []string{"mon", "tue", "wed", "thu", "fri", "sat", "sun"}
+
+func getVolumeSnapshotScheduleResourceSchema() map[string]rschema.Attribute {
+	return map[string]rschema.Attribute{
+		"id": rschema.StringAttribute{
+			Computed: true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		},
+		"name": rschema.StringAttribute{
+			Required:   true,
+			Validators: common.NameValidator(250),
+		},
+		"description": rschema.StringAttribute{
+			Optional:   true,
+			Validators: common.DescriptionValidator(),
+		},
+		"frequency": rschema.StringAttribute{
+			Required: true,
+			Validators: []validator.String{
+				stringvalidator.OneOf(
+					SnapshotScheduleFrequencyDaily,
+					SnapshotScheduleFrequencyWeekly,
+					SnapshotScheduleFrequencyMonthly,
+				),
+			},
+		},
+		"start_time": rschema.StringAttribute{
+			Required: true,
+			Validators: []validator.String{
+				stringvalidator.RegexMatches(
+					regexp.MustCompile(`^([01][0-9]|2[0-3]):[0-5][0-9]$`),
+					"must be a UTC time in HH:MM format",
+				),
+			},
+		},
+		"day_of_week": rschema.StringAttribute{
+			Optional: true,
+			Validators: []validator.String{
+				stringvalidator.OneOf(snapshotScheduleDaysOfWeek...),
+				stringvalidator.ConflictsWith(path.MatchRoot("day_of_month")),
+			},
+		},
+		"day_of_month": rschema.Int32Attribute{
+			Optional: true,
+			Validators: []validator.Int32{
+				int32validator.Between(1, 28),
+			},
+		},
+		"retention_count": rschema.Int32Attribute{
+			Required: true,
+			Validators: []validator.Int32{
+				int32validator.Between(1, 100),
+			},
+		},
+		"is_incremental": rschema.BoolAttribute{
+			Optional: true,
+			Computed: true,
+			Default:  booldefault.StaticBool(false),
+			PlanModifiers: []planmodifier.Bool{
+				boolplanmodifier.RequiresReplace(),
+			},
+		},
+		"volume_ids": rschema.SetAttribute{
+			Optional:    true,
+			ElementType: types.StringType,
+			Validators: []validator.Set{
+				setvalidator.SizeAtLeast(1),
+				setvalidator.ValueStringsAre(common.UuidValidator()...),
+			},
+		},
+		"project_id": rschema.StringAttribute{
+			Computed: true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		},
+		"created_at": rschema.StringAttribute{
+			Computed: true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		},
+		"updated_at": rschema.StringAttribute{
+			Computed: true,
+		},
+	}
+}
+
+var volumeSnapshotScheduleResourceSchemaAttributes = getVolumeSnapshotScheduleResourceSchema()
@@ -0,0 +1,139 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+package volume
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"terraform-provider-kakaocloud/internal/common"
+	. "terraform-provider-kakaocloud/internal/utils"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/datasource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/jinzhu/copier"
+	"github.com/kakaoenterprise/kc-sdk-go/services/volume"
+)
+
+var (
+	_ datasource.DataSource              = &volumeSnapshotScheduleSnapshotsDataSource{}
+	_ datasource.DataSourceWithConfigure = &volumeSnapshotScheduleSnapshotsDataSource{}
+)
+
+func NewVolumeSnapshotScheduleSnapshotsDataSource() datasource.DataSource {
+	return &volumeSnapshotScheduleSnapshotsDataSource{}
+}
+
+type volumeSnapshotScheduleSnapshotsDataSource struct {
+	kc *common.KakaoCloudClient
+}
+
+func (d *volumeSnapshotScheduleSnapshotsDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_volume_snapshot_schedule_snapshots"
+}
+
+func (d *volumeSnapshotScheduleSnapshotsDataSource) Schema(ctx context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"schedule_id": schema.StringAttribute{
+				Required:   true,
+				Validators: common.UuidValidator(),
+			},
+			"volume_id": schema.StringAttribute{
+				Optional:   true,
+				Validators: common.UuidValidator(),
+			},
+			"volume_snapshots": schema.ListNestedAttribute{
+				Computed: true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: MergeDataSourceSchemaAttributes(
+						map[string]schema.Attribute{
+							"id": schema.StringAttribute{
+								Computed: true,
+							},
+						},
+						volumeSnapshotDataSourceSchemaAttributes,
+					),
+				},
+			},
+			"timeouts": timeouts.Attributes(ctx),
+		},
+	}
+}
+
+func (d *volumeSnapshotScheduleSnapshotsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var config volumeSnapshotScheduleSnapshotsDataSourceModel
+	diags := req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	timeout, diags := config.Timeouts.Read(ctx, common.DefaultReadTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	volumeSnapshotApi := d.kc.ApiClient.VolumeSnapshotAPI.ListSnapshots(ctx).ScheduleId(config.ScheduleId.ValueString())
+	if !config.VolumeId.IsNull() {
+		volumeSnapshotApi = volumeSnapshotApi.VolumeId(config.VolumeId.ValueString())
+	}
+
+	volumesSnapshotPages, httpResp, err := common.ListAllPagesPartial(ctx, d.kc, d, &resp.Diagnostics, common.ServiceVolume,
+		func(limit int32, offset int32) (*volume.VolumeSnapshotListModel, *http.Response, error) {
+			return volumeSnapshotApi.Limit(limit).Offset(offset).XAuthToken(d.kc.XAuthToken).Execute()
+		},
+		func(page *volume.VolumeSnapshotListModel) int {
+			return len(page.Snapshots)
+		},
+	)
+	if err != nil {
+		common.AddApiActionError(ctx, d, httpResp, "ListVolumeSnapshot", err, &resp.Diagnostics)
+		return
+	}
+
+	config.VolumeSnapshots = []volumeSnapshotBaseModel{}
+	for _, volumesSnapshotResp := range volumesSnapshotPages {
+		var pageResult []volume.BcsVolumeV1ApiGetSnapshotModelVolumeSnapshotModel
+		err = copier.Copy(&pageResult, &volumesSnapshotResp.Snapshots)
+		if err != nil {
+			common.AddGeneralError(ctx, d, &resp.Diagnostics,
+				fmt.Sprintf("Failed to convert volumesSnapshotsResult: %v", err))
+			return
+		}
+
+		for _, v := range pageResult {
+			var tmpVolumeSnapshot volumeSnapshotBaseModel
+			ok := mapVolumeSnapshotBaseModel(&tmpVolumeSnapshot, &v, &resp.Diagnostics)
+			if !ok || resp.Diagnostics.HasError() {
+				return
+			}
+			config.VolumeSnapshots = append(config.VolumeSnapshots, tmpVolumeSnapshot)
+		}
+	}
+
+	diags = resp.State.Set(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (d *volumeSnapshotScheduleSnapshotsDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*common.KakaoCloudClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *common.KakaoCloudClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.kc = client
+}
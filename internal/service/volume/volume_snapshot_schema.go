@@ -131,5 +131,67 @@ func getVolumeSnapshotResourceSchema() map[string]rschema.Attribute {
 	}
 }
 
+func getVolumeSnapshotCopyResourceSchema() map[string]rschema.Attribute {
+	return map[string]rschema.Attribute{
+		"id": rschema.StringAttribute{
+			Computed: true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		},
+		"source_snapshot_id": rschema.StringAttribute{
+			Required:   true,
+			Validators: common.UuidValidator(),
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.RequiresReplace(),
+			},
+		},
+		"destination_region": rschema.StringAttribute{
+			Required:   true,
+			Validators: common.RegionValidators(),
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.RequiresReplace(),
+			},
+		},
+		"name": rschema.StringAttribute{
+			Optional:   true,
+			Computed:   true,
+			Validators: common.NameValidator(250),
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+				stringplanmodifier.RequiresReplaceIfConfigured(),
+			},
+		},
+		"description": rschema.StringAttribute{
+			Optional:   true,
+			Computed:   true,
+			Validators: common.DescriptionValidator(),
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+				stringplanmodifier.RequiresReplaceIfConfigured(),
+			},
+		},
+		"size": rschema.Int64Attribute{
+			Computed: true,
+		},
+		"status": rschema.StringAttribute{
+			Computed: true,
+		},
+		"project_id": rschema.StringAttribute{
+			Computed: true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		},
+		"created_at": rschema.StringAttribute{
+			Computed: true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		},
+	}
+}
+
 var volumeSnapshotResourceSchemaAttributes = getVolumeSnapshotResourceSchema()
+var volumeSnapshotCopyResourceSchemaAttributes = getVolumeSnapshotCopyResourceSchema()
 var volumeSnapshotDataSourceSchemaAttributes = getVolumeSnapshotDataSourceSchema()
@@ -161,9 +161,12 @@ func (d *volumeSnapshotsDataSource) Read(ctx context.Context, req datasource.Rea
 		return
 	}
 
-	volumesSnapshotResp, httpResp, err := common.ExecuteWithRetryAndAuth(ctx, d.kc, &resp.Diagnostics,
-		func() (*volume.VolumeSnapshotListModel, *http.Response, error) {
-			return volumeSnapshotApi.Limit(1000).XAuthToken(d.kc.XAuthToken).Execute()
+	volumesSnapshotPages, httpResp, err := common.ListAllPagesPartial(ctx, d.kc, d, &resp.Diagnostics, common.ServiceVolume,
+		func(limit int32, offset int32) (*volume.VolumeSnapshotListModel, *http.Response, error) {
+			return volumeSnapshotApi.Limit(limit).Offset(offset).XAuthToken(d.kc.XAuthToken).Execute()
+		},
+		func(page *volume.VolumeSnapshotListModel) int {
+			return len(page.Snapshots)
 		},
 	)
 	if err != nil {
@@ -172,11 +175,15 @@ func (d *volumeSnapshotsDataSource) Read(ctx context.Context, req datasource.Rea
 	}
 
 	var volumesSnapshotsResult []volume.BcsVolumeV1ApiGetSnapshotModelVolumeSnapshotModel
-	err = copier.Copy(&volumesSnapshotsResult, &volumesSnapshotResp.Snapshots)
-	if err != nil {
-		common.AddGeneralError(ctx, d, &resp.Diagnostics,
-			fmt.Sprintf("Failed to convert volumesSnapshotsResult: %v", err))
-		return
+	for _, volumesSnapshotResp := range volumesSnapshotPages {
+		var pageResult []volume.BcsVolumeV1ApiGetSnapshotModelVolumeSnapshotModel
+		err = copier.Copy(&pageResult, &volumesSnapshotResp.Snapshots)
+		if err != nil {
+			common.AddGeneralError(ctx, d, &resp.Diagnostics,
+				fmt.Sprintf("Failed to convert volumesSnapshotsResult: %v", err))
+			return
+		}
+		volumesSnapshotsResult = append(volumesSnapshotsResult, pageResult...)
 	}
 
 	for _, v := range volumesSnapshotsResult {
@@ -76,7 +76,7 @@ func (d *volumeTypesDataSource) Read(ctx context.Context, req datasource.ReadReq
 	ctx, cancel := context.WithTimeout(ctx, timeout)
 	defer cancel()
 
-	respModel, httpResp, err := common.ExecuteWithRetryAndAuth(ctx, d.kc, &resp.Diagnostics,
+	respModel, httpResp, err := common.ExecuteWithRetryAndAuth(ctx, d.kc, &resp.Diagnostics, common.ServiceVolume,
 		func() (*volume.VolumeTypeListModel, *http.Response, error) {
 			return d.kc.ApiClient.VolumeAPI.ListVolumeTypes(ctx).XAuthToken(d.kc.XAuthToken).Execute()
 		},
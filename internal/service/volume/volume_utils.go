@@ -59,7 +59,7 @@ func CheckVolumeStatus(
 		},
 		diags,
 		func(ctx context.Context) (*volume.BcsVolumeV1ApiGetVolumeModelVolumeModel, *http.Response, error) {
-			respModel, httpResp, err := common.ExecuteWithRetryAndAuth(ctx, kc, diags,
+			respModel, httpResp, err := common.ExecuteWithRetryAndAuth(ctx, kc, diags, common.ServiceVolume,
 				func() (*volume.BcsVolumeV1ApiGetVolumeModelResponseVolumeModel, *http.Response, error) {
 					return kc.ApiClient.VolumeAPI.
 						GetVolume(ctx, volumeId).
@@ -111,7 +111,7 @@ func CheckVolumeSnapshotStatus(
 		[]string{common.VolumeSnapshotStatusAvailable, common.VolumeSnapshotStatusError, common.VolumeSnapshotStatusDeleting},
 		diags,
 		func(ctx context.Context) (*volume.BcsVolumeV1ApiGetSnapshotModelVolumeSnapshotModel, *http.Response, error) {
-			respModel, httpResp, err := common.ExecuteWithRetryAndAuth(ctx, kc, diags,
+			respModel, httpResp, err := common.ExecuteWithRetryAndAuth(ctx, kc, diags, common.ServiceVolume,
 				func() (*volume.BcsVolumeV1ApiGetSnapshotModelResponseVolumeSnapshotModel, *http.Response, error) {
 					return kc.ApiClient.VolumeSnapshotAPI.GetSnapshot(ctx, volumeSnapshotId).
 						XAuthToken(kc.XAuthToken).Execute()
@@ -87,6 +87,7 @@ func (d *volumesDataSource) Read(ctx context.Context, req datasource.ReadRequest
 	defer cancel()
 
 	volumeApi := d.kc.ApiClient.VolumeAPI.ListVolumes(ctx)
+	tagFilters := make(map[string]string)
 
 	for _, f := range config.Filter {
 		if f.Name.IsNull() || f.Name.IsUnknown() {
@@ -181,6 +182,10 @@ func (d *volumesDataSource) Read(ctx context.Context, req datasource.ReadRequest
 					)
 				}
 			default:
+				if tagKey, ok := common.ParseTagFilterName(filterName); ok {
+					tagFilters[tagKey] = v
+					continue
+				}
 				resp.Diagnostics.AddError(
 					"Invalid filter name",
 					fmt.Sprintf("filter %q is not supported", filterName),
@@ -193,9 +198,12 @@ func (d *volumesDataSource) Read(ctx context.Context, req datasource.ReadRequest
 		return
 	}
 
-	volumesResp, httpResp, err := common.ExecuteWithRetryAndAuth(ctx, d.kc, &resp.Diagnostics,
-		func() (*volume.VolumeListModel, *http.Response, error) {
-			return volumeApi.Limit(1000).XAuthToken(d.kc.XAuthToken).Execute()
+	volumesPages, httpResp, err := common.ListAllPagesPartial(ctx, d.kc, d, &resp.Diagnostics, common.ServiceVolume,
+		func(limit int32, offset int32) (*volume.VolumeListModel, *http.Response, error) {
+			return volumeApi.Limit(limit).Offset(offset).XAuthToken(d.kc.XAuthToken).Execute()
+		},
+		func(page *volume.VolumeListModel) int {
+			return len(page.Volumes)
 		},
 	)
 	if err != nil {
@@ -203,14 +211,20 @@ func (d *volumesDataSource) Read(ctx context.Context, req datasource.ReadRequest
 		return
 	}
 
-	for _, v := range volumesResp.Volumes {
-		var tmpVolume volumeBaseModel
-		ok := mapVolumeListModel(ctx, &tmpVolume, &v, &resp.Diagnostics)
-		if !ok || resp.Diagnostics.HasError() {
-			return
-		}
+	for _, volumesResp := range volumesPages {
+		for _, v := range volumesResp.Volumes {
+			if !common.MatchTagFilters(v.Metadata, tagFilters) {
+				continue
+			}
 
-		config.Volumes = append(config.Volumes, tmpVolume)
+			var tmpVolume volumeBaseModel
+			ok := mapVolumeListModel(ctx, &tmpVolume, &v, &resp.Diagnostics)
+			if !ok || resp.Diagnostics.HasError() {
+				return
+			}
+
+			config.Volumes = append(config.Volumes, tmpVolume)
+		}
 	}
 
 	diags = resp.State.Set(ctx, &config)
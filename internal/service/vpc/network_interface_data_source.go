@@ -66,7 +66,7 @@ func (d *networkInterfaceDataSource) Read(ctx context.Context, req datasource.Re
 	ctx, cancel := context.WithTimeout(ctx, timeout)
 	defer cancel()
 
-	networkInterfaceResp, httpResp, err := common.ExecuteWithRetryAndAuth(ctx, d.kc, &resp.Diagnostics,
+	networkInterfaceResp, httpResp, err := common.ExecuteWithRetryAndAuth(ctx, d.kc, &resp.Diagnostics, common.ServiceVPC,
 		func() (*vpc.BnsVpcV1ApiGetNetworkInterfaceModelResponseNetworkInterfaceModel, *http.Response, error) {
 			return d.kc.ApiClient.NetworkInterfaceAPI.GetNetworkInterface(ctx, config.Id.ValueString()).XAuthToken(d.kc.XAuthToken).Execute()
 		},
@@ -94,7 +94,7 @@ func (r *networkInterfaceResource) Create(ctx context.Context, req resource.Crea
 
 	body := *vpc.NewBodyCreateNetworkInterface(createReq)
 
-	respModel, httpResp, err := common.ExecuteWithRetryAndAuth(ctx, r.kc, &resp.Diagnostics,
+	respModel, httpResp, err := common.ExecuteWithRetryAndAuth(ctx, r.kc, &resp.Diagnostics, common.ServiceVPC,
 		func() (*vpc.BnsVpcV1ApiCreateNetworkInterfaceModelResponseNetworkInterfaceModel, *http.Response, error) {
 			return r.kc.ApiClient.NetworkInterfaceAPI.CreateNetworkInterface(ctx).
 				XAuthToken(r.kc.XAuthToken).BodyCreateNetworkInterface(body).Execute()
@@ -164,7 +164,7 @@ func (r *networkInterfaceResource) Read(ctx context.Context, req resource.ReadRe
 	ctx, cancel := context.WithTimeout(ctx, timeout)
 	defer cancel()
 
-	respModel, httpResp, err := common.ExecuteWithRetryAndAuth(ctx, r.kc, &resp.Diagnostics,
+	respModel, httpResp, err := common.ExecuteWithRetryAndAuth(ctx, r.kc, &resp.Diagnostics, common.ServiceVPC,
 		func() (*vpc.BnsVpcV1ApiGetNetworkInterfaceModelResponseNetworkInterfaceModel, *http.Response, error) {
 			return r.kc.ApiClient.NetworkInterfaceAPI.GetNetworkInterface(ctx, state.Id.ValueString()).
 				XAuthToken(r.kc.XAuthToken).Execute()
@@ -216,6 +216,10 @@ func (r *networkInterfaceResource) Update(ctx context.Context, req resource.Upda
 	ctx, cancel := context.WithTimeout(ctx, timeout)
 	defer cancel()
 
+	mutex := common.LockForID(plan.Id.ValueString())
+	mutex.Lock()
+	defer mutex.Unlock()
+
 	var result *vpc.BnsVpcV1ApiGetNetworkInterfaceModelNetworkInterfaceModel
 	var ok bool
 
@@ -250,7 +254,7 @@ func (r *networkInterfaceResource) Update(ctx context.Context, req resource.Upda
 
 		body := *vpc.NewBodyUpdateNetworkInterface(editReq)
 
-		_, httpResp, err := common.ExecuteWithRetryAndAuth(ctx, r.kc, &resp.Diagnostics,
+		_, httpResp, err := common.ExecuteWithRetryAndAuth(ctx, r.kc, &resp.Diagnostics, common.ServiceVPC,
 			func() (*vpc.BnsVpcV1ApiUpdateNetworkInterfaceModelResponseNetworkInterfaceModel, *http.Response, error) {
 				return r.kc.ApiClient.NetworkInterfaceAPI.UpdateNetworkInterface(ctx, plan.Id.ValueString()).
 					XAuthToken(r.kc.XAuthToken).
@@ -322,7 +326,7 @@ func (r *networkInterfaceResource) Delete(ctx context.Context, req resource.Dele
 	ctx, cancel := context.WithTimeout(ctx, timeout)
 	defer cancel()
 
-	_, httpResp, err := common.ExecuteWithRetryAndAuth(ctx, r.kc, &resp.Diagnostics,
+	_, httpResp, err := common.ExecuteWithRetryAndAuth(ctx, r.kc, &resp.Diagnostics, common.ServiceVPC,
 		func() (interface{}, *http.Response, error) {
 			httpResp, err := r.kc.ApiClient.NetworkInterfaceAPI.DeleteNetworkInterface(ctx, state.Id.ValueString()).
 				XAuthToken(r.kc.XAuthToken).
@@ -339,7 +343,7 @@ func (r *networkInterfaceResource) Delete(ctx context.Context, req resource.Dele
 	}
 
 	common.PollUntilDeletion(ctx, r, 2*time.Second, &resp.Diagnostics, func(ctx context.Context) (bool, *http.Response, error) {
-		_, httpResp, err := common.ExecuteWithRetryAndAuth(ctx, r.kc, &resp.Diagnostics,
+		_, httpResp, err := common.ExecuteWithRetryAndAuth(ctx, r.kc, &resp.Diagnostics, common.ServiceVPC,
 			func() (*vpc.BnsVpcV1ApiGetNetworkInterfaceModelResponseNetworkInterfaceModel, *http.Response, error) {
 				_, httpResp, err := r.kc.ApiClient.NetworkInterfaceAPI.
 					GetNetworkInterface(ctx, state.Id.ValueString()).
@@ -391,7 +395,7 @@ func (r *networkInterfaceResource) pollNetworkInterfaceUntilStatus(
 		targetStatuses,
 		resp,
 		func(ctx context.Context) (*vpc.BnsVpcV1ApiGetNetworkInterfaceModelNetworkInterfaceModel, *http.Response, error) {
-			respModel, httpResp, err := common.ExecuteWithRetryAndAuth(ctx, r.kc, resp,
+			respModel, httpResp, err := common.ExecuteWithRetryAndAuth(ctx, r.kc, resp, common.ServiceVPC,
 				func() (*vpc.BnsVpcV1ApiGetNetworkInterfaceModelResponseNetworkInterfaceModel, *http.Response, error) {
 					return r.kc.ApiClient.NetworkInterfaceAPI.
 						GetNetworkInterface(ctx, networkInterfaceId).
@@ -434,7 +438,7 @@ func (r *networkInterfaceResource) updateAllowedAddressPairs(ctx context.Context
 
 	body := *vpc.NewBodyUpdateNetworkInterfaceAllowedAddresses(editReq)
 
-	_, httpResp, err := common.ExecuteWithRetryAndAuth(ctx, r.kc, resp,
+	_, httpResp, err := common.ExecuteWithRetryAndAuth(ctx, r.kc, resp, common.ServiceVPC,
 		func() (interface{}, *http.Response, error) {
 			return r.kc.ApiClient.NetworkInterfaceAPI.UpdateNetworkInterfaceAllowedAddresses(ctx, plan.Id.ValueString()).
 				XAuthToken(r.kc.XAuthToken).
@@ -162,9 +162,12 @@ func (d *networkInterfacesDataSource) Read(ctx context.Context, req datasource.R
 	if resp.Diagnostics.HasError() {
 		return
 	}
-	networkInterfaceResp, httpResp, err := common.ExecuteWithRetryAndAuth(ctx, d.kc, &resp.Diagnostics,
-		func() (*vpc.NetworkInterfaceListModel, *http.Response, error) {
-			return networkInterfaceApi.Limit(1000).XAuthToken(d.kc.XAuthToken).Execute()
+	networkInterfacePages, httpResp, err := common.ListAllPagesPartial(ctx, d.kc, d, &resp.Diagnostics, common.ServiceVPC,
+		func(limit int32, offset int32) (*vpc.NetworkInterfaceListModel, *http.Response, error) {
+			return networkInterfaceApi.Limit(limit).Offset(offset).XAuthToken(d.kc.XAuthToken).Execute()
+		},
+		func(page *vpc.NetworkInterfaceListModel) int {
+			return len(page.NetworkInterfaces)
 		},
 	)
 	if err != nil {
@@ -173,11 +176,15 @@ func (d *networkInterfacesDataSource) Read(ctx context.Context, req datasource.R
 	}
 
 	var networkInterfaceResult []vpc.BnsVpcV1ApiGetNetworkInterfaceModelNetworkInterfaceModel
-	err = copier.Copy(&networkInterfaceResult, &networkInterfaceResp.NetworkInterfaces)
-	if err != nil {
-		common.AddGeneralError(ctx, d, &resp.Diagnostics,
-			fmt.Sprintf("Failed to convert networkInterfaceResult: %v", err))
-		return
+	for _, networkInterfaceResp := range networkInterfacePages {
+		var pageResult []vpc.BnsVpcV1ApiGetNetworkInterfaceModelNetworkInterfaceModel
+		err = copier.Copy(&pageResult, &networkInterfaceResp.NetworkInterfaces)
+		if err != nil {
+			common.AddGeneralError(ctx, d, &resp.Diagnostics,
+				fmt.Sprintf("Failed to convert networkInterfaceResult: %v", err))
+			return
+		}
+		networkInterfaceResult = append(networkInterfaceResult, pageResult...)
 	}
 
 	for _, v := range networkInterfaceResult {
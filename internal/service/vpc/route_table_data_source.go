@@ -66,7 +66,7 @@ func (d *routeTableDataSource) Read(ctx context.Context, req datasource.ReadRequ
 	ctx, cancel := context.WithTimeout(ctx, timeout)
 	defer cancel()
 
-	routeTableResp, httpResp, err := common.ExecuteWithRetryAndAuth(ctx, d.kc, &resp.Diagnostics,
+	routeTableResp, httpResp, err := common.ExecuteWithRetryAndAuth(ctx, d.kc, &resp.Diagnostics, common.ServiceVPC,
 		func() (*vpc.BnsVpcV1ApiGetRouteTableModelResponseRouteTableModel, *http.Response, error) {
 			return d.kc.ApiClient.VPCRouteTableAPI.GetRouteTable(ctx, config.Id.ValueString()).XAuthToken(d.kc.XAuthToken).Execute()
 		},
@@ -87,7 +87,7 @@ func (r *routeTableResource) Create(ctx context.Context, req resource.CreateRequ
 		VpcRouteTable: createReq,
 	}
 
-	respModel, httpResp, err := common.ExecuteWithRetryAndAuth(ctx, r.kc, &resp.Diagnostics,
+	respModel, httpResp, err := common.ExecuteWithRetryAndAuth(ctx, r.kc, &resp.Diagnostics, common.ServiceVPC,
 		func() (*vpc.BnsVpcV1ApiCreateRouteTableModelResponseRouteTableModel, *http.Response, error) {
 			return r.kc.ApiClient.VPCRouteTableAPI.CreateRouteTable(ctx).
 				XAuthToken(r.kc.XAuthToken).BodyCreateRouteTable(body).Execute()
@@ -184,7 +184,7 @@ func (r *routeTableResource) Read(ctx context.Context, req resource.ReadRequest,
 	ctx, cancel := context.WithTimeout(ctx, timeout)
 	defer cancel()
 
-	respModel, httpResp, err := common.ExecuteWithRetryAndAuth(ctx, r.kc, &resp.Diagnostics,
+	respModel, httpResp, err := common.ExecuteWithRetryAndAuth(ctx, r.kc, &resp.Diagnostics, common.ServiceVPC,
 		func() (*vpc.BnsVpcV1ApiGetRouteTableModelResponseRouteTableModel, *http.Response, error) {
 			return r.kc.ApiClient.VPCRouteTableAPI.GetRouteTable(ctx, state.Id.ValueString()).
 				XAuthToken(r.kc.XAuthToken).Execute()
@@ -346,7 +346,7 @@ func (r *routeTableResource) Delete(ctx context.Context, req resource.DeleteRequ
 	)
 
 	for i := 0; i < maxRetry; i++ {
-		_, httpResp, err = common.ExecuteWithRetryAndAuth(ctx, r.kc, &resp.Diagnostics,
+		_, httpResp, err = common.ExecuteWithRetryAndAuth(ctx, r.kc, &resp.Diagnostics, common.ServiceVPC,
 			func() (interface{}, *http.Response, error) {
 				httpResp, err := r.kc.ApiClient.VPCRouteTableAPI.DeleteRouteTable(ctx, state.Id.ValueString()).
 					XAuthToken(r.kc.XAuthToken).
@@ -369,7 +369,7 @@ func (r *routeTableResource) Delete(ctx context.Context, req resource.DeleteRequ
 	}
 
 	common.PollUntilDeletion(ctx, r, 2*time.Second, &resp.Diagnostics, func(ctx context.Context) (bool, *http.Response, error) {
-		_, httpResp, err := common.ExecuteWithRetryAndAuth(ctx, r.kc, &resp.Diagnostics,
+		_, httpResp, err := common.ExecuteWithRetryAndAuth(ctx, r.kc, &resp.Diagnostics, common.ServiceVPC,
 			func() (*vpc.BnsVpcV1ApiGetRouteTableModelResponseRouteTableModel, *http.Response, error) {
 				_, httpResp, err := r.kc.ApiClient.VPCRouteTableAPI.
 					GetRouteTable(ctx, state.Id.ValueString()).
@@ -407,7 +407,7 @@ func (r *routeTableResource) ImportState(ctx context.Context, req resource.Impor
 }
 
 func (r *routeTableResource) setMainRouteTable(ctx context.Context, routeTableId string, diag *diag.Diagnostics) {
-	_, httpResp, err := common.ExecuteWithRetryAndAuth(ctx, r.kc, diag,
+	_, httpResp, err := common.ExecuteWithRetryAndAuth(ctx, r.kc, diag, common.ServiceVPC,
 		func() (interface{}, *http.Response, error) {
 			return r.kc.ApiClient.VPCRouteTableAPI.SetMainRouteTable(ctx, routeTableId).
 				XAuthToken(r.kc.XAuthToken).
@@ -524,7 +524,7 @@ func (r *routeTableResource) pollRouteTableUntilStatus(
 		targetStatuses,
 		resp,
 		func(ctx context.Context) (*vpc.BnsVpcV1ApiGetRouteTableModelRouteTableModel, *http.Response, error) {
-			respModel, httpResp, err := common.ExecuteWithRetryAndAuth(ctx, r.kc, resp,
+			respModel, httpResp, err := common.ExecuteWithRetryAndAuth(ctx, r.kc, resp, common.ServiceVPC,
 				func() (*vpc.BnsVpcV1ApiGetRouteTableModelResponseRouteTableModel, *http.Response, error) {
 					return r.kc.ApiClient.VPCRouteTableAPI.
 						GetRouteTable(ctx, routeTableId).
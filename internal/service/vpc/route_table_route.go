@@ -73,7 +73,7 @@ func (r *routeTableResource) addRoute(ctx context.Context, routeTableId, vpcId s
 		return false
 	}
 
-	resp, httpResp, err := common.ExecuteWithRetryAndAuth(ctx, r.kc, diag,
+	resp, httpResp, err := common.ExecuteWithRetryAndAuth(ctx, r.kc, diag, common.ServiceVPC,
 		func() (*vpc.BnsVpcV1ApiAddRouteModelResponseRouteModel, *http.Response, error) {
 			return r.kc.ApiClient.VPCRouteTableRouteAPI.AddRoute(ctx, routeTableId).
 				XAuthToken(r.kc.XAuthToken).
@@ -102,7 +102,7 @@ func (r *routeTableResource) updateRoute(ctx context.Context, routeTableId, vpcI
 	}
 
 	body := *vpc.NewBodyUpdateRoute(req)
-	_, httpResp, err := common.ExecuteWithRetryAndAuth(ctx, r.kc, diag,
+	_, httpResp, err := common.ExecuteWithRetryAndAuth(ctx, r.kc, diag, common.ServiceVPC,
 		func() (*vpc.BnsVpcV1ApiUpdateRouteModelResponseRouteModel, *http.Response, error) {
 			return r.kc.ApiClient.VPCRouteTableRouteAPI.UpdateRoute(ctx, routeTableId, route.Id.ValueString()).
 				XAuthToken(r.kc.XAuthToken).
@@ -123,7 +123,7 @@ func (r *routeTableResource) deleteRoute(ctx context.Context, routeTableId, vpcI
 		return false
 	}
 
-	_, httpResp, err := common.ExecuteWithRetryAndAuth(ctx, r.kc, diag,
+	_, httpResp, err := common.ExecuteWithRetryAndAuth(ctx, r.kc, diag, common.ServiceVPC,
 		func() (interface{}, *http.Response, error) {
 			httpResp, err := r.kc.ApiClient.VPCRouteTableRouteAPI.DeleteRoute(ctx, routeTableId, routeId).
 				XAuthToken(r.kc.XAuthToken).
@@ -153,7 +153,7 @@ func (r *routeTableResource) pollRouteDelete(
 	resp *diag.Diagnostics,
 ) bool {
 	for {
-		respModel, httpResp, err := common.ExecuteWithRetryAndAuth(ctx, r.kc, resp,
+		respModel, httpResp, err := common.ExecuteWithRetryAndAuth(ctx, r.kc, resp, common.ServiceVPC,
 			func() (*vpc.BnsVpcV1ApiGetRouteTableModelResponseRouteTableModel, *http.Response, error) {
 				return r.kc.ApiClient.VPCRouteTableAPI.
 					GetRouteTable(ctx, routeTableId).
@@ -192,6 +192,7 @@ func getRequestRouteResourceSchema() map[string]rschema.Attribute {
 					string(vpc.ROUTETABLEROUTETYPE_INSTANCE),
 					string(vpc.ROUTETABLEROUTETYPE_IGW),
 					string(vpc.ROUTETABLEROUTETYPE_TGW),
+					string(vpc.ROUTETABLEROUTETYPE_PEERING),
 				),
 			},
 		},
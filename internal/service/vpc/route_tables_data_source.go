@@ -162,9 +162,12 @@ func (d *routeTablesDataSource) Read(ctx context.Context, req datasource.ReadReq
 	if resp.Diagnostics.HasError() {
 		return
 	}
-	routeTableResp, httpResp, err := common.ExecuteWithRetryAndAuth(ctx, d.kc, &resp.Diagnostics,
-		func() (*vpc.RouteTableListModel, *http.Response, error) {
-			return routeTableApi.Limit(1000).XAuthToken(d.kc.XAuthToken).Execute()
+	routeTablePages, httpResp, err := common.ListAllPagesPartial(ctx, d.kc, d, &resp.Diagnostics, common.ServiceVPC,
+		func(limit int32, offset int32) (*vpc.RouteTableListModel, *http.Response, error) {
+			return routeTableApi.Limit(limit).Offset(offset).XAuthToken(d.kc.XAuthToken).Execute()
+		},
+		func(page *vpc.RouteTableListModel) int {
+			return len(page.VpcRouteTables)
 		},
 	)
 	if err != nil {
@@ -173,11 +176,15 @@ func (d *routeTablesDataSource) Read(ctx context.Context, req datasource.ReadReq
 	}
 
 	var routeTableResult []vpc.BnsVpcV1ApiGetRouteTableModelRouteTableModel
-	err = copier.Copy(&routeTableResult, &routeTableResp.VpcRouteTables)
-	if err != nil {
-		common.AddGeneralError(ctx, d, &resp.Diagnostics,
-			fmt.Sprintf("Failed to convert routeTableResult: %v", err))
-		return
+	for _, routeTableResp := range routeTablePages {
+		var pageResult []vpc.BnsVpcV1ApiGetRouteTableModelRouteTableModel
+		err = copier.Copy(&pageResult, &routeTableResp.VpcRouteTables)
+		if err != nil {
+			common.AddGeneralError(ctx, d, &resp.Diagnostics,
+				fmt.Sprintf("Failed to convert routeTableResult: %v", err))
+			return
+		}
+		routeTableResult = append(routeTableResult, pageResult...)
 	}
 
 	for _, v := range routeTableResult {
@@ -66,7 +66,7 @@ func (d *subnetDataSource) Read(ctx context.Context, req datasource.ReadRequest,
 	ctx, cancel := context.WithTimeout(ctx, timeout)
 	defer cancel()
 
-	subnetResp, httpResp, err := common.ExecuteWithRetryAndAuth(ctx, d.kc, &resp.Diagnostics,
+	subnetResp, httpResp, err := common.ExecuteWithRetryAndAuth(ctx, d.kc, &resp.Diagnostics, common.ServiceVPC,
 		func() (*vpc.BnsVpcV1ApiGetSubnetModelResponseSubnetModel, *http.Response, error) {
 			return d.kc.ApiClient.VPCSubnetAPI.GetSubnet(ctx, config.Id.ValueString()).XAuthToken(d.kc.XAuthToken).Execute()
 		},
@@ -83,7 +83,7 @@ func (r *subnetResource) Create(ctx context.Context, req resource.CreateRequest,
 		return
 	}
 
-	respModel, httpResp, err := common.ExecuteWithRetryAndAuth(ctx, r.kc, &resp.Diagnostics,
+	respModel, httpResp, err := common.ExecuteWithRetryAndAuth(ctx, r.kc, &resp.Diagnostics, common.ServiceVPC,
 		func() (*vpc.BnsVpcV1ApiCreateSubnetModelResponseSubnetModel, *http.Response, error) {
 			return r.kc.ApiClient.VPCSubnetAPI.CreateSubnet(ctx).
 				XAuthToken(r.kc.XAuthToken).BodyCreateSubnet(body).Execute()
@@ -105,7 +105,7 @@ func (r *subnetResource) Create(ctx context.Context, req resource.CreateRequest,
 		[]string{common.VpcProvisioningStatusActive, common.VpcProvisioningStatusError},
 		&resp.Diagnostics,
 		func(ctx context.Context) (*vpc.BnsVpcV1ApiGetSubnetModelSubnetModel, *http.Response, error) {
-			respModel, httpResp, err := common.ExecuteWithRetryAndAuth(ctx, r.kc, &resp.Diagnostics,
+			respModel, httpResp, err := common.ExecuteWithRetryAndAuth(ctx, r.kc, &resp.Diagnostics, common.ServiceVPC,
 				func() (*vpc.BnsVpcV1ApiGetSubnetModelResponseSubnetModel, *http.Response, error) {
 					return r.kc.ApiClient.VPCSubnetAPI.
 						GetSubnet(ctx, plan.Id.ValueString()).
@@ -167,7 +167,7 @@ func (r *subnetResource) Read(ctx context.Context, req resource.ReadRequest, res
 	ctx, cancel := context.WithTimeout(ctx, timeout)
 	defer cancel()
 
-	respModel, httpResp, err := common.ExecuteWithRetryAndAuth(ctx, r.kc, &resp.Diagnostics,
+	respModel, httpResp, err := common.ExecuteWithRetryAndAuth(ctx, r.kc, &resp.Diagnostics, common.ServiceVPC,
 		func() (*vpc.BnsVpcV1ApiGetSubnetModelResponseSubnetModel, *http.Response, error) {
 			return r.kc.ApiClient.VPCSubnetAPI.GetSubnet(ctx, state.Id.ValueString()).
 				XAuthToken(r.kc.XAuthToken).Execute()
@@ -231,7 +231,7 @@ func (r *subnetResource) Update(ctx context.Context, req resource.UpdateRequest,
 
 		body := *vpc.NewBodyUpdateSubnet(editReq)
 
-		_, httpResp, err := common.ExecuteWithRetryAndAuth(ctx, r.kc, &resp.Diagnostics,
+		_, httpResp, err := common.ExecuteWithRetryAndAuth(ctx, r.kc, &resp.Diagnostics, common.ServiceVPC,
 			func() (*vpc.BnsVpcV1ApiUpdateSubnetModelResponseSubnetModel, *http.Response, error) {
 				return r.kc.ApiClient.VPCSubnetAPI.UpdateSubnet(ctx, plan.Id.ValueString()).
 					XAuthToken(r.kc.XAuthToken).
@@ -299,7 +299,7 @@ func (r *subnetResource) Delete(ctx context.Context, req resource.DeleteRequest,
 		return
 	}
 
-	_, httpResp, err := common.ExecuteWithRetryAndAuth(ctx, r.kc, &resp.Diagnostics,
+	_, httpResp, err := common.ExecuteWithRetryAndAuth(ctx, r.kc, &resp.Diagnostics, common.ServiceVPC,
 		func() (interface{}, *http.Response, error) {
 			httpResp, err := r.kc.ApiClient.VPCSubnetAPI.DeleteSubnet(ctx, state.Id.ValueString()).
 				XAuthToken(r.kc.XAuthToken).
@@ -316,7 +316,7 @@ func (r *subnetResource) Delete(ctx context.Context, req resource.DeleteRequest,
 	}
 
 	common.PollUntilDeletion(ctx, r, 5*time.Second, &resp.Diagnostics, func(ctx context.Context) (bool, *http.Response, error) {
-		_, httpResp, err := common.ExecuteWithRetryAndAuth(ctx, r.kc, &resp.Diagnostics,
+		_, httpResp, err := common.ExecuteWithRetryAndAuth(ctx, r.kc, &resp.Diagnostics, common.ServiceVPC,
 			func() (*vpc.BnsVpcV1ApiGetSubnetModelResponseSubnetModel, *http.Response, error) {
 				_, httpResp, err := r.kc.ApiClient.VPCSubnetAPI.
 					GetSubnet(ctx, state.Id.ValueString()).
@@ -382,7 +382,7 @@ func (r *subnetResource) setAssociation(
 ) (*vpc.BnsVpcV1ApiGetSubnetModelSubnetModel, bool) {
 	subnetId := plan.Id.ValueString()
 
-	routeTableResp, httpResp, err := common.ExecuteWithRetryAndAuth(ctx, r.kc, respDiags,
+	routeTableResp, httpResp, err := common.ExecuteWithRetryAndAuth(ctx, r.kc, respDiags, common.ServiceVPC,
 		func() (*vpc.BnsVpcV1ApiGetRouteTableModelResponseRouteTableModel, *http.Response, error) {
 			return r.kc.ApiClient.VPCRouteTableAPI.GetRouteTable(ctx, sourceRouteTableId).
 				XAuthToken(r.kc.XAuthToken).Execute()
@@ -426,7 +426,7 @@ func (r *subnetResource) setAssociation(
 
 	body := *vpc.NewBodyUpdateRouteTableAssociation(req)
 
-	_, httpResp, err = common.ExecuteWithRetryAndAuth(ctx, r.kc, respDiags,
+	_, httpResp, err = common.ExecuteWithRetryAndAuth(ctx, r.kc, respDiags, common.ServiceVPC,
 		func() (*vpc.ResponseRouteTableAssociationModel, *http.Response, error) {
 			return r.kc.ApiClient.VPCRouteTableAssociationAPI.UpdateRouteTableAssociation(ctx, sourceRouteTableId, *associationId).
 				XAuthToken(r.kc.XAuthToken).BodyUpdateRouteTableAssociation(body).Execute()
@@ -446,7 +446,7 @@ func (r *subnetResource) setAssociation(
 		[]string{common.VpcProvisioningStatusActive, common.VpcProvisioningStatusError},
 		respDiags,
 		func(ctx context.Context) (*vpc.BnsVpcV1ApiGetSubnetModelSubnetModel, *http.Response, error) {
-			respModel, httpResp, err := common.ExecuteWithRetryAndAuth(ctx, r.kc, respDiags,
+			respModel, httpResp, err := common.ExecuteWithRetryAndAuth(ctx, r.kc, respDiags, common.ServiceVPC,
 				func() (*vpc.BnsVpcV1ApiGetSubnetModelResponseSubnetModel, *http.Response, error) {
 					return r.kc.ApiClient.VPCSubnetAPI.
 						GetSubnet(ctx, plan.Id.ValueString()).
@@ -491,7 +491,7 @@ func checkSubnetStatus(
 		[]string{common.VpcProvisioningStatusActive, common.VpcProvisioningStatusError},
 		respDiags,
 		func(ctx context.Context) (*vpc.BnsVpcV1ApiGetSubnetModelSubnetModel, *http.Response, error) {
-			respModel, httpResp, err := common.ExecuteWithRetryAndAuth(ctx, kc, respDiags,
+			respModel, httpResp, err := common.ExecuteWithRetryAndAuth(ctx, kc, respDiags, common.ServiceVPC,
 				func() (*vpc.BnsVpcV1ApiGetSubnetModelResponseSubnetModel, *http.Response, error) {
 					return kc.ApiClient.VPCSubnetAPI.
 						GetSubnet(ctx, subnetId).
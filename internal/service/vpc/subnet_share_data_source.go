@@ -68,7 +68,7 @@ func (d *subnetShareDataSource) Read(ctx context.Context, req datasource.ReadReq
 	ctx, cancel := context.WithTimeout(ctx, timeout)
 	defer cancel()
 
-	subnetShareResp, httpResp, err := common.ExecuteWithRetryAndAuth(ctx, d.kc, &resp.Diagnostics,
+	subnetShareResp, httpResp, err := common.ExecuteWithRetryAndAuth(ctx, d.kc, &resp.Diagnostics, common.ServiceVPC,
 		func() (*vpc.ResponseSubnetSharedProjectListModel, *http.Response, error) {
 			return d.kc.ApiClient.VPCSubnetAPI.ListSubnetSharedProjects(ctx, config.Id.ValueString()).XAuthToken(d.kc.XAuthToken).Execute()
 		},
@@ -16,6 +16,7 @@ type subnetShareBaseModel struct {
 type subnetShareResourceModel struct {
 	subnetShareBaseModel
 	ProjectIds types.Set              `tfsdk:"project_ids"`
+	ProjectId  types.String           `tfsdk:"project_id"`
 	Timeouts   resourceTimeouts.Value `tfsdk:"timeouts"`
 }
 
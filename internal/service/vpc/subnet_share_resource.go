@@ -6,6 +6,7 @@ import (
 	"context"
 	"fmt"
 	"net/http"
+	"strings"
 	"terraform-provider-kakaocloud/internal/common"
 
 	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
@@ -60,6 +61,13 @@ func (r *subnetShareResource) Schema(ctx context.Context, _ resource.SchemaReque
 					setvalidator.ValueStringsAre(common.UuidNoHyphenValidator()...),
 				},
 			},
+			"project_id": schema.StringAttribute{
+				Optional:   true,
+				Validators: common.UuidNoHyphenValidator(),
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
 			"timeouts": timeouts.AttributesAll(ctx),
 		},
 	}
@@ -73,6 +81,8 @@ func (r *subnetShareResource) Create(ctx context.Context, req resource.CreateReq
 		return
 	}
 
+	kc := r.kc.ForProject(plan.ProjectId.ValueString())
+
 	timeout, diags := plan.Timeouts.Create(ctx, common.DefaultCreateTimeout)
 
 	resp.Diagnostics.Append(diags...)
@@ -91,10 +101,10 @@ func (r *subnetShareResource) Create(ctx context.Context, req resource.CreateReq
 	}
 
 	for _, subjectId := range projectIds {
-		_, httpResp, err := common.ExecuteWithRetryAndAuth(ctx, r.kc, &resp.Diagnostics,
+		_, httpResp, err := common.ExecuteWithRetryAndAuth(ctx, kc, &resp.Diagnostics, common.ServiceVPC,
 			func() (interface{}, *http.Response, error) {
-				return r.kc.ApiClient.VPCSubnetAPI.ShareSubnet(ctx, plan.Id.ValueString(), subjectId).
-					XAuthToken(r.kc.XAuthToken).Execute()
+				return kc.ApiClient.VPCSubnetAPI.ShareSubnet(ctx, plan.Id.ValueString(), subjectId).
+					XAuthToken(kc.XAuthToken).Execute()
 			},
 		)
 		if err != nil {
@@ -126,6 +136,8 @@ func (r *subnetShareResource) Read(ctx context.Context, req resource.ReadRequest
 		return
 	}
 
+	kc := r.kc.ForProject(state.ProjectId.ValueString())
+
 	timeout, diags := state.Timeouts.Read(ctx, common.DefaultReadTimeout)
 
 	resp.Diagnostics.Append(diags...)
@@ -136,9 +148,9 @@ func (r *subnetShareResource) Read(ctx context.Context, req resource.ReadRequest
 	ctx, cancel := context.WithTimeout(ctx, timeout)
 	defer cancel()
 
-	subnetShareResp, httpResp, err := common.ExecuteWithRetryAndAuth(ctx, r.kc, &resp.Diagnostics,
+	subnetShareResp, httpResp, err := common.ExecuteWithRetryAndAuth(ctx, kc, &resp.Diagnostics, common.ServiceVPC,
 		func() (*vpc.ResponseSubnetSharedProjectListModel, *http.Response, error) {
-			return r.kc.ApiClient.VPCSubnetAPI.ListSubnetSharedProjects(ctx, state.Id.ValueString()).XAuthToken(r.kc.XAuthToken).Execute()
+			return kc.ApiClient.VPCSubnetAPI.ListSubnetSharedProjects(ctx, state.Id.ValueString()).XAuthToken(kc.XAuthToken).Execute()
 		},
 	)
 	if err != nil {
@@ -173,6 +185,8 @@ func (r *subnetShareResource) Update(ctx context.Context, req resource.UpdateReq
 		return
 	}
 
+	kc := r.kc.ForProject(plan.ProjectId.ValueString())
+
 	diags = req.State.Get(ctx, &state)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
@@ -212,10 +226,10 @@ func (r *subnetShareResource) Update(ctx context.Context, req resource.UpdateReq
 
 	for id := range planSet {
 		if _, exists := stateSet[id]; !exists {
-			_, httpResp, err := common.ExecuteWithRetryAndAuth(ctx, r.kc, &resp.Diagnostics,
+			_, httpResp, err := common.ExecuteWithRetryAndAuth(ctx, kc, &resp.Diagnostics, common.ServiceVPC,
 				func() (interface{}, *http.Response, error) {
-					return r.kc.ApiClient.VPCSubnetAPI.ShareSubnet(ctx, plan.Id.ValueString(), id).
-						XAuthToken(r.kc.XAuthToken).Execute()
+					return kc.ApiClient.VPCSubnetAPI.ShareSubnet(ctx, plan.Id.ValueString(), id).
+						XAuthToken(kc.XAuthToken).Execute()
 				},
 			)
 			if err != nil {
@@ -227,10 +241,10 @@ func (r *subnetShareResource) Update(ctx context.Context, req resource.UpdateReq
 
 	for id := range stateSet {
 		if _, exists := planSet[id]; !exists {
-			_, httpResp, err := common.ExecuteWithRetryAndAuth(ctx, r.kc, &resp.Diagnostics,
+			_, httpResp, err := common.ExecuteWithRetryAndAuth(ctx, kc, &resp.Diagnostics, common.ServiceVPC,
 				func() (interface{}, *http.Response, error) {
-					httpResp, err := r.kc.ApiClient.VPCSubnetAPI.UnshareSubnet(ctx, plan.Id.ValueString(), id).
-						XAuthToken(r.kc.XAuthToken).Execute()
+					httpResp, err := kc.ApiClient.VPCSubnetAPI.UnshareSubnet(ctx, plan.Id.ValueString(), id).
+						XAuthToken(kc.XAuthToken).Execute()
 					return nil, httpResp, err
 				},
 			)
@@ -262,6 +276,8 @@ func (r *subnetShareResource) Delete(ctx context.Context, req resource.DeleteReq
 		return
 	}
 
+	kc := r.kc.ForProject(state.ProjectId.ValueString())
+
 	timeout, diags := state.Timeouts.Delete(ctx, common.DefaultDeleteTimeout)
 
 	resp.Diagnostics.Append(diags...)
@@ -280,10 +296,10 @@ func (r *subnetShareResource) Delete(ctx context.Context, req resource.DeleteReq
 	}
 
 	for _, id := range stateProjectIds {
-		_, httpResp, err := common.ExecuteWithRetryAndAuth(ctx, r.kc, &resp.Diagnostics,
+		_, httpResp, err := common.ExecuteWithRetryAndAuth(ctx, kc, &resp.Diagnostics, common.ServiceVPC,
 			func() (interface{}, *http.Response, error) {
-				httpResp, err := r.kc.ApiClient.VPCSubnetAPI.UnshareSubnet(ctx, state.Id.ValueString(), id).
-					XAuthToken(r.kc.XAuthToken).
+				httpResp, err := kc.ApiClient.VPCSubnetAPI.UnshareSubnet(ctx, state.Id.ValueString(), id).
+					XAuthToken(kc.XAuthToken).
 					Execute()
 				return nil, httpResp, err
 			},
@@ -315,6 +331,12 @@ func (r *subnetShareResource) Configure(_ context.Context, req resource.Configur
 }
 
 func (r *subnetShareResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	subnetId, projectId, scoped := strings.Cut(req.ID, "/")
+	if !scoped {
+		resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+		return
+	}
 
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), subnetId)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("project_id"), projectId)...)
 }
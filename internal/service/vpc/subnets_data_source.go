@@ -176,9 +176,12 @@ func (d *subnetsDataSource) Read(ctx context.Context, req datasource.ReadRequest
 	if resp.Diagnostics.HasError() {
 		return
 	}
-	subnetResp, httpResp, err := common.ExecuteWithRetryAndAuth(ctx, d.kc, &resp.Diagnostics,
-		func() (*vpc.SubnetListModel, *http.Response, error) {
-			return subnetApi.Limit(1000).XAuthToken(d.kc.XAuthToken).Execute()
+	subnetPages, httpResp, err := common.ListAllPagesPartial(ctx, d.kc, d, &resp.Diagnostics, common.ServiceVPC,
+		func(limit int32, offset int32) (*vpc.SubnetListModel, *http.Response, error) {
+			return subnetApi.Limit(limit).Offset(offset).XAuthToken(d.kc.XAuthToken).Execute()
+		},
+		func(page *vpc.SubnetListModel) int {
+			return len(page.Subnets)
 		},
 	)
 	if err != nil {
@@ -187,11 +190,15 @@ func (d *subnetsDataSource) Read(ctx context.Context, req datasource.ReadRequest
 	}
 
 	var subnetsResult []vpc.BnsVpcV1ApiGetSubnetModelSubnetModel
-	err = copier.Copy(&subnetsResult, &subnetResp.Subnets)
-	if err != nil {
-		common.AddGeneralError(ctx, d, &resp.Diagnostics,
-			fmt.Sprintf("Failed to convert subnetsResult: %v", err))
-		return
+	for _, subnetResp := range subnetPages {
+		var pageResult []vpc.BnsVpcV1ApiGetSubnetModelSubnetModel
+		err = copier.Copy(&pageResult, &subnetResp.Subnets)
+		if err != nil {
+			common.AddGeneralError(ctx, d, &resp.Diagnostics,
+				fmt.Sprintf("Failed to convert subnetsResult: %v", err))
+			return
+		}
+		subnetsResult = append(subnetsResult, pageResult...)
 	}
 
 	for _, v := range subnetsResult {
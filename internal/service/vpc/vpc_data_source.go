@@ -66,7 +66,7 @@ func (d *vpcDataSource) Read(ctx context.Context, req datasource.ReadRequest, re
 	ctx, cancel := context.WithTimeout(ctx, timeout)
 	defer cancel()
 
-	vpcResp, httpResp, err := common.ExecuteWithRetryAndAuth(ctx, d.kc, &resp.Diagnostics,
+	vpcResp, httpResp, err := common.ExecuteWithRetryAndAuth(ctx, d.kc, &resp.Diagnostics, common.ServiceVPC,
 		func() (*vpc.BnsVpcV1ApiGetVpcModelResponseVPCModel, *http.Response, error) {
 			return d.kc.ApiClient.VPCAPI.GetVpc(ctx, config.Id.ValueString()).XAuthToken(d.kc.XAuthToken).Execute()
 		},
@@ -0,0 +1,207 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+package vpc
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+	"terraform-provider-kakaocloud/internal/common"
+	"terraform-provider-kakaocloud/internal/utils"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/kakaoenterprise/kc-sdk-go/services/vpc"
+)
+
+var (
+	_ resource.ResourceWithConfigure   = &vpcPeeringAccepterResource{}
+	_ resource.ResourceWithImportState = &vpcPeeringAccepterResource{}
+)
+
+func NewVpcPeeringAccepterResource() resource.Resource {
+	return &vpcPeeringAccepterResource{}
+}
+
+type vpcPeeringAccepterResource struct {
+	kc *common.KakaoCloudClient
+}
+
+func (r *vpcPeeringAccepterResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_vpc_peering_accepter"
+}
+
+func (r *vpcPeeringAccepterResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: utils.MergeResourceSchemaAttributes(
+			vpcPeeringAccepterResourceSchemaAttributes,
+			map[string]schema.Attribute{
+				"timeouts": timeouts.AttributesAll(ctx),
+			},
+		),
+	}
+}
+
+func (r *vpcPeeringAccepterResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan vpcPeeringAccepterResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	kc := r.kc.ForProject(plan.ProjectId.ValueString())
+
+	timeout, diags := plan.Timeouts.Create(ctx, common.DefaultCreateTimeout)
+
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	peeringId := plan.VpcPeeringId.ValueString()
+
+	acceptResp, httpResp, err := common.ExecuteWithRetryAndAuth(ctx, kc, &resp.Diagnostics, common.ServiceVPC,
+		func() (*vpc.BnsVpcV1ApiAcceptVpcPeeringModelResponseVpcPeeringModel, *http.Response, error) {
+			return kc.ApiClient.VPCPeeringAPI.AcceptVpcPeering(ctx, peeringId).
+				XAuthToken(kc.XAuthToken).
+				Execute()
+		},
+	)
+	if err != nil {
+		common.AddApiActionError(ctx, r, httpResp, "AcceptVpcPeering", err, &resp.Diagnostics)
+		return
+	}
+
+	plan.Id = types.StringValue(acceptResp.VpcPeering.Id)
+
+	result, ok := pollVpcPeeringUntilStatus(
+		ctx, r, kc, peeringId,
+		[]string{common.VpcPeeringStatusActive, common.VpcPeeringStatusRejected, common.VpcPeeringStatusError},
+		&resp.Diagnostics,
+	)
+	if !ok || resp.Diagnostics.HasError() {
+		return
+	}
+
+	common.CheckResourceAvailableStatus(ctx, r, (*string)(result.ProvisioningStatus.Get()), []string{common.VpcPeeringStatusActive}, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ok = mapVpcPeeringAccepterModel(ctx, &plan, result, &resp.Diagnostics)
+	if !ok || resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *vpcPeeringAccepterResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state vpcPeeringAccepterResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	kc := r.kc.ForProject(state.ProjectId.ValueString())
+
+	timeout, diags := state.Timeouts.Read(ctx, common.DefaultReadTimeout)
+
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	respModel, httpResp, err := common.ExecuteWithRetryAndAuth(ctx, kc, &resp.Diagnostics, common.ServiceVPC,
+		func() (*vpc.BnsVpcV1ApiGetVpcPeeringModelResponseVpcPeeringModel, *http.Response, error) {
+			return kc.ApiClient.VPCPeeringAPI.GetVpcPeering(ctx, state.VpcPeeringId.ValueString()).
+				XAuthToken(kc.XAuthToken).Execute()
+		},
+	)
+
+	if httpResp != nil && httpResp.StatusCode == 404 {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		common.AddApiActionError(ctx, r, httpResp, "GetVpcPeering", err, &resp.Diagnostics)
+		return
+	}
+
+	status := vpcPeeringStatus(&respModel.VpcPeering)
+	if status == common.VpcPeeringStatusPendingAcceptance {
+		common.AddGeneralError(ctx, r, &resp.Diagnostics, "The VPC peering is still pending acceptance.")
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	ok := mapVpcPeeringAccepterModel(ctx, &state, &respModel.VpcPeering, &resp.Diagnostics)
+	if !ok || resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *vpcPeeringAccepterResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	common.AddGeneralError(
+		ctx, r, &resp.Diagnostics,
+		"Updates are not supported for vpc_peering_accepter. The vpc_peering_id requires replacement.",
+	)
+}
+
+func (r *vpcPeeringAccepterResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+}
+
+func (r *vpcPeeringAccepterResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*common.KakaoCloudClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *common.KakaoCloudClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.kc = client
+}
+
+func (r *vpcPeeringAccepterResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	parts := strings.Split(req.ID, "/")
+	if len(parts) != 1 && len(parts) != 2 {
+		common.AddImportFormatError(ctx, r, &resp.Diagnostics,
+			"Expected import ID in the format: vpc_peering_id or vpc_peering_id/project_id")
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), parts[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("vpc_peering_id"), parts[0])...)
+	if len(parts) == 2 {
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("project_id"), parts[1])...)
+	}
+}
@@ -0,0 +1,55 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+package vpc
+
+import (
+	"context"
+	. "terraform-provider-kakaocloud/internal/utils"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/kakaoenterprise/kc-sdk-go/services/vpc"
+)
+
+func mapVpcPeeringModel(
+	ctx context.Context,
+	model *vpcPeeringResourceModel,
+	peering *vpc.BnsVpcV1ApiGetVpcPeeringModelVpcPeeringModel,
+	respDiags *diag.Diagnostics,
+) bool {
+	model.Id = types.StringValue(peering.Id)
+	model.Name = types.StringValue(peering.Name)
+	model.Description = ConvertNullableStringWithEmptyToNull(peering.Description)
+	model.VpcId = ConvertNullableString(peering.RequesterVpcId)
+	model.PeerVpcId = ConvertNullableString(peering.AccepterVpcId)
+	model.PeerProjectId = ConvertNullableString(peering.AccepterProjectId)
+	model.CidrBlock = ConvertNullableString(peering.RequesterVpcCidrBlock)
+	model.PeerCidrBlock = ConvertNullableString(peering.AccepterVpcCidrBlock)
+	model.ProjectId = ConvertNullableString(peering.RequesterProjectId)
+	model.ProvisioningStatus = ConvertNullableString(peering.ProvisioningStatus)
+	model.CreatedAt = ConvertNullableTime(peering.CreatedAt)
+	model.UpdatedAt = ConvertNullableTime(peering.UpdatedAt)
+
+	return !respDiags.HasError()
+}
+
+func mapVpcPeeringAccepterModel(
+	ctx context.Context,
+	model *vpcPeeringAccepterResourceModel,
+	peering *vpc.BnsVpcV1ApiGetVpcPeeringModelVpcPeeringModel,
+	respDiags *diag.Diagnostics,
+) bool {
+	model.Id = types.StringValue(peering.Id)
+	model.Name = types.StringValue(peering.Name)
+	model.VpcId = ConvertNullableString(peering.AccepterVpcId)
+	model.AccepterProjectId = ConvertNullableString(peering.AccepterProjectId)
+	model.PeerVpcId = ConvertNullableString(peering.RequesterVpcId)
+	model.PeerProjectId = ConvertNullableString(peering.RequesterProjectId)
+	model.CidrBlock = ConvertNullableString(peering.AccepterVpcCidrBlock)
+	model.PeerCidrBlock = ConvertNullableString(peering.RequesterVpcCidrBlock)
+	model.ProvisioningStatus = ConvertNullableString(peering.ProvisioningStatus)
+	model.CreatedAt = ConvertNullableTime(peering.CreatedAt)
+	model.UpdatedAt = ConvertNullableTime(peering.UpdatedAt)
+
+	return !respDiags.HasError()
+}
@@ -0,0 +1,118 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+package vpc
+
+import (
+	"context"
+	"encoding/json"
+	"terraform-provider-kakaocloud/internal/common"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/kakaoenterprise/kc-sdk-go/services/vpc"
+)
+
+func testVpcPeering(t *testing.T, fields map[string]any) *vpc.BnsVpcV1ApiGetVpcPeeringModelVpcPeeringModel {
+	t.Helper()
+	raw := map[string]any{
+		"id":                       "4f2b7c1d-8e9a-4b3c-9d0e-1f2a3b4c5d6e",
+		"name":                     "peering",
+		"requester_vpc_id":         "5a6b7c8d-9e0f-4a1b-8c2d-3e4f5a6b7c8d",
+		"accepter_vpc_id":          "6b7c8d9e-0f1a-4b2c-9d3e-4f5a6b7c8d9e",
+		"requester_project_id":     "0123456789abcdef0123456789abcdef",
+		"accepter_project_id":      "fedcba9876543210fedcba9876543210",
+		"requester_vpc_cidr_block": "10.0.0.0/16",
+		"accepter_vpc_cidr_block":  "10.1.0.0/16",
+		"created_at":               "2026-01-01T00:00:00Z",
+		"updated_at":               "2026-01-01T00:00:00Z",
+	}
+	for k, v := range fields {
+		raw[k] = v
+	}
+	data, err := json.Marshal(raw)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var peering vpc.BnsVpcV1ApiGetVpcPeeringModelVpcPeeringModel
+	if err := json.Unmarshal(data, &peering); err != nil {
+		t.Fatal(err)
+	}
+	return &peering
+}
+
+func TestMapVpcPeeringModel(t *testing.T) {
+	cases := []struct {
+		name        string
+		description any
+		want        types.String
+	}{
+		{"description", "to shared services", types.StringValue("to shared services")},
+		{"cleared description", "", types.StringNull()},
+		{"no description", nil, types.StringNull()},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			peering := testVpcPeering(t, map[string]any{
+				"description":         tc.description,
+				"provisioning_status": common.VpcPeeringStatusActive,
+			})
+
+			var model vpcPeeringResourceModel
+			var diags diag.Diagnostics
+			if !mapVpcPeeringModel(context.Background(), &model, peering, &diags) {
+				t.Fatalf("unexpected diagnostics: %v", diags)
+			}
+			if !model.Description.Equal(tc.want) {
+				t.Errorf("description = %v, want %v", model.Description, tc.want)
+			}
+			if model.VpcId.ValueString() != "5a6b7c8d-9e0f-4a1b-8c2d-3e4f5a6b7c8d" || model.PeerVpcId.ValueString() != "6b7c8d9e-0f1a-4b2c-9d3e-4f5a6b7c8d9e" {
+				t.Errorf("vpc_id, peer_vpc_id = %v, %v, want the requester and accepter VPCs", model.VpcId, model.PeerVpcId)
+			}
+		})
+	}
+}
+
+func TestMapVpcPeeringAccepterModel(t *testing.T) {
+	peering := testVpcPeering(t, map[string]any{"provisioning_status": common.VpcPeeringStatusActive})
+
+	model := vpcPeeringAccepterResourceModel{ProjectId: types.StringNull()}
+	var diags diag.Diagnostics
+	if !mapVpcPeeringAccepterModel(context.Background(), &model, peering, &diags) {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+	if model.AccepterProjectId.ValueString() != "fedcba9876543210fedcba9876543210" {
+		t.Errorf("accepter_project_id = %v, want the project of the accepting VPC", model.AccepterProjectId)
+	}
+	if !model.ProjectId.IsNull() {
+		t.Errorf("project_id = %v, want the configured override to stay null", model.ProjectId)
+	}
+	if model.VpcId.ValueString() != "6b7c8d9e-0f1a-4b2c-9d3e-4f5a6b7c8d9e" || model.PeerVpcId.ValueString() != "5a6b7c8d-9e0f-4a1b-8c2d-3e4f5a6b7c8d" {
+		t.Errorf("vpc_id, peer_vpc_id = %v, %v, want the accepter and requester VPCs", model.VpcId, model.PeerVpcId)
+	}
+	if model.CidrBlock.ValueString() != "10.1.0.0/16" || model.PeerCidrBlock.ValueString() != "10.0.0.0/16" {
+		t.Errorf("cidr_block, peer_cidr_block = %v, %v, want the accepter and requester CIDR blocks", model.CidrBlock, model.PeerCidrBlock)
+	}
+}
+
+func TestVpcPeeringStatus(t *testing.T) {
+	cases := []struct {
+		name   string
+		status any
+		want   string
+	}{
+		{"pending acceptance", common.VpcPeeringStatusPendingAcceptance, common.VpcPeeringStatusPendingAcceptance},
+		{"active", common.VpcPeeringStatusActive, common.VpcPeeringStatusActive},
+		{"null", nil, ""},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			peering := testVpcPeering(t, map[string]any{"provisioning_status": tc.status})
+			if got := vpcPeeringStatus(peering); got != tc.want {
+				t.Fatalf("got %q, want %q", got, tc.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,41 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+package vpc
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type vpcPeeringResourceModel struct {
+	Id                 types.String   `tfsdk:"id"`
+	Name               types.String   `tfsdk:"name"`
+	Description        types.String   `tfsdk:"description"`
+	VpcId              types.String   `tfsdk:"vpc_id"`
+	PeerVpcId          types.String   `tfsdk:"peer_vpc_id"`
+	PeerProjectId      types.String   `tfsdk:"peer_project_id"`
+	CidrBlock          types.String   `tfsdk:"cidr_block"`
+	PeerCidrBlock      types.String   `tfsdk:"peer_cidr_block"`
+	ProjectId          types.String   `tfsdk:"project_id"`
+	ProvisioningStatus types.String   `tfsdk:"provisioning_status"`
+	CreatedAt          types.String   `tfsdk:"created_at"`
+	UpdatedAt          types.String   `tfsdk:"updated_at"`
+	Timeouts           timeouts.Value `tfsdk:"timeouts"`
+}
+
+type vpcPeeringAccepterResourceModel struct {
+	Id                 types.String   `tfsdk:"id"`
+	VpcPeeringId       types.String   `tfsdk:"vpc_peering_id"`
+	ProjectId          types.String   `tfsdk:"project_id"`
+	AccepterProjectId  types.String   `tfsdk:"accepter_project_id"`
+	Name               types.String   `tfsdk:"name"`
+	VpcId              types.String   `tfsdk:"vpc_id"`
+	PeerVpcId          types.String   `tfsdk:"peer_vpc_id"`
+	PeerProjectId      types.String   `tfsdk:"peer_project_id"`
+	CidrBlock          types.String   `tfsdk:"cidr_block"`
+	PeerCidrBlock      types.String   `tfsdk:"peer_cidr_block"`
+	ProvisioningStatus types.String   `tfsdk:"provisioning_status"`
+	CreatedAt          types.String   `tfsdk:"created_at"`
+	UpdatedAt          types.String   `tfsdk:"updated_at"`
+	Timeouts           timeouts.Value `tfsdk:"timeouts"`
+}
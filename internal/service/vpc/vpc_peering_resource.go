@@ -0,0 +1,358 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+package vpc
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"terraform-provider-kakaocloud/internal/common"
+	"terraform-provider-kakaocloud/internal/utils"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/kakaoenterprise/kc-sdk-go/services/vpc"
+)
+
+var (
+	_ resource.ResourceWithConfigure   = &vpcPeeringResource{}
+	_ resource.ResourceWithImportState = &vpcPeeringResource{}
+)
+
+func NewVpcPeeringResource() resource.Resource {
+	return &vpcPeeringResource{}
+}
+
+type vpcPeeringResource struct {
+	kc *common.KakaoCloudClient
+}
+
+func (r *vpcPeeringResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_vpc_peering"
+}
+
+func (r *vpcPeeringResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: utils.MergeResourceSchemaAttributes(
+			vpcPeeringResourceSchemaAttributes,
+			map[string]schema.Attribute{
+				"timeouts": timeouts.AttributesAll(ctx),
+			},
+		),
+	}
+}
+
+func (r *vpcPeeringResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan vpcPeeringResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	timeout, diags := plan.Timeouts.Create(ctx, common.DefaultCreateTimeout)
+
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	ok := checkVpcStatus(ctx, r, r.kc, plan.VpcId.ValueString(), &resp.Diagnostics)
+	if !ok || resp.Diagnostics.HasError() {
+		return
+	}
+
+	createReq := vpc.CreateVpcPeeringModel{
+		Name:           plan.Name.ValueString(),
+		RequesterVpcId: plan.VpcId.ValueString(),
+		AccepterVpcId:  plan.PeerVpcId.ValueString(),
+	}
+	if !plan.Description.IsNull() && !plan.Description.IsUnknown() {
+		createReq.SetDescription(plan.Description.ValueString())
+	}
+	if !plan.PeerProjectId.IsNull() && !plan.PeerProjectId.IsUnknown() {
+		createReq.SetAccepterProjectId(plan.PeerProjectId.ValueString())
+	}
+
+	body := vpc.BodyCreateVpcPeering{
+		VpcPeering: createReq,
+	}
+
+	respModel, httpResp, err := common.ExecuteWithRetryAndAuth(ctx, r.kc, &resp.Diagnostics, common.ServiceVPC,
+		func() (*vpc.BnsVpcV1ApiCreateVpcPeeringModelResponseVpcPeeringModel, *http.Response, error) {
+			return r.kc.ApiClient.VPCPeeringAPI.CreateVpcPeering(ctx).
+				XAuthToken(r.kc.XAuthToken).
+				BodyCreateVpcPeering(body).
+				Execute()
+		},
+	)
+	if err != nil {
+		common.AddApiActionError(ctx, r, httpResp, "CreateVpcPeering", err, &resp.Diagnostics)
+		return
+	}
+
+	plan.Id = types.StringValue(respModel.VpcPeering.Id)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), plan.Id)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	result, ok := pollVpcPeeringUntilStatus(
+		ctx, r, r.kc, plan.Id.ValueString(),
+		[]string{common.VpcPeeringStatusActive, common.VpcPeeringStatusPendingAcceptance, common.VpcPeeringStatusRejected, common.VpcPeeringStatusError},
+		&resp.Diagnostics,
+	)
+	if !ok || resp.Diagnostics.HasError() {
+		return
+	}
+
+	common.CheckResourceAvailableStatus(ctx, r, (*string)(result.ProvisioningStatus.Get()),
+		[]string{common.VpcPeeringStatusActive, common.VpcPeeringStatusPendingAcceptance}, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ok = mapVpcPeeringModel(ctx, &plan, result, &resp.Diagnostics)
+	if !ok || resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *vpcPeeringResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state vpcPeeringResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	timeout, diags := state.Timeouts.Read(ctx, common.DefaultReadTimeout)
+
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	respModel, httpResp, err := common.ExecuteWithRetryAndAuth(ctx, r.kc, &resp.Diagnostics, common.ServiceVPC,
+		func() (*vpc.BnsVpcV1ApiGetVpcPeeringModelResponseVpcPeeringModel, *http.Response, error) {
+			return r.kc.ApiClient.VPCPeeringAPI.GetVpcPeering(ctx, state.Id.ValueString()).
+				XAuthToken(r.kc.XAuthToken).Execute()
+		},
+	)
+
+	if httpResp != nil && httpResp.StatusCode == 404 {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		common.AddApiActionError(ctx, r, httpResp, "GetVpcPeering", err, &resp.Diagnostics)
+		return
+	}
+
+	ok := mapVpcPeeringModel(ctx, &state, &respModel.VpcPeering, &resp.Diagnostics)
+	if !ok || resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *vpcPeeringResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state vpcPeeringResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	timeout, diags := plan.Timeouts.Update(ctx, common.DefaultUpdateTimeout)
+
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	if !plan.Name.Equal(state.Name) || !plan.Description.Equal(state.Description) {
+		editReq := vpc.EditVpcPeeringModel{}
+		editReq.SetName(plan.Name.ValueString())
+		editReq.SetDescription(plan.Description.ValueString())
+
+		body := *vpc.NewBodyUpdateVpcPeering(editReq)
+
+		_, httpResp, err := common.ExecuteWithRetryAndAuth(ctx, r.kc, &resp.Diagnostics, common.ServiceVPC,
+			func() (*vpc.BnsVpcV1ApiUpdateVpcPeeringModelResponseVpcPeeringModel, *http.Response, error) {
+				return r.kc.ApiClient.VPCPeeringAPI.UpdateVpcPeering(ctx, state.Id.ValueString()).
+					XAuthToken(r.kc.XAuthToken).
+					BodyUpdateVpcPeering(body).
+					Execute()
+			},
+		)
+		if err != nil {
+			common.AddApiActionError(ctx, r, httpResp, "UpdateVpcPeering", err, &resp.Diagnostics)
+			return
+		}
+	}
+
+	respModel, httpResp, err := common.ExecuteWithRetryAndAuth(ctx, r.kc, &resp.Diagnostics, common.ServiceVPC,
+		func() (*vpc.BnsVpcV1ApiGetVpcPeeringModelResponseVpcPeeringModel, *http.Response, error) {
+			return r.kc.ApiClient.VPCPeeringAPI.GetVpcPeering(ctx, state.Id.ValueString()).
+				XAuthToken(r.kc.XAuthToken).Execute()
+		},
+	)
+	if err != nil {
+		common.AddApiActionError(ctx, r, httpResp, "GetVpcPeering", err, &resp.Diagnostics)
+		return
+	}
+
+	ok := mapVpcPeeringModel(ctx, &plan, &respModel.VpcPeering, &resp.Diagnostics)
+	if !ok || resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.State.Set(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *vpcPeeringResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state vpcPeeringResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	timeout, diags := state.Timeouts.Delete(ctx, common.DefaultDeleteTimeout)
+
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	_, httpResp, err := common.ExecuteWithRetryAndAuth(ctx, r.kc, &resp.Diagnostics, common.ServiceVPC,
+		func() (interface{}, *http.Response, error) {
+			httpResp, err := r.kc.ApiClient.VPCPeeringAPI.DeleteVpcPeering(ctx, state.Id.ValueString()).
+				XAuthToken(r.kc.XAuthToken).
+				Execute()
+			return nil, httpResp, err
+		},
+	)
+	if err != nil {
+		if httpResp != nil && httpResp.StatusCode == http.StatusNotFound {
+			return
+		}
+		common.AddApiActionError(ctx, r, httpResp, "DeleteVpcPeering", err, &resp.Diagnostics)
+		return
+	}
+
+	common.PollUntilDeletion(ctx, r, 5*time.Second, &resp.Diagnostics, func(ctx context.Context) (bool, *http.Response, error) {
+		_, httpResp, err := common.ExecuteWithRetryAndAuth(ctx, r.kc, &resp.Diagnostics, common.ServiceVPC,
+			func() (interface{}, *http.Response, error) {
+				_, httpResp, err := r.kc.ApiClient.VPCPeeringAPI.
+					GetVpcPeering(ctx, state.Id.ValueString()).
+					XAuthToken(r.kc.XAuthToken).
+					Execute()
+				return nil, httpResp, err
+			},
+		)
+		return false, httpResp, err
+	})
+}
+
+func (r *vpcPeeringResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*common.KakaoCloudClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *common.KakaoCloudClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.kc = client
+}
+
+func (r *vpcPeeringResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+func pollVpcPeeringUntilStatus(
+	ctx context.Context,
+	r resource.Resource,
+	kc *common.KakaoCloudClient,
+	peeringId string,
+	targetStatuses []string,
+	respDiags *diag.Diagnostics,
+) (*vpc.BnsVpcV1ApiGetVpcPeeringModelVpcPeeringModel, bool) {
+	return common.PollUntilResult(
+		ctx,
+		r,
+		5*time.Second,
+		"vpc peering",
+		peeringId,
+		targetStatuses,
+		respDiags,
+		func(ctx context.Context) (*vpc.BnsVpcV1ApiGetVpcPeeringModelVpcPeeringModel, *http.Response, error) {
+			respModel, httpResp, err := common.ExecuteWithRetryAndAuth(ctx, kc, respDiags, common.ServiceVPC,
+				func() (*vpc.BnsVpcV1ApiGetVpcPeeringModelResponseVpcPeeringModel, *http.Response, error) {
+					return kc.ApiClient.VPCPeeringAPI.
+						GetVpcPeering(ctx, peeringId).
+						XAuthToken(kc.XAuthToken).
+						Execute()
+				},
+			)
+			if err != nil {
+				return nil, httpResp, err
+			}
+			return &respModel.VpcPeering, httpResp, nil
+		},
+		func(v *vpc.BnsVpcV1ApiGetVpcPeeringModelVpcPeeringModel) string {
+			return vpcPeeringStatus(v)
+		},
+	)
+}
+
+// vpcPeeringStatus returns the provisioning status of the peering, or "" when the API reports
+// none.
+func vpcPeeringStatus(peering *vpc.BnsVpcV1ApiGetVpcPeeringModelVpcPeeringModel) string {
+	return utils.ConvertNullableString(peering.ProvisioningStatus).ValueString()
+}
@@ -0,0 +1,133 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+package vpc_test
+
+import (
+	"fmt"
+	"regexp"
+	"terraform-provider-kakaocloud/internal/acctest"
+	"terraform-provider-kakaocloud/internal/acctest/mockserver"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+func testAccVpcPeeringCollection(server *mockserver.Server, readyStatus string) *mockserver.Collection {
+	return server.Register(&mockserver.Collection{
+		Service:       "vpc",
+		Name:          "vpc-peerings",
+		Key:           "vpc_peering",
+		ListKey:       "vpc_peerings",
+		StatusField:   "provisioning_status",
+		PendingStatus: "PENDING_CREATE",
+		ReadyStatus:   readyStatus,
+		PendingReads:  1,
+		OnCreate: func(obj map[string]any) {
+			obj["requester_project_id"] = mockserver.ProjectId
+			obj["requester_vpc_cidr_block"] = "10.0.0.0/16"
+			obj["accepter_vpc_cidr_block"] = "10.1.0.0/16"
+			if _, ok := obj["accepter_project_id"]; !ok {
+				obj["accepter_project_id"] = mockserver.ProjectId
+			}
+		},
+	})
+}
+
+func TestAccVpcPeeringResource_basic(t *testing.T) {
+	server := acctest.NewMockServer(t)
+	peerings := testAccVpcPeeringCollection(server, "ACTIVE")
+	resourceName := "kakaocloud_vpc_peering.test"
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		CheckDestroy: func(s *terraform.State) error {
+			if n := peerings.Len(); n != 0 {
+				return fmt.Errorf("expected all VPC peerings to be destroyed, %d left", n)
+			}
+			return nil
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: acctest.ProviderConfig(server, "") + testAccVpcPeeringResourceConfig(`description = "to shared services"`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "name", "acc-peering"),
+					resource.TestCheckResourceAttr(resourceName, "description", "to shared services"),
+					resource.TestCheckResourceAttr(resourceName, "provisioning_status", "ACTIVE"),
+					resource.TestCheckResourceAttr(resourceName, "cidr_block", "10.0.0.0/16"),
+					resource.TestCheckResourceAttr(resourceName, "peer_cidr_block", "10.1.0.0/16"),
+					resource.TestCheckResourceAttrPair(resourceName, "vpc_id", "kakaocloud_vpc.requester", "id"),
+					resource.TestCheckResourceAttrPair(resourceName, "peer_vpc_id", "kakaocloud_vpc.accepter", "id"),
+					resource.TestCheckResourceAttrSet(resourceName, "id"),
+				),
+			},
+			{
+				// Removing the description clears it rather than leaving "" in state.
+				Config: acctest.ProviderConfig(server, "") + testAccVpcPeeringResourceConfig(""),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckNoResourceAttr(resourceName, "description"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"timeouts"},
+			},
+		},
+	})
+}
+
+// A peering that ends up in ERROR is still saved to state, so that it is destroyed instead of
+// being left behind.
+func TestAccVpcPeeringResource_error(t *testing.T) {
+	server := acctest.NewMockServer(t)
+	peerings := testAccVpcPeeringCollection(server, "ERROR")
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		CheckDestroy: func(s *terraform.State) error {
+			if n := peerings.Len(); n != 0 {
+				return fmt.Errorf("expected the failed VPC peering to be destroyed, %d left", n)
+			}
+			return nil
+		},
+		Steps: []resource.TestStep{
+			{
+				Config:      acctest.ProviderConfig(server, "") + testAccVpcPeeringResourceConfig(""),
+				ExpectError: regexp.MustCompile(`ERROR`),
+			},
+		},
+	})
+}
+
+func testAccVpcPeeringResourceConfig(description string) string {
+	return fmt.Sprintf(`
+resource "kakaocloud_vpc" "requester" {
+  name       = "acc-requester"
+  cidr_block = "10.0.0.0/16"
+
+  subnet = {
+    cidr_block        = "10.0.0.0/20"
+    availability_zone = "kr-central-2-a"
+  }
+}
+
+resource "kakaocloud_vpc" "accepter" {
+  name       = "acc-accepter"
+  cidr_block = "10.1.0.0/16"
+
+  subnet = {
+    cidr_block        = "10.1.0.0/20"
+    availability_zone = "kr-central-2-a"
+  }
+}
+
+resource "kakaocloud_vpc_peering" "test" {
+  name        = "acc-peering"
+  vpc_id      = kakaocloud_vpc.requester.id
+  peer_vpc_id = kakaocloud_vpc.accepter.id
+  %s
+}
+`, description)
+}
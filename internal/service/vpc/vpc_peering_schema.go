@@ -0,0 +1,137 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+package vpc
+
+import (
+	"terraform-provider-kakaocloud/internal/common"
+
+	rschema "github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+)
+
+var vpcPeeringResourceSchemaAttributes = map[string]rschema.Attribute{
+	"id": rschema.StringAttribute{
+		Computed: true,
+		PlanModifiers: []planmodifier.String{
+			stringplanmodifier.UseStateForUnknown(),
+		},
+	},
+	"name": rschema.StringAttribute{
+		Required:   true,
+		Validators: common.NameValidator(200),
+	},
+	"description": rschema.StringAttribute{
+		Optional:   true,
+		Validators: common.DescriptionValidator(),
+	},
+	"vpc_id": rschema.StringAttribute{
+		Required:   true,
+		Validators: common.UuidValidator(),
+		PlanModifiers: []planmodifier.String{
+			stringplanmodifier.RequiresReplace(),
+		},
+	},
+	"peer_vpc_id": rschema.StringAttribute{
+		Required:   true,
+		Validators: common.UuidValidator(),
+		PlanModifiers: []planmodifier.String{
+			stringplanmodifier.RequiresReplace(),
+		},
+	},
+	"peer_project_id": rschema.StringAttribute{
+		Optional:   true,
+		Computed:   true,
+		Validators: common.UuidNoHyphenValidator(),
+		PlanModifiers: []planmodifier.String{
+			stringplanmodifier.RequiresReplaceIfConfigured(),
+			stringplanmodifier.UseStateForUnknown(),
+		},
+	},
+	"cidr_block": rschema.StringAttribute{
+		Computed: true,
+		PlanModifiers: []planmodifier.String{
+			stringplanmodifier.UseStateForUnknown(),
+		},
+	},
+	"peer_cidr_block": rschema.StringAttribute{
+		Computed: true,
+		PlanModifiers: []planmodifier.String{
+			stringplanmodifier.UseStateForUnknown(),
+		},
+	},
+	"project_id": rschema.StringAttribute{
+		Computed: true,
+		PlanModifiers: []planmodifier.String{
+			stringplanmodifier.UseStateForUnknown(),
+		},
+	},
+	"provisioning_status": rschema.StringAttribute{
+		Computed: true,
+	},
+	"created_at": rschema.StringAttribute{
+		Computed: true,
+		PlanModifiers: []planmodifier.String{
+			stringplanmodifier.UseStateForUnknown(),
+		},
+	},
+	"updated_at": rschema.StringAttribute{
+		Computed: true,
+	},
+}
+
+var vpcPeeringAccepterResourceSchemaAttributes = map[string]rschema.Attribute{
+	"id": rschema.StringAttribute{
+		Computed: true,
+		PlanModifiers: []planmodifier.String{
+			stringplanmodifier.UseStateForUnknown(),
+		},
+	},
+	"vpc_peering_id": rschema.StringAttribute{
+		Required:   true,
+		Validators: common.UuidValidator(),
+		PlanModifiers: []planmodifier.String{
+			stringplanmodifier.RequiresReplace(),
+		},
+	},
+	"project_id": rschema.StringAttribute{
+		Optional:   true,
+		Validators: common.UuidNoHyphenValidator(),
+		PlanModifiers: []planmodifier.String{
+			stringplanmodifier.RequiresReplace(),
+		},
+	},
+	"accepter_project_id": rschema.StringAttribute{
+		Computed: true,
+	},
+	"name": rschema.StringAttribute{
+		Computed: true,
+	},
+	"vpc_id": rschema.StringAttribute{
+		Computed: true,
+	},
+	"peer_vpc_id": rschema.StringAttribute{
+		Computed: true,
+	},
+	"peer_project_id": rschema.StringAttribute{
+		Computed: true,
+	},
+	"cidr_block": rschema.StringAttribute{
+		Computed: true,
+	},
+	"peer_cidr_block": rschema.StringAttribute{
+		Computed: true,
+	},
+	"provisioning_status": rschema.StringAttribute{
+		Computed: true,
+	},
+	"created_at": rschema.StringAttribute{
+		Computed: true,
+		PlanModifiers: []planmodifier.String{
+			stringplanmodifier.UseStateForUnknown(),
+		},
+	},
+	"updated_at": rschema.StringAttribute{
+		Computed: true,
+	},
+}
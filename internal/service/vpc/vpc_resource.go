@@ -88,7 +88,7 @@ func (r *vpcResource) Create(ctx context.Context, req resource.CreateRequest, re
 		Vpc: createReq,
 	}
 
-	respModel, httpResp, err := common.ExecuteWithRetryAndAuth(ctx, r.kc, &resp.Diagnostics,
+	respModel, httpResp, err := common.ExecuteWithRetryAndAuth(ctx, r.kc, &resp.Diagnostics, common.ServiceVPC,
 		func() (*vpc.BnsVpcV1ApiCreateVpcModelResponseVPCModel, *http.Response, error) {
 			return r.kc.ApiClient.VPCAPI.CreateVpc(ctx).
 				XAuthToken(r.kc.XAuthToken).BodyCreateVpc(body).Execute()
@@ -110,7 +110,7 @@ func (r *vpcResource) Create(ctx context.Context, req resource.CreateRequest, re
 		[]string{common.VpcProvisioningStatusActive, common.VpcProvisioningStatusError},
 		&resp.Diagnostics,
 		func(ctx context.Context) (*vpc.BnsVpcV1ApiGetVpcModelVpcModel, *http.Response, error) {
-			respModel, httpResp, err := common.ExecuteWithRetryAndAuth(ctx, r.kc, &resp.Diagnostics,
+			respModel, httpResp, err := common.ExecuteWithRetryAndAuth(ctx, r.kc, &resp.Diagnostics, common.ServiceVPC,
 				func() (*vpc.BnsVpcV1ApiGetVpcModelResponseVPCModel, *http.Response, error) {
 					return r.kc.ApiClient.VPCAPI.
 						GetVpc(ctx, plan.Id.ValueString()).
@@ -167,7 +167,7 @@ func (r *vpcResource) Read(ctx context.Context, req resource.ReadRequest, resp *
 	ctx, cancel := context.WithTimeout(ctx, timeout)
 	defer cancel()
 
-	respModel, httpResp, err := common.ExecuteWithRetryAndAuth(ctx, r.kc, &resp.Diagnostics,
+	respModel, httpResp, err := common.ExecuteWithRetryAndAuth(ctx, r.kc, &resp.Diagnostics, common.ServiceVPC,
 		func() (*vpc.BnsVpcV1ApiGetVpcModelResponseVPCModel, *http.Response, error) {
 			return r.kc.ApiClient.VPCAPI.GetVpc(ctx, state.Id.ValueString()).
 				XAuthToken(r.kc.XAuthToken).Execute()
@@ -227,7 +227,7 @@ func (r *vpcResource) Update(ctx context.Context, req resource.UpdateRequest, re
 
 		body := *vpc.NewBodyPutBnsVpc(editReq)
 
-		respModel, httpResp, err := common.ExecuteWithRetryAndAuth(ctx, r.kc, &resp.Diagnostics,
+		respModel, httpResp, err := common.ExecuteWithRetryAndAuth(ctx, r.kc, &resp.Diagnostics, common.ServiceVPC,
 			func() (*vpc.BnsVpcV1ApiUpdateVpcModelResponseVPCModel, *http.Response, error) {
 				return r.kc.ApiClient.VPCAPI.PutBnsVpc(ctx, plan.Id.ValueString()).
 					XAuthToken(r.kc.XAuthToken).
@@ -268,7 +268,7 @@ func (r *vpcResource) Delete(ctx context.Context, req resource.DeleteRequest, re
 	ctx, cancel := context.WithTimeout(ctx, timeout)
 	defer cancel()
 
-	_, httpResp, err := common.ExecuteWithRetryAndAuth(ctx, r.kc, &resp.Diagnostics,
+	_, httpResp, err := common.ExecuteWithRetryAndAuth(ctx, r.kc, &resp.Diagnostics, common.ServiceVPC,
 		func() (interface{}, *http.Response, error) {
 			httpResp, err := r.kc.ApiClient.VPCAPI.DeleteVpc(ctx, state.Id.ValueString()).
 				XAuthToken(r.kc.XAuthToken).
@@ -285,7 +285,7 @@ func (r *vpcResource) Delete(ctx context.Context, req resource.DeleteRequest, re
 	}
 
 	common.PollUntilDeletion(ctx, r, 10*time.Second, &resp.Diagnostics, func(ctx context.Context) (bool, *http.Response, error) {
-		_, httpResp, err := common.ExecuteWithRetryAndAuth(ctx, r.kc, &resp.Diagnostics,
+		_, httpResp, err := common.ExecuteWithRetryAndAuth(ctx, r.kc, &resp.Diagnostics, common.ServiceVPC,
 			func() (interface{}, *http.Response, error) {
 				_, httpResp, err := r.kc.ApiClient.VPCAPI.
 					GetVpc(ctx, state.Id.ValueString()).
@@ -384,7 +384,7 @@ func checkVpcStatus(
 		[]string{common.VpcProvisioningStatusActive, common.VpcProvisioningStatusError, common.VpcProvisioningStatusDeleting},
 		respDiags,
 		func(ctx context.Context) (*vpc.BnsVpcV1ApiGetVpcModelVpcModel, *http.Response, error) {
-			respModel, httpResp, err := common.ExecuteWithRetryAndAuth(ctx, kc, respDiags,
+			respModel, httpResp, err := common.ExecuteWithRetryAndAuth(ctx, kc, respDiags, common.ServiceVPC,
 				func() (*vpc.BnsVpcV1ApiGetVpcModelResponseVPCModel, *http.Response, error) {
 					return kc.ApiClient.VPCAPI.
 						GetVpc(ctx, vpcId).
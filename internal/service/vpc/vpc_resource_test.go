@@ -0,0 +1,66 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+package vpc_test
+
+import (
+	"fmt"
+	"terraform-provider-kakaocloud/internal/acctest"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+func TestAccVpcResource_basic(t *testing.T) {
+	server := acctest.NewMockServer(t)
+	resourceName := "kakaocloud_vpc.test"
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		CheckDestroy: func(s *terraform.State) error {
+			if n := server.Collection("vpc", "vpcs").Len(); n != 0 {
+				return fmt.Errorf("expected all VPCs to be destroyed, %d left", n)
+			}
+			return nil
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: acctest.ProviderConfig(server, "") + testAccVpcResourceConfig("acc-vpc"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "name", "acc-vpc"),
+					resource.TestCheckResourceAttr(resourceName, "cidr_block", "10.0.0.0/16"),
+					resource.TestCheckResourceAttr(resourceName, "provisioning_status", "ACTIVE"),
+					resource.TestCheckResourceAttrSet(resourceName, "id"),
+					resource.TestCheckResourceAttrSet(resourceName, "igw.id"),
+					resource.TestCheckResourceAttrSet(resourceName, "default_route_table.id"),
+				),
+			},
+			{
+				Config: acctest.ProviderConfig(server, "") + testAccVpcResourceConfig("acc-vpc-renamed"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "name", "acc-vpc-renamed"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"subnet", "timeouts"},
+			},
+		},
+	})
+}
+
+func testAccVpcResourceConfig(name string) string {
+	return fmt.Sprintf(`
+resource "kakaocloud_vpc" "test" {
+  name       = %q
+  cidr_block = "10.0.0.0/16"
+
+  subnet = {
+    cidr_block        = "10.0.0.0/20"
+    availability_zone = "kr-central-2-a"
+  }
+}
+`, name)
+}
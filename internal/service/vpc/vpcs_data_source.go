@@ -154,9 +154,12 @@ func (d *vpcsDataSource) Read(ctx context.Context, req datasource.ReadRequest, r
 	if resp.Diagnostics.HasError() {
 		return
 	}
-	vpcResp, httpResp, err := common.ExecuteWithRetryAndAuth(ctx, d.kc, &resp.Diagnostics,
-		func() (*vpc.VPCListModel, *http.Response, error) {
-			return vpcApi.Limit(1000).XAuthToken(d.kc.XAuthToken).Execute()
+	vpcPages, httpResp, err := common.ListAllPagesPartial(ctx, d.kc, d, &resp.Diagnostics, common.ServiceVPC,
+		func(limit int32, offset int32) (*vpc.VPCListModel, *http.Response, error) {
+			return vpcApi.Limit(limit).Offset(offset).XAuthToken(d.kc.XAuthToken).Execute()
+		},
+		func(page *vpc.VPCListModel) int {
+			return len(page.Vpcs)
 		},
 	)
 	if err != nil {
@@ -164,14 +167,16 @@ func (d *vpcsDataSource) Read(ctx context.Context, req datasource.ReadRequest, r
 		return
 	}
 
-	for _, v := range vpcResp.Vpcs {
-		var tmpVpc vpcBaseModel
-		ok := mapVpcListModel(ctx, &tmpVpc, &v, &resp.Diagnostics)
-		if !ok || resp.Diagnostics.HasError() {
-			return
-		}
+	for _, vpcResp := range vpcPages {
+		for _, v := range vpcResp.Vpcs {
+			var tmpVpc vpcBaseModel
+			ok := mapVpcListModel(ctx, &tmpVpc, &v, &resp.Diagnostics)
+			if !ok || resp.Diagnostics.HasError() {
+				return
+			}
 
-		config.Vpcs = append(config.Vpcs, tmpVpc)
+			config.Vpcs = append(config.Vpcs, tmpVpc)
+		}
 	}
 
 	diags = resp.State.Set(ctx, &config)